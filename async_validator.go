@@ -0,0 +1,132 @@
+package pathvars
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// validateAsync runs the AsyncValidator of each of the route's parameters that
+// has a value, stopping at the first failure. It runs last so lookups are
+// only made for requests that otherwise match.
+func (r Route) validateAsync(ctx context.Context, values pvtypes.ValuesMap) (err error) {
+	for param := range r.ParsedTemplate.params.Values() {
+		if param.AsyncValidator() == nil {
+			continue
+		}
+		value, found := values.Get(param.Name)
+		if !found {
+			continue
+		}
+		s := param.FormatValue(value)
+		err = runAsyncValidator(ctx, param, s)
+		if err != nil {
+			err = WithErr(err,
+				"parameter_name", param.Name,
+				"value", s,
+				"endpoint", r.Endpoint(),
+			)
+			goto end
+		}
+	}
+end:
+	return err
+}
+
+// runAsyncValidator checks value with param's AsyncValidator, consulting and
+// filling its ValidationCache. A validator that ignores ctx is abandoned once
+// ctx is done, so timeouts and cancellation are honored either way.
+func runAsyncValidator(ctx context.Context, param Parameter, value string) (err error) {
+	var found bool
+	var cancel context.CancelFunc
+
+	cache := param.ValidationCache()
+	if cache != nil {
+		err, found = cache.Get(value)
+		if found {
+			goto end
+		}
+	}
+
+	if param.AsyncTimeout() > 0 {
+		ctx, cancel = context.WithTimeout(ctx, param.AsyncTimeout())
+		defer cancel()
+	}
+
+	err = awaitValidator(ctx, param.AsyncValidator(), value)
+	if isContextErr(err) || faultSourceOf(err) == ServerFaultSource {
+		// Not the value's fault, so never cached
+		goto end
+	}
+	if cache != nil {
+		cache.Set(value, err)
+	}
+
+end:
+	return asyncValidationErr(ctx, err)
+}
+
+// awaitValidator calls fn in its own goroutine and returns its result, or
+// ctx's error if ctx is done first.
+func awaitValidator(ctx context.Context, fn AsyncValidator, value string) (err error) {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(ctx, value)
+	}()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	return err
+}
+
+// asyncValidationErr classifies a validator result: a canceled request is a
+// client fault, any other context error is a timeout and a server fault, an
+// error the validator marked as a server fault means it could not check the
+// value, and everything else rejects the value as a client fault.
+func asyncValidationErr(ctx context.Context, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(context.Cause(ctx), context.Canceled):
+		return NewErr(
+			ErrAsyncValidationCanceled,
+			"fault_source", ClientFaultSource.Slug(),
+			err,
+		)
+	case isContextErr(err):
+		return NewErr(
+			ErrAsyncValidationTimeout,
+			"fault_source", ServerFaultSource.Slug(),
+			err,
+		)
+	case faultSourceOf(err) == ServerFaultSource:
+		return NewErr(
+			ErrAsyncValidationUnavailable,
+			"fault_source", ServerFaultSource.Slug(),
+			err,
+		)
+	}
+	return NewErr(
+		ErrAsyncValidationFailed,
+		"fault_source", ClientFaultSource.Slug(),
+		err,
+	)
+}
+
+// requestContext returns req's context, or context.Background() when matching
+// without a request.
+func requestContext(req *http.Request) context.Context {
+	if req == nil {
+		return context.Background()
+	}
+	return req.Context()
+}
+
+// isContextErr reports whether err comes from a canceled or expired context.
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
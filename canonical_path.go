@@ -0,0 +1,125 @@
+package pathvars
+
+import (
+	"strings"
+)
+
+// matchingPath returns path, or its normalizePath() form when only that
+// matches, reporting whether either fits route's template.
+func (r *Router) matchingPath(route *Route, path string) (canonical string, ok bool) {
+	canonical = path
+	if route.ParsedTemplate.matchesPath(canonical) {
+		ok = true
+		goto end
+	}
+	canonical = r.normalizePath(route.ParsedTemplate, path)
+	if canonical == path || !route.ParsedTemplate.matchesPath(canonical) {
+		canonical = path
+		goto end
+	}
+	ok = true
+end:
+	return canonical, ok
+}
+
+// normalizePath returns path as it would need to be written to match pt
+// exactly under RouterArgs.IgnoreTrailingSlash and RouterArgs.CaseInsensitive,
+// or path itself when neither applies.
+func (r *Router) normalizePath(pt *ParsedTemplate, path string) string {
+	if r.ignoreTrailingSlash && len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if r.caseInsensitive {
+		path = pt.foldLiterals(path)
+	}
+	return path
+}
+
+// foldLiterals rewrites the parts of path that equal the template's literal
+// text under Unicode case folding to the template's own spelling, so
+// "/Users/Ada" becomes "/users/Ada" for "/users/{name}". Parameter values
+// are left untouched. Segments are aligned from the start of the path up to
+// the first multi-segment parameter and from the end back to the last one.
+func (pt *ParsedTemplate) foldLiterals(path string) string {
+	var changed, spanned bool
+
+	parts := strings.Split(path, "/")
+	segments := pt.segments
+	if len(parts)-1 < len(segments) {
+		// Too few parts to align; the path cannot match anyway
+		return path
+	}
+	for i, segment := range segments {
+		if pt.spansSegments(segment) {
+			spanned = true
+			break
+		}
+		changed = pt.foldPart(segment, &parts[i+1]) || changed
+	}
+	for i := len(segments) - 1; spanned && i >= 0; i-- {
+		if pt.spansSegments(segments[i]) {
+			break
+		}
+		changed = pt.foldPart(segments[i], &parts[len(parts)-len(segments)+i]) || changed
+	}
+	if !changed {
+		return path
+	}
+	return strings.Join(parts, "/")
+}
+
+// foldPart replaces the literal text of *part that matches segment in any
+// case with segment's spelling, reporting whether *part changed.
+func (pt *ParsedTemplate) foldPart(segment Segment, part *string) (changed bool) {
+	var matrix string
+
+	name := *part
+	if pt.matrixParameters {
+		if i := strings.IndexByte(name, ';'); i >= 0 {
+			name, matrix = name[:i], name[i:]
+		}
+	}
+
+	switch {
+	case !segment.IsParameter():
+		if strings.EqualFold(name, segment.Raw) {
+			name = segment.Raw
+		}
+	case len(name) >= len(segment.Prefix)+len(segment.Suffix):
+		name = foldPrefix(name, segment.Prefix)
+		name = foldSuffix(name, segment.Suffix)
+	}
+
+	if name+matrix != *part {
+		*part = name + matrix
+		changed = true
+	}
+	return changed
+}
+
+// foldPrefix replaces the start of s with prefix when they match in any case.
+func foldPrefix(s, prefix string) string {
+	if len(prefix) == 0 || len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s
+	}
+	return prefix + s[len(prefix):]
+}
+
+// foldSuffix replaces the end of s with suffix when they match in any case.
+func foldSuffix(s, suffix string) string {
+	if len(suffix) == 0 || len(s) < len(suffix) || !strings.EqualFold(s[len(s)-len(suffix):], suffix) {
+		return s
+	}
+	return s[:len(s)-len(suffix)] + suffix
+}
+
+// spansSegments reports whether segment holds a multi-segment parameter, after
+// which path parts no longer line up with template segments.
+func (pt *ParsedTemplate) spansSegments(segment Segment) bool {
+	for _, param := range segment.Parameters {
+		if pt.isMultiSegment(param.Name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package pathvars
+
+import (
+	"crypto/x509"
+	"net/http"
+	"slices"
+)
+
+// ClientCertRequirement restricts a route to requests presenting a verified
+// TLS client certificate whose subject matches, e.g. for zero-trust gateways
+// that route mTLS traffic by service identity. Only certificates the TLS
+// server verified are considered, so the server's tls.Config must use
+// tls.VerifyClientCertIfGiven or tls.RequireAndVerifyClientCert; certificates
+// that were merely requested are ignored.
+type ClientCertRequirement struct {
+	// CommonNames lists the accepted subject common names, e.g. "billing-svc".
+	// An empty list accepts any common name.
+	CommonNames []string
+
+	// Organizations lists the accepted subject organizations, any one of
+	// which the certificate must carry. An empty list accepts any.
+	Organizations []string
+}
+
+// verifiedClientCert returns the leaf certificate of req's first verified
+// chain, or nil when req carries no verified client certificate.
+func verifiedClientCert(req *http.Request) *x509.Certificate {
+	if req == nil || req.TLS == nil || len(req.TLS.VerifiedChains) == 0 {
+		return nil
+	}
+	chain := req.TLS.VerifiedChains[0]
+	if len(chain) == 0 {
+		return nil
+	}
+	return chain[0]
+}
+
+// checkClientCert checks req against the route's ClientCert requirement.
+func (r Route) checkClientCert(req *http.Request) (err error) {
+	var cert *x509.Certificate
+
+	if r.ClientCert == nil {
+		goto end
+	}
+	cert = verifiedClientCert(req)
+	if cert == nil {
+		err = NewErr(
+			ErrClientCertRejected,
+			ErrClientCertMissing,
+			"fault_source", ClientFaultSource.Slug(),
+		)
+		goto end
+	}
+	if !r.ClientCert.accepts(cert) {
+		err = NewErr(
+			ErrClientCertRejected,
+			"subject", cert.Subject.String(),
+			"fault_source", ClientFaultSource.Slug(),
+		)
+	}
+end:
+	return err
+}
+
+// accepts reports whether cert's subject satisfies the requirement.
+func (cr ClientCertRequirement) accepts(cert *x509.Certificate) bool {
+	if len(cr.CommonNames) != 0 && !slices.Contains(cr.CommonNames, cert.Subject.CommonName) {
+		return false
+	}
+	if len(cr.Organizations) == 0 {
+		return true
+	}
+	for _, org := range cert.Subject.Organization {
+		if slices.Contains(cr.Organizations, org) {
+			return true
+		}
+	}
+	return false
+}
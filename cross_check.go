@@ -0,0 +1,192 @@
+package pathvars
+
+import (
+	"time"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// CrossCheck validates a relationship between several of a route's values
+// once each has passed its own type and constraint validation, e.g. that one
+// date precedes another. pt is the matched route's template and values holds
+// everything extracted from the request, including defaults.
+type CrossCheck interface {
+	Check(pt *ParsedTemplate, values pvtypes.ValuesMap) error
+}
+
+// CrossCheckFunc adapts an ordinary function to a CrossCheck.
+type CrossCheckFunc func(pt *ParsedTemplate, values pvtypes.ValuesMap) error
+
+// Check calls f(pt, values).
+func (f CrossCheckFunc) Check(pt *ParsedTemplate, values pvtypes.ValuesMap) error {
+	return f(pt, values)
+}
+
+// TemplateVerifier is optionally implemented by a CrossCheck to verify, when
+// the route is added, that its template declares the parameters the check
+// names, so a typo fails AddRoute() rather than every request.
+type TemplateVerifier interface {
+	VerifyTemplate(pt *ParsedTemplate) error
+}
+
+var _ TemplateVerifier = dateRange{}
+
+// dateRange is the CrossCheck returned by DateRange().
+type dateRange struct {
+	start, end Identifier
+}
+
+// multiSegmentDateLayouts parse the yyyy, yyyy/mm and yyyy/mm/dd values
+// accepted by multi-segment date parameters without a format constraint.
+var multiSegmentDateLayouts = []string{"2006/01/02", "2006/01", "2006"}
+
+// DateRange returns a CrossCheck requiring the date parameter named start to
+// be on or before the one named end. Each value is parsed using its own
+// parameter's format[...] constraint, so the two may use different formats.
+// The check is skipped when either value is absent, e.g. an optional query
+// parameter without a default. AddRoute() fails if either name is not a date
+// parameter of the route.
+func DateRange(start, end Identifier) CrossCheck {
+	return dateRange{start: start, end: end}
+}
+
+// Check requires the start date in values to be on or before the end date.
+func (dr dateRange) Check(pt *ParsedTemplate, values pvtypes.ValuesMap) (err error) {
+	var startTime, endTime time.Time
+	var startValue, endValue string
+	var ok bool
+
+	startValue, ok = stringValue(values, dr.start)
+	if !ok {
+		goto end
+	}
+	endValue, ok = stringValue(values, dr.end)
+	if !ok {
+		goto end
+	}
+
+	startTime, err = parseDateParameter(pt, dr.start, startValue)
+	if err != nil {
+		goto end
+	}
+	endTime, err = parseDateParameter(pt, dr.end, endValue)
+	if err != nil {
+		goto end
+	}
+
+	if startTime.After(endTime) {
+		err = NewErr(
+			ErrDateRangeReversed,
+			"start_parameter", dr.start,
+			"start_value", startValue,
+			"end_parameter", dr.end,
+			"end_value", endValue,
+			"fault_source", ClientFaultSource.Slug(),
+		)
+	}
+
+end:
+	return err
+}
+
+// VerifyTemplate requires both of the check's parameters to be date
+// parameters declared by pt.
+func (dr dateRange) VerifyTemplate(pt *ParsedTemplate) (err error) {
+	for _, name := range []Identifier{dr.start, dr.end} {
+		p, ok := pt.params.Get(name)
+		if !ok {
+			err = NewErr(
+				ErrInvalidCrossCheck,
+				ErrCrossCheckParameterNotFound,
+				"parameter", name,
+			)
+			goto end
+		}
+		if p.DataType() != DateType {
+			err = NewErr(
+				ErrInvalidCrossCheck,
+				ErrCrossCheckParameterNotDate,
+				"parameter", name,
+				"data_type", p.DataTypeSlug(),
+			)
+			goto end
+		}
+	}
+end:
+	return err
+}
+
+// checkCrossChecks verifies each of checks that implements TemplateVerifier
+// against pt.
+func checkCrossChecks(pt *ParsedTemplate, checks []CrossCheck) (err error) {
+	for _, check := range checks {
+		verifier, ok := check.(TemplateVerifier)
+		if !ok {
+			continue
+		}
+		err = verifier.VerifyTemplate(pt)
+		if err != nil {
+			goto end
+		}
+	}
+end:
+	return err
+}
+
+// stringValue returns the value named name from values as a string.
+func stringValue(values pvtypes.ValuesMap, name Identifier) (s string, ok bool) {
+	var value any
+
+	value, ok = values.Get(name)
+	if !ok {
+		goto end
+	}
+	s, ok = value.(string)
+
+end:
+	return s, ok
+}
+
+// parseDateParameter parses value for the date parameter name of pt using its
+// format[...] constraint, or the data type's default yyyy-mm-dd format (or
+// yyyy/mm/dd for multi-segment parameters) when it has none.
+func parseDateParameter(pt *ParsedTemplate, name Identifier, value string) (t time.Time, err error) {
+	var p Parameter
+	var ok bool
+
+	p, ok = pt.params.Get(name)
+	if !ok || p.DataType() != DateType {
+		err = NewErr(
+			ErrCrossCheckParameterNotDate,
+			"parameter", name,
+			"fault_source", ServerFaultSource.Slug(),
+		)
+		goto end
+	}
+
+	for _, c := range p.Constraints() {
+		dfc, isDateFormat := c.(*pvconstraints.DateFormatConstraint)
+		if isDateFormat {
+			t, err = dfc.ParseTime(value)
+			goto end
+		}
+	}
+
+	if !p.MultiSegment {
+		t, err = time.Parse(time.DateOnly, value)
+		goto end
+	}
+	for _, layout := range multiSegmentDateLayouts {
+		t, err = time.Parse(layout, value)
+		if err == nil {
+			goto end
+		}
+	}
+
+end:
+	if err != nil {
+		err = WithErr(err, "parameter", name, "value", value)
+	}
+	return t, err
+}
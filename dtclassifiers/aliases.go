@@ -0,0 +1,14 @@
+package dtclassifiers
+
+import (
+	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// mustRegisterAlias registers a built-in data type alias, panicking on failure
+// since that can only mean two built-ins claim the same slug.
+func mustRegisterAlias(alias, canonical pvt.PVDataTypeSlug) {
+	err := pvt.RegisterDataTypeAlias(alias, canonical)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -1,6 +1,7 @@
 package dtclassifiers
 
 import (
+	"math/rand/v2"
 	"regexp"
 
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -8,10 +9,11 @@ import (
 
 func init() {
 	pvt.RegisterDataTypeClassifier(&AlphaNumericClassifier{})
-	pvt.RegisterDataTypeAlias(pvt.AlphanumericType, pvt.AlphanumTypeSlug)
+	mustRegisterAlias(pvt.AlphanumTypeSlug, pvt.AlphanumericTypeSlug)
 }
 
 var _ pvt.DataTypeClassifier = (*AlphaNumericClassifier)(nil)
+var _ pvt.RandomExampler = (*AlphaNumericClassifier)(nil)
 
 type AlphaNumericClassifier struct {
 	*pvt.BaseDataTypeClassifier
@@ -45,6 +47,11 @@ func (v AlphaNumericClassifier) DataType() pvt.PVDataType {
 func (AlphaNumericClassifier) Example() any {
 	return "abc123"
 }
+
+// RandomExample returns six lowercase letters and digits.
+func (AlphaNumericClassifier) RandomExample(r *rand.Rand) any {
+	return randomString(r, lowerAlnum, 6)
+}
 func (AlphaNumericClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.AlphanumericTypeSlug
 }
@@ -1,15 +1,19 @@
 package dtclassifiers
 
 import (
+	"math/rand/v2"
+	"strconv"
+
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
 )
 
 func init() {
 	pvt.RegisterDataTypeClassifier(&BooleanClassifier{})
-	pvt.RegisterDataTypeAlias(pvt.BooleanType, pvt.BoolTypeSlug)
+	mustRegisterAlias(pvt.BoolTypeSlug, pvt.BooleanTypeSlug)
 }
 
 var _ pvt.DataTypeClassifier = (*BooleanClassifier)(nil)
+var _ pvt.RandomExampler = (*BooleanClassifier)(nil)
 
 type BooleanClassifier struct {
 	*pvt.BaseDataTypeClassifier
@@ -43,6 +47,11 @@ func (v BooleanClassifier) MakeNew(args *pvt.DataTypeClassifierArgs) pvt.DataTyp
 func (BooleanClassifier) Example() any {
 	return "true"
 }
+
+// RandomExample returns "true" or "false".
+func (BooleanClassifier) RandomExample(r *rand.Rand) any {
+	return strconv.FormatBool(r.IntN(2) == 0)
+}
 func (BooleanClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.BooleanTypeSlug
 }
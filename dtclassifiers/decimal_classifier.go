@@ -1,6 +1,7 @@
 package dtclassifiers
 
 import (
+	"math/rand/v2"
 	"strconv"
 
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -11,16 +12,24 @@ func init() {
 }
 
 var _ pvt.DataTypeClassifier = (*DecimalClassifier)(nil)
+var _ pvt.RandomExampler = (*DecimalClassifier)(nil)
 
 type DecimalClassifier struct {
 	*pvt.BaseDataTypeClassifier
 }
 
 func (v DecimalClassifier) Validate(value string) (err error) {
-	_, err = strconv.ParseFloat(value, 64)
+	var f float64
+
+	f, err = strconv.ParseFloat(value, 64)
 	if err != nil {
 		err = NewErr(pvt.ErrInvalidDecimalFormat, "value", value, err)
+		goto end
+	}
+	if !v.AllowNonFinite {
+		err = rejectNonFinite(f, value, pvt.ErrInvalidDecimalFormat)
 	}
+end:
 	return err
 }
 
@@ -38,6 +47,11 @@ func (DecimalClassifier) Example() any {
 	return 1.23 // TODO Might need to consider format constraints
 }
 
+// RandomExample returns a decimal with two decimal places.
+func (DecimalClassifier) RandomExample(r *rand.Rand) any {
+	return randomFloat(r, 0, 1000, 2)
+}
+
 func (DecimalClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.DecimalTypeSlug
 }
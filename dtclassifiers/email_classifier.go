@@ -2,6 +2,7 @@ package dtclassifiers
 
 import (
 	"errors"
+	"math/rand/v2"
 	"regexp"
 
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -12,6 +13,7 @@ func init() {
 }
 
 var _ pvt.DataTypeClassifier = (*EmailClassifier)(nil)
+var _ pvt.RandomExampler = (*EmailClassifier)(nil)
 
 type EmailClassifier struct {
 	*pvt.BaseDataTypeClassifier
@@ -44,6 +46,11 @@ func (v EmailClassifier) MakeNew(args *pvt.DataTypeClassifierArgs) pvt.DataTypeC
 func (EmailClassifier) Example() any {
 	return "user@example.com"
 }
+
+// RandomExample returns an address at example.com.
+func (EmailClassifier) RandomExample(r *rand.Rand) any {
+	return randomWord(r) + "@example.com"
+}
 func (EmailClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.EmailTypeSlug
 }
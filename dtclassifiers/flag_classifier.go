@@ -0,0 +1,54 @@
+package dtclassifiers
+
+import (
+	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvt.RegisterDataTypeClassifier(&FlagClassifier{})
+}
+
+var _ pvt.DataTypeClassifier = (*FlagClassifier)(nil)
+
+// FlagClassifier handles presence-only query flags. A bare ?verbose or
+// ?verbose= is matched as "true", an absent flag defaults to "false", and
+// an explicit ?verbose=true or ?verbose=false is honored as given.
+type FlagClassifier struct {
+	*pvt.BaseDataTypeClassifier
+}
+
+func (v FlagClassifier) Validate(value string) (err error) {
+	switch value {
+	case "", "true", "false":
+		goto end
+	}
+	err = NewErr(
+		pvt.ErrInvalidFlagFormat,
+		"allowed_values", ",true,false",
+	)
+end:
+	return err
+}
+
+func (v FlagClassifier) DataType() pvt.PVDataType {
+	return pvt.FlagType
+}
+
+func (v FlagClassifier) MakeNew(args *pvt.DataTypeClassifierArgs) pvt.DataTypeClassifier {
+	return &FlagClassifier{
+		BaseDataTypeClassifier: pvt.NewBaseDataTypeClassifier(v, args),
+	}
+}
+
+func (FlagClassifier) Example() any {
+	return "true"
+}
+
+func (FlagClassifier) Slug() pvt.PVDataTypeSlug {
+	return pvt.FlagTypeSlug
+}
+
+func (FlagClassifier) DefaultValue() *string {
+	f := "false"
+	return &f
+}
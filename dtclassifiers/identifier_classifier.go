@@ -1,6 +1,7 @@
 package dtclassifiers
 
 import (
+	"math/rand/v2"
 	"regexp"
 
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -11,6 +12,7 @@ func init() {
 }
 
 var _ pvt.DataTypeClassifier = (*IdentifierClassifier)(nil)
+var _ pvt.RandomExampler = (*IdentifierClassifier)(nil)
 
 type IdentifierClassifier struct {
 	*pvt.BaseDataTypeClassifier
@@ -45,6 +47,11 @@ func (v IdentifierClassifier) MakeNew(args *pvt.DataTypeClassifierArgs) pvt.Data
 func (IdentifierClassifier) Example() any {
 	return "id"
 }
+
+// RandomExample returns a lowercase identifier of six characters.
+func (IdentifierClassifier) RandomExample(r *rand.Rand) any {
+	return randomString(r, lowerLetters, 1) + randomString(r, lowerAlnum+"_", 5)
+}
 func (IdentifierClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.IdentifierTypeSlug
 }
@@ -1,6 +1,7 @@
 package dtclassifiers
 
 import (
+	"math/rand/v2"
 	"strconv"
 
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -8,10 +9,11 @@ import (
 
 func init() {
 	pvt.RegisterDataTypeClassifier(&IntegerClassifier{})
-	pvt.RegisterDataTypeAlias(pvt.IntegerType, pvt.IntTypeSlug)
+	mustRegisterAlias(pvt.IntTypeSlug, pvt.IntegerTypeSlug)
 }
 
 var _ pvt.DataTypeClassifier = (*IntegerClassifier)(nil)
+var _ pvt.RandomExampler = (*IntegerClassifier)(nil)
 
 type IntegerClassifier struct {
 	*pvt.BaseDataTypeClassifier
@@ -39,6 +41,11 @@ func (IntegerClassifier) Example() any {
 	return 123
 }
 
+// RandomExample returns an integer from 1 to 1000.
+func (IntegerClassifier) RandomExample(r *rand.Rand) any {
+	return r.Int64N(1000) + 1
+}
+
 func (IntegerClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.IntegerTypeSlug
 }
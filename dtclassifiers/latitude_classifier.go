@@ -0,0 +1,93 @@
+package dtclassifiers
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strconv"
+
+	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvt.RegisterDataTypeClassifier(&LatitudeClassifier{})
+}
+
+var _ pvt.DataTypeClassifier = (*LatitudeClassifier)(nil)
+var _ pvt.RandomExampler = (*LatitudeClassifier)(nil)
+var _ pvt.DataTypeErrorSuggester = (*LatitudeClassifier)(nil)
+
+// maxLatitude is the largest absolute latitude in decimal degrees.
+const maxLatitude = 90
+
+type LatitudeClassifier struct {
+	*pvt.BaseDataTypeClassifier
+}
+
+func (v LatitudeClassifier) Validate(value string) error {
+	return validateCoordinate(value, maxLatitude, pvt.ErrInvalidLatitudeFormat)
+}
+
+func (v LatitudeClassifier) DataType() pvt.PVDataType {
+	return pvt.LatitudeType
+}
+
+func (v LatitudeClassifier) MakeNew(args *pvt.DataTypeClassifierArgs) pvt.DataTypeClassifier {
+	return &LatitudeClassifier{
+		BaseDataTypeClassifier: pvt.NewBaseDataTypeClassifier(v, args),
+	}
+}
+
+func (LatitudeClassifier) Example() any {
+	return 40.7128
+}
+
+// RandomExample returns a latitude rounded to four decimal places.
+func (LatitudeClassifier) RandomExample(r *rand.Rand) any {
+	return randomFloat(r, -maxLatitude, maxLatitude, 4)
+}
+
+func (LatitudeClassifier) Slug() pvt.PVDataTypeSlug {
+	return pvt.LatitudeTypeSlug
+}
+
+func (LatitudeClassifier) DefaultValue() *string {
+	return nil
+}
+
+func (LatitudeClassifier) ErrorSuggestion(param *pvt.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is a latitude in decimal degrees from -90 (south) to 90 (north), for example: %s",
+		param.Name,
+		example,
+	)
+}
+
+// validateCoordinate checks that value is a finite decimal number of degrees
+// within [-limit, limit], reporting formatErr when it is not a number.
+func validateCoordinate(value string, limit float64, formatErr error) (err error) {
+	var degrees float64
+
+	degrees, err = strconv.ParseFloat(value, 64)
+	if err != nil {
+		err = NewErr(formatErr, "value", value, err)
+		goto end
+	}
+
+	if math.IsNaN(degrees) || math.IsInf(degrees, 0) {
+		err = NewErr(formatErr, "value", value)
+		goto end
+	}
+
+	if degrees < -limit || degrees > limit {
+		err = NewErr(
+			formatErr,
+			pvt.ErrCoordinateOutOfRange,
+			"value", value,
+			"minimum", -limit,
+			"maximum", limit,
+		)
+	}
+
+end:
+	return err
+}
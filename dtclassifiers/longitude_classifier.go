@@ -0,0 +1,61 @@
+package dtclassifiers
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvt.RegisterDataTypeClassifier(&LongitudeClassifier{})
+}
+
+var _ pvt.DataTypeClassifier = (*LongitudeClassifier)(nil)
+var _ pvt.RandomExampler = (*LongitudeClassifier)(nil)
+var _ pvt.DataTypeErrorSuggester = (*LongitudeClassifier)(nil)
+
+// maxLongitude is the largest absolute longitude in decimal degrees.
+const maxLongitude = 180
+
+type LongitudeClassifier struct {
+	*pvt.BaseDataTypeClassifier
+}
+
+func (v LongitudeClassifier) Validate(value string) error {
+	return validateCoordinate(value, maxLongitude, pvt.ErrInvalidLongitudeFormat)
+}
+
+func (v LongitudeClassifier) DataType() pvt.PVDataType {
+	return pvt.LongitudeType
+}
+
+func (v LongitudeClassifier) MakeNew(args *pvt.DataTypeClassifierArgs) pvt.DataTypeClassifier {
+	return &LongitudeClassifier{
+		BaseDataTypeClassifier: pvt.NewBaseDataTypeClassifier(v, args),
+	}
+}
+
+func (LongitudeClassifier) Example() any {
+	return -74.006
+}
+
+// RandomExample returns a longitude rounded to four decimal places.
+func (LongitudeClassifier) RandomExample(r *rand.Rand) any {
+	return randomFloat(r, -maxLongitude, maxLongitude, 4)
+}
+
+func (LongitudeClassifier) Slug() pvt.PVDataTypeSlug {
+	return pvt.LongitudeTypeSlug
+}
+
+func (LongitudeClassifier) DefaultValue() *string {
+	return nil
+}
+
+func (LongitudeClassifier) ErrorSuggestion(param *pvt.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is a longitude in decimal degrees from -180 (west) to 180 (east), for example: %s",
+		param.Name,
+		example,
+	)
+}
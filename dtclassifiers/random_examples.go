@@ -0,0 +1,34 @@
+package dtclassifiers
+
+import (
+	"math"
+	"math/rand/v2"
+	"strings"
+)
+
+const (
+	lowerLetters = "abcdefghijklmnopqrstuvwxyz"
+	lowerAlnum   = lowerLetters + "0123456789"
+)
+
+// randomString returns n characters chosen from alphabet using r.
+func randomString(r *rand.Rand, alphabet string, n int) string {
+	sb := strings.Builder{}
+	sb.Grow(n)
+	for range n {
+		sb.WriteByte(alphabet[r.IntN(len(alphabet))])
+	}
+	return sb.String()
+}
+
+// randomWord returns a lowercase word of 3 to 8 letters.
+func randomWord(r *rand.Rand) string {
+	return randomString(r, lowerLetters, 3+r.IntN(6))
+}
+
+// randomFloat returns a value in [min, max) rounded to the given number of
+// decimal places, so examples stay short.
+func randomFloat(r *rand.Rand, min, max float64, places int) float64 {
+	scale := math.Pow10(places)
+	return math.Round((min+r.Float64()*(max-min))*scale) / scale
+}
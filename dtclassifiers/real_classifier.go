@@ -1,6 +1,8 @@
 package dtclassifiers
 
 import (
+	"math"
+	"math/rand/v2"
 	"strconv"
 
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -11,15 +13,32 @@ func init() {
 }
 
 var _ pvt.DataTypeClassifier = (*RealClassifier)(nil)
+var _ pvt.RandomExampler = (*RealClassifier)(nil)
 
 type RealClassifier struct {
 	*pvt.BaseDataTypeClassifier
 }
 
 func (v RealClassifier) Validate(value string) (err error) {
-	_, err = strconv.ParseFloat(value, 64)
+	var f float64
+
+	f, err = strconv.ParseFloat(value, 64)
 	if err != nil {
 		err = NewErr(pvt.ErrInvalidRealFormat, "value", value, err)
+		goto end
+	}
+	if !v.AllowNonFinite {
+		err = rejectNonFinite(f, value, pvt.ErrInvalidRealFormat)
+	}
+end:
+	return err
+}
+
+// rejectNonFinite returns an error wrapping formatErr when f, parsed from
+// value, is NaN, Inf or -Inf, which strconv.ParseFloat() accepts.
+func rejectNonFinite(f float64, value string, formatErr error) (err error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		err = NewErr(formatErr, pvt.ErrNonFiniteNumber, "value", value)
 	}
 	return err
 }
@@ -37,6 +56,11 @@ func (v RealClassifier) MakeNew(args *pvt.DataTypeClassifierArgs) pvt.DataTypeCl
 func (RealClassifier) Example() any {
 	return 1.2345
 }
+
+// RandomExample returns a real number rounded to four decimal places.
+func (RealClassifier) RandomExample(r *rand.Rand) any {
+	return randomFloat(r, -1000, 1000, 4)
+}
 func (RealClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.RealTypeSlug
 }
@@ -1,6 +1,7 @@
 package dtclassifiers
 
 import (
+	"math/rand/v2"
 	"regexp"
 
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -11,6 +12,7 @@ func init() {
 }
 
 var _ pvt.DataTypeClassifier = (*SlugClassifier)(nil)
+var _ pvt.RandomExampler = (*SlugClassifier)(nil)
 
 type SlugClassifier struct {
 	*pvt.BaseDataTypeClassifier
@@ -47,6 +49,11 @@ func (v SlugClassifier) MakeNew(args *pvt.DataTypeClassifierArgs) pvt.DataTypeCl
 func (SlugClassifier) Example() any {
 	return "abc-123"
 }
+
+// RandomExample returns a slug such as "kqzt-7a2".
+func (SlugClassifier) RandomExample(r *rand.Rand) any {
+	return randomWord(r) + "-" + randomString(r, lowerAlnum, 3)
+}
 func (SlugClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.SlugTypeSlug
 }
@@ -1,6 +1,8 @@
 package dtclassifiers
 
 import (
+	"math/rand/v2"
+
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
 )
 
@@ -9,6 +11,7 @@ func init() {
 }
 
 var _ pvt.DataTypeClassifier = (*StringClassifier)(nil)
+var _ pvt.RandomExampler = (*StringClassifier)(nil)
 
 type StringClassifier struct {
 	*pvt.BaseDataTypeClassifier
@@ -33,6 +36,11 @@ func (StringClassifier) Example() any {
 	return "abc"
 }
 
+// RandomExample returns a lowercase word.
+func (StringClassifier) RandomExample(r *rand.Rand) any {
+	return randomWord(r)
+}
+
 func (StringClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.StringTypeSlug
 }
@@ -2,6 +2,8 @@ package dtclassifiers
 
 import (
 	"errors"
+	"fmt"
+	"math/rand/v2"
 	"regexp"
 
 	pvt "github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -12,6 +14,7 @@ func init() {
 }
 
 var _ pvt.DataTypeClassifier = (*UUIDClassifier)(nil)
+var _ pvt.RandomExampler = (*UUIDClassifier)(nil)
 
 type UUIDClassifier struct {
 	*pvt.BaseDataTypeClassifier
@@ -46,6 +49,16 @@ func (UUIDClassifier) Example() any {
 	// This UUID is the example of a UUID from RFC 9562
 	return "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"
 }
+
+// RandomExample returns a version 4 UUID whose random bits come from r.
+func (UUIDClassifier) RandomExample(r *rand.Rand) any {
+	hi, lo := r.Uint64(), r.Uint64()
+	hi = hi&^0xf000 | 0x4000           // version 4
+	lo = lo&^(0xc000<<48) | 0x8000<<48 // RFC 9562 variant
+	return fmt.Sprintf("%08x-%04x-%04x-%04x-%012x",
+		hi>>32, hi>>16&0xffff, hi&0xffff, lo>>48, lo&0xffffffffffff,
+	)
+}
 func (UUIDClassifier) Slug() pvt.PVDataTypeSlug {
 	return pvt.UUIDTypeSlug
 }
@@ -0,0 +1,123 @@
+package pathvars
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// dumpGroup collects the routes sharing a method and leading literal.
+type dumpGroup struct {
+	method  HTTPMethod
+	literal string
+	routes  []*Route
+}
+
+// Dump writes a human-readable tree of the router's routes to w for
+// debugging large route tables. Routes are grouped by method and then by
+// leading literal segment, as reported by ParsedTemplate.FirstLiteral(), with
+// each group listed in the order Match() first reaches it:
+//
+//	GET
+//	  /users
+//	    [0] /users/{id:int:range[1..100]} - Get user
+//	        id: integer (path) range[1..100]
+//	  (parameter)
+//	    [2] /{category}/items
+//	        category: string (path)
+//
+// Write errors are ignored, as Dump is a developer tool and not intended for
+// the hot path.
+func (r *Router) Dump(w io.Writer) {
+	var method HTTPMethod
+
+	for _, group := range r.dumpGroups() {
+		if group.method != method {
+			method = group.method
+			_, _ = fmt.Fprintln(w, method)
+		}
+		if group.literal == "" {
+			_, _ = fmt.Fprintln(w, "  (parameter)")
+		} else {
+			_, _ = fmt.Fprintf(w, "  /%s\n", group.literal)
+		}
+		for _, route := range group.routes {
+			dumpRoute(w, route)
+		}
+	}
+}
+
+// dumpGroups returns the router's routes grouped by method, then by leading
+// literal, in order of first appearance.
+func (r *Router) dumpGroups() (groups []dumpGroup) {
+	var methods []HTTPMethod
+	byMethod := make(map[HTTPMethod][]dumpGroup)
+
+	for _, route := range r.routes {
+		method := route.Method
+		if method == "" {
+			method = MethodAny
+		}
+		literal, _ := route.ParsedTemplate.FirstLiteral()
+
+		methodGroups, seen := byMethod[method]
+		if !seen {
+			methods = append(methods, method)
+		}
+		i := 0
+		for i < len(methodGroups) && methodGroups[i].literal != literal {
+			i++
+		}
+		if i == len(methodGroups) {
+			methodGroups = append(methodGroups, dumpGroup{method: method, literal: literal})
+		}
+		methodGroups[i].routes = append(methodGroups[i].routes, route)
+		byMethod[method] = methodGroups
+	}
+	for _, method := range methods {
+		groups = append(groups, byMethod[method]...)
+	}
+	return groups
+}
+
+// dumpRoute writes one route and its parameters.
+func dumpRoute(w io.Writer, route *Route) {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "    [%d] %s", route.Index, route.ParsedTemplate.Original())
+	if route.Host != "" {
+		sb.WriteString(" @" + route.Host)
+	}
+	if route.Priority != 0 {
+		fmt.Fprintf(&sb, " (priority %d)", route.Priority)
+	}
+	if route.Description != "" {
+		sb.WriteString(" - " + route.Description)
+	}
+	_, _ = fmt.Fprintln(w, sb.String())
+
+	for param := range route.ParsedTemplate.params.Values() {
+		_, _ = fmt.Fprintln(w, "        "+dumpParameter(param))
+	}
+}
+
+// dumpParameter describes param as "name: type (location[, flags]) constraints".
+func dumpParameter(param Parameter) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s: %s (%s", param.Name, param.DataTypeSlug(), param.Location().Slug())
+	if param.MultiSegment {
+		sb.WriteString(", multi-segment")
+	}
+	if param.Optional {
+		sb.WriteString(", optional")
+	}
+	if param.DefaultValue != nil {
+		fmt.Fprintf(&sb, ", default %q", *param.DefaultValue)
+	}
+	sb.WriteByte(')')
+	if len(param.Constraints()) != 0 {
+		sb.WriteString(" " + Constraints(param.Constraints()).String())
+	}
+	return sb.String()
+}
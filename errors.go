@@ -30,6 +30,9 @@ var (
 	// ErrEmptyTemplate indicates that the template string is empty.
 	ErrEmptyTemplate = errors.New("empty template")
 
+	// ErrTemplateTooComplex indicates that a template exceeds MaxTemplateLength or MaxTemplateParameters.
+	ErrTemplateTooComplex = errors.New("template too complex")
+
 	// ErrUnmatchedClosingBrace indicates an unmatched closing brace in template.
 	ErrUnmatchedClosingBrace = errors.New("unmatched closing brace")
 
@@ -39,6 +42,21 @@ var (
 	// ErrMalformedBraces indicates a closing brace before and opening brace
 	ErrMalformedBraces = errors.New("malformed brace; '{' must precede '}'")
 
+	// ErrAdjacentSegmentParameters indicates that two parameters in one path segment have no literal text between them, e.g. "{a}{b}".
+	ErrAdjacentSegmentParameters = errors.New("parameters in a path segment must be separated by literal text")
+
+	// ErrDuplicateParameterName indicates that a template declares the same parameter name more than once, e.g. "/users/{id}?{id:int}".
+	ErrDuplicateParameterName = errors.New("duplicate parameter name")
+
+	// ErrConflictingTemplateMethods indicates that ParsedTemplate.Concat() was given a tail whose method prefix differs from the base template's, e.g. "GET /api" and "POST /users".
+	ErrConflictingTemplateMethods = errors.New("conflicting template methods")
+
+	// ErrInvalidColonParameter indicates that a ColonSyntax path segment beginning with ':' is not a valid ":name" parameter, e.g. ":id.json".
+	ErrInvalidColonParameter = errors.New("invalid colon parameter")
+
+	// ErrOptionalColonParameter indicates that a ColonSyntax template has an Express-style optional ":name?" segment, which is rejected because path parameters cannot be omitted.
+	ErrOptionalColonParameter = errors.New("optional colon parameter not supported")
+
 	// Router Errors
 
 	// ErrNoRouteMatched indicates that no route matched the request.
@@ -47,6 +65,65 @@ var (
 	// ErrNoMatch indicates that no route matched the incoming request.
 	ErrNoMatch = errors.New("no matching route")
 
+	// ErrPathMismatch indicates where a path diverges from the template of the
+	// route that came closest to matching it, reported with RouterArgs.DescribeMismatch.
+	ErrPathMismatch = errors.New("path does not fit the closest route's template")
+
+	// ErrSegmentCountMismatch indicates that a path has more or fewer segments than a template accepts, e.g. /users/123/extra for /users/{id:int}.
+	ErrSegmentCountMismatch = errors.New("path has a different number of segments than the template")
+
+	// ErrSegmentLiteralMismatch indicates that a path segment differs from the literal text of a template segment, e.g. /posts/123 for /users/{id:int}.
+	ErrSegmentLiteralMismatch = errors.New("path segment does not match the template's literal text")
+
+	// ErrMethodNotAllowed indicates that routes matched the request's path but none served its method, or that RouterArgs.AllowedMethods rejected the method.
+	ErrMethodNotAllowed = errors.New("method not allowed")
+
+	// ErrRouteHasNoHandler indicates that a request matched a route that has no handler to serve it.
+	ErrRouteHasNoHandler = errors.New("matched route has no handler")
+
+	// ErrNoHandlerRoutes indicates that the value passed to RouterFromStruct() does not provide handler routes.
+	ErrNoHandlerRoutes = errors.New("value does not provide handler routes")
+
+	// ErrInvalidHandlerRoute indicates that a HandlerRoute has no usable handler.
+	ErrInvalidHandlerRoute = errors.New("invalid handler route")
+
+	// ErrInvalidRouteHost indicates that a route's host pattern is malformed, e.g. "api.*.com".
+	ErrInvalidRouteHost = errors.New("invalid route host")
+
+	// ErrInvalidMountPrefix indicates that a sub-router could not be mounted under a prefix, e.g. "/admin?{q}".
+	ErrInvalidMountPrefix = errors.New("invalid mount prefix")
+
+	// ErrRouteIndexNotFound indicates that Router.URLFor() was given an index no route has.
+	ErrRouteIndexNotFound = errors.New("no route has index")
+
+	// ErrInvalidRouteConfig indicates that a route config passed to Router.LoadRoutesJSON() could not be read.
+	ErrInvalidRouteConfig = errors.New("invalid route config")
+
+	// ErrRouteConfigNotArray indicates that a route config is not a JSON array of routes.
+	ErrRouteConfigNotArray = errors.New("route config must be a JSON array")
+
+	// Code Generation Errors
+
+	// ErrInvalidRouteSpec indicates that a RouteSpec passed to GenerateGoConstants() cannot produce valid Go identifiers.
+	ErrInvalidRouteSpec = errors.New("invalid route spec")
+
+	// ErrDuplicateGoIdentifier indicates that two RouteSpecs would generate the same Go identifier, e.g. two routes named "User".
+	ErrDuplicateGoIdentifier = errors.New("duplicate generated Go identifier")
+
+	// ErrGoCodeGenerationFailed indicates that GenerateGoConstants() produced source that could not be formatted.
+	ErrGoCodeGenerationFailed = errors.New("go code generation failed")
+
+	// Router Encoding Errors
+
+	// ErrInvalidRouterEncoding indicates that data passed to Router.UnmarshalBinary() could not be decoded.
+	ErrInvalidRouterEncoding = errors.New("invalid router encoding")
+
+	// ErrUnsupportedRouterEncodingVersion indicates that encoded router data was written by an incompatible version.
+	ErrUnsupportedRouterEncodingVersion = errors.New("unsupported router encoding version")
+
+	// ErrParameterNotEncodable indicates that a parameter uses a DefaultFunc or AsyncValidator, which cannot be encoded.
+	ErrParameterNotEncodable = errors.New("parameter with DefaultFunc or AsyncValidator cannot be encoded")
+
 	// Other Errors
 
 	// ErrParsingDBExtensionFailed indicates that parsing a database extension failed.
@@ -66,4 +143,97 @@ var (
 
 	// ErrQueryParameterNotFoundInValuesMap indicates that a query parameter was not found in the values map.
 	ErrQueryParameterNotFoundInValuesMap = errors.New("query parameter not found in values map")
+
+	// ErrUnencodedReservedChar indicates that a path segment contains a raw reserved character, such as "#", while RouterArgs.StrictReservedChars is set.
+	ErrUnencodedReservedChar = errors.New("path segment contains unencoded reserved character")
+
+	// ErrValuesTooLarge indicates that a request's raw path and query, and so its values, total more than RouterArgs.MaxValuesSize bytes.
+	ErrValuesTooLarge = errors.New("request values exceed maximum total size")
+
+	// ErrRegexpNotApplied indicates that AddRoute() rejected a parameter whose ParameterArgs.Regex could not be applied because the constraint registered for "regex" does not implement RegexpConstraint.
+	ErrRegexpNotApplied = errors.New("pre-compiled regexp cannot be applied to parameter")
+
+	// Cross Check Errors
+
+	// ErrCrossCheckFailed indicates that a request's values failed one of a route's RouteArgs.CrossChecks.
+	ErrCrossCheckFailed = errors.New("cross-parameter check failed")
+
+	// ErrDateRangeReversed indicates that the start date of a DateRange() check is after its end date.
+	ErrDateRangeReversed = errors.New("start date is after end date")
+
+	// ErrInvalidCrossCheck indicates that AddRoute() rejected one of a route's RouteArgs.CrossChecks via its TemplateVerifier.
+	ErrInvalidCrossCheck = errors.New("invalid cross check")
+
+	// ErrCrossCheckParameterNotFound indicates that a cross check names a parameter the route does not declare.
+	ErrCrossCheckParameterNotFound = errors.New("cross check parameter not declared by route")
+
+	// ErrCrossCheckParameterNotDate indicates that a DateRange() check names a parameter that is not a date parameter of the route.
+	ErrCrossCheckParameterNotDate = errors.New("cross check parameter is not a date parameter")
+
+	// ErrRouteNotEncodable indicates that a route uses CrossChecks or a PathValidator, which cannot be encoded.
+	ErrRouteNotEncodable = errors.New("route with CrossChecks or a PathValidator cannot be encoded")
+
+	// ErrPathValidationFailed indicates that a request's path values failed a route's RouteArgs.PathValidator.
+	ErrPathValidationFailed = errors.New("path validation failed")
+
+	// Parameter Group Errors
+
+	// ErrExactlyOneViolated indicates that a request did not provide precisely one parameter of a RouteArgs.ExactlyOne group.
+	ErrExactlyOneViolated = errors.New("exactly one of the parameters must be provided")
+
+	// ErrInvalidExactlyOneGroup indicates that a RouteArgs.ExactlyOne group cannot be enforced for the route.
+	ErrInvalidExactlyOneGroup = errors.New("invalid exactly-one parameter group")
+
+	// ErrExactlyOneGroupTooSmall indicates that a RouteArgs.ExactlyOne group has fewer than two members.
+	ErrExactlyOneGroupTooSmall = errors.New("exactly-one group needs at least two parameters")
+
+	// ErrExactlyOneParameterNotFound indicates that a RouteArgs.ExactlyOne group names a parameter the route does not declare.
+	ErrExactlyOneParameterNotFound = errors.New("exactly-one group parameter not declared by route")
+
+	// ErrRequiredWithViolated indicates that a request provided a parameter without a parameter it requires via RouteArgs.RequiredWith.
+	ErrRequiredWithViolated = errors.New("parameter requires another parameter")
+
+	// ErrInvalidRequiredWith indicates that a RouteArgs.RequiredWith dependency cannot be enforced for the route.
+	ErrInvalidRequiredWith = errors.New("invalid required-with dependency")
+
+	// ErrRequiredWithParameterNotFound indicates that a RouteArgs.RequiredWith dependency names a parameter the route does not declare.
+	ErrRequiredWithParameterNotFound = errors.New("required-with parameter not declared by route")
+
+	// Request Body Errors
+
+	// ErrRequestBodyRequired indicates that a route requires a request body but none was sent.
+	ErrRequestBodyRequired = errors.New("request body required")
+
+	// ErrUnsupportedContentType indicates that the request's Content-Type is not accepted by the route.
+	ErrUnsupportedContentType = errors.New("unsupported content type")
+
+	// ErrInvalidContentType indicates that the request's Content-Type header could not be parsed.
+	ErrInvalidContentType = errors.New("invalid content type")
+
+	// Async Validation Errors
+
+	// ErrAsyncValidationFailed indicates that a parameter's AsyncValidator rejected its value.
+	ErrAsyncValidationFailed = errors.New("async validation failed")
+
+	// ErrAsyncValidationCanceled indicates that the request was canceled before its AsyncValidator finished.
+	ErrAsyncValidationCanceled = errors.New("async validation canceled")
+
+	// ErrAsyncValidationTimeout indicates that a parameter's AsyncValidator did not finish in time.
+	ErrAsyncValidationTimeout = errors.New("async validation timed out")
+
+	// ErrAsyncValidationUnavailable indicates that a parameter's AsyncValidator could not check its value, reporting a ServerFaultSource error such as a backend outage.
+	ErrAsyncValidationUnavailable = errors.New("async validation unavailable")
+
+	// Client Certificate Errors
+
+	// ErrClientCertRejected indicates that a route requires a client certificate the request did not satisfy.
+	ErrClientCertRejected = errors.New("client certificate rejected")
+
+	// ErrClientCertMissing indicates that a route requires a verified client certificate but the request has none.
+	ErrClientCertMissing = errors.New("verified client certificate required")
+
+	// Content Negotiation Errors
+
+	// ErrNotAcceptable indicates that a route matched the path but none of its Produces types satisfy the Accept header.
+	ErrNotAcceptable = errors.New("no acceptable content type")
 )
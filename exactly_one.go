@@ -0,0 +1,72 @@
+package pathvars
+
+import (
+	"slices"
+	"strings"
+)
+
+// requireExactlyOne returns an error for the first of the route's ExactlyOne
+// groups of which the request did not provide precisely one member. Defaults
+// do not count as provided.
+func (r Route) requireExactlyOne(provided []Identifier) (err error) {
+	for _, group := range r.ExactlyOne {
+		var present []Identifier
+		for _, name := range group {
+			if slices.Contains(provided, name) {
+				present = append(present, name)
+			}
+		}
+		if len(present) == 1 {
+			continue
+		}
+		err = NewErr(
+			ErrExactlyOneViolated,
+			"parameters", joinIdentifiers(group),
+			"provided", joinIdentifiers(present),
+			"provided_count", len(present),
+			"endpoint", r.Endpoint(),
+			"fault_source", ClientFaultSource.Slug(),
+		)
+		goto end
+	}
+end:
+	return err
+}
+
+// checkExactlyOne verifies that each member of groups names one of pt's
+// parameters, so a typo cannot make a group impossible to satisfy.
+func checkExactlyOne(pt *ParsedTemplate, groups [][]Identifier) (err error) {
+	for _, group := range groups {
+		if len(group) < 2 {
+			err = NewErr(
+				ErrInvalidExactlyOneGroup,
+				ErrExactlyOneGroupTooSmall,
+				"parameters", joinIdentifiers(group),
+			)
+			goto end
+		}
+		for _, name := range group {
+			_, exists := pt.params.Get(name)
+			if !exists {
+				err = NewErr(
+					ErrInvalidExactlyOneGroup,
+					ErrExactlyOneParameterNotFound,
+					"parameter", name,
+					"parameters", joinIdentifiers(group),
+				)
+				goto end
+			}
+		}
+	}
+end:
+	return err
+}
+
+// joinIdentifiers formats names as a comma-separated list, e.g. "id, email".
+func joinIdentifiers(names []Identifier) string {
+	s := make([]string, len(names))
+	for i, name := range names {
+		s[i] = string(name)
+	}
+	return strings.Join(s, ", ")
+}
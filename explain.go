@@ -0,0 +1,204 @@
+package pathvars
+
+import (
+	"net/http"
+	"slices"
+)
+
+// MatchExplanation describes how a request fared against every route of a
+// router. Where Match() stops at the first route whose path matches and
+// returns a single error, Explain() keeps going so contract tests and
+// debugging tools can see which routes came close and why they failed.
+type MatchExplanation struct {
+	// Method and Path are the request's method and URL path.
+	Method HTTPMethod
+	Path   string
+
+	// MethodAllowed is false when RouterArgs.AllowedMethods rejects Method,
+	// in which case Routes is empty.
+	MethodAllowed bool
+
+	// Routes has one entry per route, in the order Match() tries them.
+	Routes []RouteExplanation
+
+	// Result and Err are exactly what Match() returns for the request.
+	Result MatchResult
+	Err    error
+}
+
+// RouteExplanation describes a request checked against a single route.
+// Parameters and Errors are only populated when PathMatched is true, since
+// values cannot be extracted from a path that does not fit the template.
+// Parameters is also empty for a request exceeding RouterArgs.MaxValuesSize.
+type RouteExplanation struct {
+	Route *Route
+
+	MethodMatched bool
+	HostMatched   bool
+	PathMatched   bool
+
+	// Parameters lists each of the route's parameters with its value and
+	// validation result.
+	Parameters []ParameterExplanation
+
+	// Errors collects every error the route produced, including parameter
+	// validation, ClientCert, StrictReservedChars, MaxValuesSize,
+	// PathValidator, RequireAllQuery, ExactlyOne, RequiredWith, CrossChecks,
+	// content negotiation and request body checks, rather than only the
+	// first. Async validators only run, once per Explain() call, when the
+	// method, host and every other check passed, as in Match().
+	Errors []error
+}
+
+// Matched reports whether the route accepts the request.
+func (re RouteExplanation) Matched() bool {
+	return re.MethodMatched && re.HostMatched && re.PathMatched && len(re.Errors) == 0
+}
+
+// ParameterFailures returns the parameters that failed validation or were
+// required but not provided.
+func (re RouteExplanation) ParameterFailures() (failures []ParameterExplanation) {
+	for _, pe := range re.Parameters {
+		if pe.Err != nil {
+			failures = append(failures, pe)
+		}
+	}
+	return failures
+}
+
+// ParameterExplanation describes one parameter of a path-matched route.
+type ParameterExplanation struct {
+	Name     Identifier
+	Location LocationType
+
+	// Value is the value extracted from the request or its default, and
+	// Provided reports whether it came from the request.
+	Value    string
+	Provided bool
+
+	// Err is nil when the parameter validated.
+	Err error
+}
+
+// Explain checks req against every route and reports, per route, whether the
+// method, host and path matched, how each parameter validated, and every
+// error encountered. It is an introspection counterpart to Match() and is
+// not intended for the hot path.
+func (r *Router) Explain(req *http.Request) (explanation MatchExplanation) {
+	asyncErrs := make(map[*Route]error)
+
+	explanation = MatchExplanation{
+		Method:        HTTPMethod(req.Method),
+		Path:          req.URL.Path,
+		MethodAllowed: r.allowsMethod(req.Method),
+	}
+	explanation.Result, explanation.Err = r.match(req, asyncErrs)
+	if !explanation.MethodAllowed {
+		goto end
+	}
+
+	explanation.Routes = make([]RouteExplanation, len(r.routes))
+	for i, route := range r.routes {
+		explanation.Routes[i] = r.explainRoute(route, req, asyncErrs)
+	}
+
+end:
+	return explanation
+}
+
+// explainRoute checks req against route without stopping at the first error,
+// canonicalizing the path and applying the router's request checks as Match()
+// does so the two agree. As in Match(), async validators only run when
+// everything else passed, and asyncErrs supplies the outcome for a route
+// Match() already validated.
+func (r *Router) explainRoute(route *Route, req *http.Request, asyncErrs map[*Route]error) (re RouteExplanation) {
+	var attempt MatchAttempt
+	var canonical string
+	var ok bool
+	var err error
+	var validated bool
+
+	re = RouteExplanation{
+		Route:         route,
+		MethodMatched: route.MatchesMethod(req.Method),
+		HostMatched:   route.MatchesHost(req.Host),
+	}
+
+	canonical, ok = r.matchingPath(route, req.URL.Path)
+	if !ok {
+		goto end
+	}
+	err = r.checkValuesSize(req.URL.Path, req.URL.RawQuery)
+	if err != nil {
+		// Match() extracts no values from an oversized request either
+		re.PathMatched = true
+		re.Errors = appendErr(re.Errors, err)
+		goto end
+	}
+	attempt, err = route.ParsedTemplate.Match(canonical, req.URL.RawQuery)
+	re.PathMatched = attempt.PathMatched
+	if !re.PathMatched {
+		goto end
+	}
+
+	for param := range route.ParsedTemplate.params.Values() {
+		re.Parameters = append(re.Parameters, explainParameter(param, attempt))
+	}
+
+	re.Errors = appendErr(re.Errors, route.checkClientCert(req))
+	re.Errors = appendErr(re.Errors, r.checkReservedChars(req, route, canonical, attempt))
+	re.Errors = appendErr(re.Errors, err)
+	re.Errors = appendErr(re.Errors, route.validatePath(attempt.ValuesMap))
+	re.Errors = appendErr(re.Errors, route.requireAllQuery(attempt, req.URL.RawQuery))
+	re.Errors = appendErr(re.Errors, route.requireExactlyOne(attempt.Provided))
+	re.Errors = appendErr(re.Errors, route.requireWith(attempt.Provided))
+	re.Errors = appendErr(re.Errors, route.crossCheck(attempt.ValuesMap))
+	_, err = route.negotiate(req)
+	re.Errors = appendErr(re.Errors, err)
+	re.Errors = appendErr(re.Errors, route.validateBody(req))
+
+	if !re.MethodMatched || !re.HostMatched || len(re.Errors) != 0 {
+		goto end
+	}
+	err, validated = asyncErrs[route]
+	if !validated {
+		err = route.validateAsync(req.Context(), attempt.ValuesMap)
+	}
+	re.Errors = appendErr(re.Errors, err)
+
+end:
+	return re
+}
+
+// explainParameter reports param's value in attempt and whether it validates.
+func explainParameter(param Parameter, attempt MatchAttempt) (pe ParameterExplanation) {
+	pe = ParameterExplanation{
+		Name:     param.Name,
+		Location: param.Location(),
+		Provided: slices.Contains(attempt.Provided, param.Name),
+	}
+
+	value, found := attempt.ValuesMap.Get(param.Name)
+	if !found {
+		if !param.Optional {
+			pe.Err = NewErr(ErrRequiredParameterNotProvided,
+				"parameter_name", param.Name,
+				"data_type", param.DataTypeSlug(),
+			)
+		}
+		goto end
+	}
+	pe.Value = param.FormatValue(value)
+	pe.Err = param.Validate(pe.Value)
+
+end:
+	return pe
+}
+
+// appendErr appends err to errs when it is not nil.
+func appendErr(errs []error, err error) []error {
+	if err == nil {
+		return errs
+	}
+	return append(errs, err)
+}
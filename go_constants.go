@@ -0,0 +1,155 @@
+package pathvars
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"strings"
+)
+
+// RouteSpec describes a route for GenerateGoConstants().
+type RouteSpec struct {
+	// Name prefixes the generated identifiers, e.g. "User" yields the
+	// constant RouteUser and helpers such as UserIDFromMatch(). It must be an
+	// exported Go identifier.
+	Name string
+
+	Method   HTTPMethod
+	Template Template
+
+	// Index is the route's RouteArgs.Index. As with AddRoute(), 0 means the
+	// route's position in the slice.
+	Index int
+}
+
+// initialisms are name parts written in upper case in generated identifiers,
+// following Go naming conventions.
+var initialisms = map[string]bool{
+	"api": true, "id": true, "ip": true, "json": true, "sql": true,
+	"uri": true, "url": true, "uuid": true, "http": true, "html": true,
+}
+
+// GenerateGoConstants writes to w a Go source file for package pkg declaring
+// a Route<Name> constant holding each route's index and, for each of its
+// parameters, a <Name><Param>FromMatch(pathvars.MatchResult) function
+// returning the value as its GoType(), e.g. UserIDFromMatch() returning
+// (int64, bool) for {id:int}. The helpers convert with MatchResult.Typed(), so
+// they report false when the value is missing or did not convert.
+func GenerateGoConstants(routes []RouteSpec, pkg string, w io.Writer) (err error) {
+	var src []byte
+	var needsTime bool
+	var buf, consts, funcs bytes.Buffer
+
+	names := make(map[string]RouteSpec)
+
+	if !token.IsIdentifier(pkg) {
+		err = NewErr(ErrInvalidRouteSpec, "package", pkg)
+		goto end
+	}
+
+	for i, spec := range routes {
+		var pt *ParsedTemplate
+		var constName string
+
+		index := spec.Index
+		if index == 0 {
+			index = i
+		}
+		constName = "Route" + spec.Name
+		if !token.IsIdentifier(spec.Name) || !token.IsExported(spec.Name) {
+			err = NewErr(ErrInvalidRouteSpec, "name", spec.Name, "template", spec.Template)
+			goto end
+		}
+		pt, err = ParseTemplate(string(spec.Template))
+		if err != nil {
+			err = WithErr(err, ErrInvalidRouteSpec, "name", spec.Name)
+			goto end
+		}
+
+		err = claimGoName(names, constName, spec)
+		if err != nil {
+			goto end
+		}
+		fmt.Fprintf(&consts, "\t// %s is the index of %s\n", constName,
+			strings.TrimSpace(string(spec.Method)+" "+pt.Original()),
+		)
+		fmt.Fprintf(&consts, "\t%s = %d\n", constName, index)
+
+		for p := range pt.params.Values() {
+			funcName := spec.Name + exportedGoName(p.Name) + "FromMatch"
+			err = claimGoName(names, funcName, spec)
+			if err != nil {
+				goto end
+			}
+			goType := p.GoType()
+			needsTime = needsTime || goType == "time.Time"
+			fmt.Fprintf(&funcs, "\n// %s returns the %s value of a %s match.\n", funcName, p.Name, constName)
+			fmt.Fprintf(&funcs, "func %s(m pathvars.MatchResult) (value %s, ok bool) {\n", funcName, goType)
+			fmt.Fprintf(&funcs, "\tv, found := m.Typed(%q)\n", p.Name)
+			fmt.Fprintf(&funcs, "\tif !found {\n\t\treturn value, false\n\t}\n")
+			fmt.Fprintf(&funcs, "\tvalue, ok = v.(%s)\n", goType)
+			fmt.Fprintf(&funcs, "\treturn value, ok\n}\n")
+		}
+	}
+
+	fmt.Fprintf(&buf, "// Code generated by pathvars.GenerateGoConstants(); DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\nimport (\n", pkg)
+	if needsTime {
+		fmt.Fprintf(&buf, "\t\"time\"\n\n")
+	}
+	fmt.Fprintf(&buf, "\t\"github.com/mikeschinkel/go-pathvars\"\n)\n\n")
+	fmt.Fprintf(&buf, "const (\n%s)\n", consts.String())
+	if funcs.Len() == 0 {
+		// Keeps the import used when no route has parameters
+		fmt.Fprintf(&buf, "\nvar _ pathvars.MatchResult\n")
+	}
+	buf.Write(funcs.Bytes())
+
+	src, err = format.Source(buf.Bytes())
+	if err != nil {
+		err = NewErr(ErrGoCodeGenerationFailed, "package", pkg, err)
+		goto end
+	}
+
+	_, err = w.Write(src)
+
+end:
+	return err
+}
+
+// claimGoName records that spec generates the identifier name, failing with
+// ErrInvalidRouteSpec if another spec already generated it.
+func claimGoName(names map[string]RouteSpec, name string, spec RouteSpec) (err error) {
+	other, taken := names[name]
+	if taken {
+		err = NewErr(
+			ErrInvalidRouteSpec,
+			ErrDuplicateGoIdentifier,
+			"identifier", name,
+			"template", spec.Template,
+			"other_template", other.Template,
+		)
+		goto end
+	}
+	names[name] = spec
+end:
+	return err
+}
+
+// exportedGoName converts a parameter name such as "user_id" to "UserID".
+func exportedGoName(name Identifier) string {
+	sb := strings.Builder{}
+	for _, part := range strings.Split(string(name), "_") {
+		if part == "" {
+			continue
+		}
+		if initialisms[strings.ToLower(part)] {
+			sb.WriteString(strings.ToUpper(part))
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return sb.String()
+}
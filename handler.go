@@ -0,0 +1,241 @@
+package pathvars
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// matchResultContextKey is the context key Handler() uses to store a MatchResult.
+type matchResultContextKey struct{}
+
+// ProblemDetails is an RFC 9457 problem details body describing why a request
+// failed to match, with one ParameterProblem per invalid parameter.
+type ProblemDetails struct {
+	Type     string             `json:"type"`
+	Title    string             `json:"title"`
+	Status   int                `json:"status"`
+	Detail   string             `json:"detail,omitempty"`
+	Instance string             `json:"instance,omitempty"`
+	Errors   []ParameterProblem `json:"errors,omitempty"`
+}
+
+// ParameterProblem is the JSON form of a single ParameterError.
+type ParameterProblem struct {
+	Parameter      string       `json:"parameter,omitempty"`
+	Location       LocationType `json:"location,omitempty"`
+	ExpectedType   string       `json:"expected_type,omitempty"`
+	ReceivedValue  string       `json:"received_value,omitempty"`
+	ConstraintType string       `json:"constraint_type,omitempty"`
+	Detail         string       `json:"detail,omitempty"`
+	Suggestion     string       `json:"suggestion,omitempty"`
+	Example        string       `json:"example,omitempty"`
+}
+
+// NewProblemDetails builds a ProblemDetails for err with the given HTTP status,
+// collecting every TemplateError in err's tree into the Errors list.
+func NewProblemDetails(req *http.Request, status int, err error) *ProblemDetails {
+	pd := &ProblemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+	if req != nil {
+		pd.Instance = req.URL.RequestURI()
+	}
+	for _, te := range TemplateErrors(err) {
+		pd.Errors = append(pd.Errors, ParameterProblem{
+			Parameter:      te.Parameter(),
+			Location:       te.Location,
+			ExpectedType:   te.ExpectedType(),
+			ReceivedValue:  te.ReceivedValue(),
+			ConstraintType: te.ConstraintType(),
+			Detail:         te.Detail(),
+			Suggestion:     te.GetSuggestion(),
+			Example:        te.Example,
+		})
+	}
+	switch {
+	case len(pd.Errors) == 1:
+		pd.Detail = pd.Errors[0].Detail
+	case len(pd.Errors) > 1:
+		pd.Detail = "One or more parameters are invalid"
+	case errors.Is(err, ErrMethodNotAllowed):
+		pd.Detail = ErrMethodNotAllowed.Error()
+	case errors.Is(err, ErrNoRouteMatched):
+		pd.Detail = ErrNoRouteMatched.Error()
+	case errors.Is(err, ErrRouteHasNoHandler):
+		pd.Detail = ErrRouteHasNoHandler.Error()
+	case errors.Is(err, ErrRequestBodyRequired):
+		pd.Detail = ErrRequestBodyRequired.Error()
+	case errors.Is(err, ErrUnsupportedContentType):
+		pd.Detail = ErrUnsupportedContentType.Error()
+	case errors.Is(err, ErrInvalidContentType):
+		pd.Detail = ErrInvalidContentType.Error()
+	case errors.Is(err, ErrNotAcceptable):
+		pd.Detail = ErrNotAcceptable.Error()
+	case errors.Is(err, ErrDateRangeReversed):
+		pd.Detail = ErrDateRangeReversed.Error()
+	case errors.Is(err, ErrCrossCheckFailed):
+		pd.Detail = ErrCrossCheckFailed.Error()
+	case errors.Is(err, ErrPathValidationFailed):
+		pd.Detail = ErrPathValidationFailed.Error()
+	case errors.Is(err, ErrExactlyOneViolated):
+		names, _ := ErrValue[string](err, "parameters")
+		pd.Detail = fmt.Sprintf("Exactly one of %s must be provided", names)
+	case errors.Is(err, ErrRequiredWithViolated):
+		name, _ := ErrValue[Identifier](err, "parameter_name")
+		requires, _ := ErrValue[string](err, "requires")
+		pd.Detail = fmt.Sprintf("Parameter '%s' requires %s", name, requires)
+	}
+	return pd
+}
+
+// WriteProblemDetails writes err to w as an application/problem+json response
+// with the given HTTP status.
+func WriteProblemDetails(w http.ResponseWriter, req *http.Request, status int, err error) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(NewProblemDetails(req, status, err))
+}
+
+// DefaultErrorHandler is the RouterArgs.ErrorHandler used when none is given.
+// It writes a problem details response with the status from StatusForError(),
+// adding an Allow header listing the permitted methods to 405 responses.
+func DefaultErrorHandler(w http.ResponseWriter, req *http.Request, err error) {
+	allowed, ok := ErrValue[string](err, "allowed_methods")
+	if ok && errors.Is(err, ErrMethodNotAllowed) {
+		w.Header().Set("Allow", allowed)
+	}
+	WriteProblemDetails(w, req, StatusForError(err), err)
+}
+
+// StatusForError maps an error returned by Match() to an HTTP status code:
+// 404 when no route matched, 405 when routes matched the path but not the
+// method or RouterArgs.AllowedMethods rejected it, 501, 415 and 406 for
+// routes without a handler, unsupported bodies and unacceptable responses,
+// 403 when a route's ClientCert requirement was not met, 500 when any error
+// in err's tree is a ServerFaultSource fault, and 400 for client faults such
+// as invalid parameters. Errors that did not come from matching and carry no
+// fault source are reported as 500. A nil err yields 200.
+func StatusForError(err error) (status int) {
+	switch {
+	case err == nil:
+		status = http.StatusOK
+	case errors.Is(err, ErrMethodNotAllowed):
+		status = http.StatusMethodNotAllowed
+	case errors.Is(err, ErrNoRouteMatched):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrRouteHasNoHandler):
+		status = http.StatusNotImplemented
+	case errors.Is(err, ErrUnsupportedContentType):
+		status = http.StatusUnsupportedMediaType
+	case errors.Is(err, ErrNotAcceptable):
+		status = http.StatusNotAcceptable
+	case errors.Is(err, ErrClientCertRejected):
+		status = http.StatusForbidden
+	default:
+		switch faultSourceOf(err) {
+		case ServerFaultSource:
+			status = http.StatusInternalServerError
+		case ClientFaultSource:
+			status = http.StatusBadRequest
+		default:
+			status = http.StatusInternalServerError
+			if errors.Is(err, ErrNoMatch) {
+				// Match() failures without a fault source are malformed requests
+				status = http.StatusBadRequest
+			}
+		}
+	}
+	return status
+}
+
+// faultSourceOf returns the most severe fault source in err's tree, where a
+// server fault outranks a client fault. Fault sources come from ParameterErrors
+// and from "fault_source" metadata.
+func faultSourceOf(err error) (fs FaultSource) {
+	var pe *ParameterError
+
+	if err == nil {
+		goto end
+	}
+	//goland:noinspection GoTypeAssertionOnErrors
+	pe, _ = err.(*ParameterError)
+	if pe != nil {
+		fs = pe.GetFaultSource()
+		goto end
+	}
+	for _, kv := range ErrMeta(err) {
+		if kv.Key() != "fault_source" {
+			continue
+		}
+		slug, _ := kv.Value().(string)
+		source, _ := pvtypes.ParseFaultSource(slug)
+		fs = max(fs, source)
+	}
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		fs = max(fs, faultSourceOf(u.Unwrap()))
+	case interface{ Unwrap() []error }:
+		for _, child := range u.Unwrap() {
+			fs = max(fs, faultSourceOf(child))
+		}
+	}
+end:
+	return fs
+}
+
+// ServeHTTP lets a Router be used directly as an http.Handler. Requests that
+// match a route are passed to its RouteArgs.Handler with the MatchResult
+// available via MatchResultFromContext(). Requests that fail to match are
+// passed to the router's ErrorHandler, as are matches of routes without a
+// Handler, which are reported as ErrRouteHasNoHandler; use Handler() to pass
+// those on to another http.Handler instead.
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	result, err := r.MatchInto(req)
+	switch {
+	case err != nil:
+		// Match()'s own error, so a 405 is not reported as a plain 404
+		r.errorHandler(w, req, err)
+	case result.Route.Handler == nil:
+		err = NewErr(ErrRouteHasNoHandler, "method", req.Method, "path", req.URL.Path)
+		r.errorHandler(w, req, WithErr(err, ErrNoMatch))
+	default:
+		req = req.WithContext(context.WithValue(req.Context(), matchResultContextKey{}, result))
+		result.Route.Handler.ServeHTTP(w, req)
+	}
+}
+
+// Handler returns middleware that puts the router in front of next, typically
+// an existing http.ServeMux. Requests that match a route have their values set
+// via MatchInto() and stored in the request context for MatchResultFromContext()
+// before being passed to next. Requests that match no route are passed to next
+// unchanged, including those whose path matched a route for another method,
+// since next may serve that method. Requests that match a route's path but
+// fail validation are passed to the router's ErrorHandler and next is not called.
+func (r *Router) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		result, err := r.MatchInto(req)
+		switch {
+		case err == nil:
+			req = req.WithContext(context.WithValue(req.Context(), matchResultContextKey{}, result))
+		case errors.Is(err, ErrNoRouteMatched):
+			// Not ours; let the fallback handler deal with it
+		default:
+			r.errorHandler(w, req, err)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// MatchResultFromContext returns the MatchResult stored by Handler(), if any.
+func MatchResultFromContext(ctx context.Context) (result MatchResult, ok bool) {
+	result, ok = ctx.Value(matchResultContextKey{}).(MatchResult)
+	return result, ok
+}
@@ -0,0 +1,40 @@
+package pathvars
+
+import (
+	"context"
+	"net/http"
+)
+
+type languageContextKey struct{}
+
+// WithLanguage returns a copy of ctx that selects lang's message catalog for
+// errors returned when matching a request carrying the context, overriding
+// RouterArgs.Language.
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageContextKey{}, lang)
+}
+
+// LanguageFromContext returns the language set by WithLanguage(), if any.
+func LanguageFromContext(ctx context.Context) (lang string, ok bool) {
+	lang, ok = ctx.Value(languageContextKey{}).(string)
+	return lang, ok
+}
+
+// localizeErrors rewrites the details and suggestions of the TemplateErrors in
+// err using the language selected for req, if one has been selected.
+func (r *Router) localizeErrors(req *http.Request, err error) {
+	var lang string
+	var ok bool
+	if req != nil {
+		lang, ok = LanguageFromContext(req.Context())
+	}
+	if !ok {
+		lang = r.language
+	}
+	if lang == "" {
+		return
+	}
+	for _, te := range TemplateErrors(err) {
+		te.localize(lang)
+	}
+}
@@ -4,6 +4,16 @@
 package pathvars
 
 import (
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
 	"github.com/mikeschinkel/go-pathvars/pvtypes"
 )
 
@@ -19,6 +29,14 @@ type MatchAttempt struct {
 
 	// ValuesMap contains extracted parameter values (may be partial if validation failed).
 	ValuesMap pvtypes.ValuesMap
+
+	// Provided lists the parameters whose values came from the request rather
+	// than from defaults, in template order.
+	Provided []Identifier
+
+	// ParsedQuery is the request's parsed query string, or nil when it could
+	// not be parsed.
+	ParsedQuery *ParsedQuery
 }
 
 // Matched returns true if both path and query matched successfully.
@@ -43,6 +61,23 @@ type MatchResult struct {
 	// valuesMap contains the extracted parameter values from the matched request.
 	// This field is private to control access and ensure proper initialization.
 	valuesMap pvtypes.ValuesMap
+
+	// rawQuery is the request's query string exactly as received.
+	rawQuery string
+
+	// provided lists the parameters the request actually supplied.
+	provided []Identifier
+
+	// contentType is the response media type negotiated from the Accept header.
+	contentType string
+
+	// clientCert is the leaf of the request's verified TLS client certificate chain.
+	clientCert *x509.Certificate
+
+	// canonicalPath is the path the route matched after normalization, and
+	// normalized reports whether it differs from the request's path.
+	canonicalPath string
+	normalized    bool
 }
 
 // NewMatchResult creates a new MatchResult with the specified route index and parameter values.
@@ -73,6 +108,160 @@ func (m MatchResult) ValuesMap() pvtypes.ValuesMap {
 	return m.valuesMap
 }
 
+// RawQuery returns the request's query string exactly as received, without
+// the leading '?', preserving parameter order, duplicate keys and encoding so
+// proxy handlers can forward it verbatim.
+func (m MatchResult) RawQuery() string {
+	return m.rawQuery
+}
+
+// Query returns the request's query string parsed into url.Values, including
+// parameters not declared in the route template. Malformed pairs are skipped.
+func (m MatchResult) Query() url.Values {
+	values, _ := url.ParseQuery(m.rawQuery)
+	return values
+}
+
+// QueryPair is a single name=value pair from a request's query string.
+type QueryPair struct {
+	Name  Identifier
+	Value string
+}
+
+// QueryInOrder returns the request's query parameters in the order they
+// appeared, one pair per occurrence so repeated keys keep their positions,
+// e.g. b, a, c for "?b=2&a=1&c=3". Like Query() it includes parameters not
+// declared in the route template and skips malformed pairs. Values are
+// URL-decoded and a key without '=' has an empty value.
+func (m MatchResult) QueryInOrder() []QueryPair {
+	return parseQueryPairs(m.rawQuery)
+}
+
+// ExtraQuery returns the query parameters the route's template does not
+// declare, keyed by name with the first value of any repeated key, so
+// passthrough and proxy handlers can forward them while declared parameters
+// are bound and validated as usual. Values are URL-decoded and malformed
+// pairs are skipped. The map is empty, not nil, when there are no extras.
+func (m MatchResult) ExtraQuery() (extra map[string]string) {
+	extra = make(map[string]string)
+	pq, _ := ParseQuery(m.rawQuery)
+	for name, values := range pq.Iterator() {
+		if m.declaresQuery(Identifier(name)) {
+			continue
+		}
+		extra[name] = values[0]
+	}
+	return extra
+}
+
+// declaresQuery reports whether the matched route declares name as a query
+// parameter.
+func (m MatchResult) declaresQuery(name Identifier) bool {
+	if m.Route == nil || m.Route.ParsedTemplate == nil {
+		return false
+	}
+	p, exists := m.Route.ParsedTemplate.params.Get(name)
+	return exists && p.Location() == QueryLocation
+}
+
+// Provided returns the names of the parameters whose values were sent in the
+// request, excluding optional parameters that fell back to a default. This
+// allows PATCH-like handlers to update only the fields a client supplied.
+func (m MatchResult) Provided() []Identifier {
+	return m.provided
+}
+
+// WasProvided reports whether the request itself supplied a value for name,
+// as opposed to the value coming from a default.
+func (m MatchResult) WasProvided(name Identifier) bool {
+	return slices.Contains(m.provided, name)
+}
+
+// UUIDVersion returns the version of the UUID matched for the UUID-typed
+// parameter name, e.g. to branch on time-sortable v7 versus random v4 IDs for
+// routes using format[any] or format[v1-5]. It returns false if name is not a
+// UUID parameter, uses a non-UUID format such as ULID or KSUID, or has no value.
+func (m MatchResult) UUIDVersion(name Identifier) (version int, ok bool) {
+	var p Parameter
+	var value any
+	var err error
+
+	if m.Route == nil || m.Route.ParsedTemplate == nil {
+		goto end
+	}
+	p, ok = m.Route.ParsedTemplate.params.Get(name)
+	if !ok || p.DataType() != UUIDType {
+		ok = false
+		goto end
+	}
+	for _, c := range p.Constraints() {
+		uc, isUUID := c.(*pvconstraints.UUIDFormatConstraint)
+		if isUUID && !uc.IsStandardUUID() {
+			ok = false
+			goto end
+		}
+	}
+	value, ok = m.valuesMap.Get(name)
+	if !ok {
+		goto end
+	}
+	version, err = pvconstraints.UUIDVersion(fmt.Sprintf("%v", value))
+	ok = err == nil
+
+end:
+	return version, ok
+}
+
+// IDFormat returns the ID scheme matched for the parameter name when it uses
+// format[id]: "uuid", "ulid", "ksuid", "nanoid" or "cuid", e.g. to look a
+// record up by the right column. It returns false if name does not use
+// format[id] or has no value.
+func (m MatchResult) IDFormat(name Identifier) (format string, ok bool) {
+	var p Parameter
+	var value any
+	var err error
+
+	if m.Route == nil || m.Route.ParsedTemplate == nil {
+		goto end
+	}
+	p, ok = m.Route.ParsedTemplate.params.Get(name)
+	if !ok {
+		goto end
+	}
+	ok = slices.ContainsFunc(p.Constraints(), func(c Constraint) bool {
+		uc, isUUID := c.(*pvconstraints.UUIDFormatConstraint)
+		return isUUID && uc.Rule() == pvconstraints.IDFormat
+	})
+	if !ok {
+		goto end
+	}
+	value, ok = m.valuesMap.Get(name)
+	if !ok {
+		goto end
+	}
+	format, err = pvconstraints.DetectIDFormat(fmt.Sprintf("%v", value))
+	ok = err == nil
+
+end:
+	return format, ok
+}
+
+// Constraints returns the constraints declared for the parameter name on the
+// matched route, e.g. so middleware can read the bounds of range[1..100] via
+// Rule() to enforce further business rules. The slice is a copy, so changing
+// it does not affect the route. Returns nil if name is not a parameter of the
+// route or has no constraints.
+func (m MatchResult) Constraints(name Identifier) []Constraint {
+	if m.Route == nil || m.Route.ParsedTemplate == nil {
+		return nil
+	}
+	p, ok := m.Route.ParsedTemplate.params.Get(name)
+	if !ok || len(p.Constraints()) == 0 {
+		return nil
+	}
+	return slices.Clone(p.Constraints())
+}
+
 // GetValue returns the value of a named parameter and whether it was found.
 // Returns the parameter value and true if the parameter exists, or empty string and false otherwise.
 func (m MatchResult) GetValue(name Identifier) (value any, found bool) {
@@ -80,6 +269,94 @@ func (m MatchResult) GetValue(name Identifier) (value any, found bool) {
 	return value, found
 }
 
+// Typed returns the value of a named parameter converted to its data type's
+// natural Go type: int64 for int, float64 for decimal, real, latitude and
+// longitude, bool for bool and flag, time.Time for date (honoring any
+// format[...] constraint), and string for everything else. Values that are
+// not parameters themselves, such as the decomposed components of a
+// multi-segment parameter, and values that cannot be converted are returned
+// unchanged. The bool result reports whether the value was found.
+func (m MatchResult) Typed(name Identifier) (value any, found bool) {
+	var p Parameter
+	var s string
+	var ok bool
+	var converted any
+	var err error
+
+	value, found = m.valuesMap.Get(name)
+	if !found || m.Route == nil || m.Route.ParsedTemplate == nil {
+		goto end
+	}
+	p, ok = m.Route.ParsedTemplate.params.Get(name)
+	if !ok {
+		goto end
+	}
+	s, ok = value.(string)
+	if !ok {
+		goto end
+	}
+
+	switch p.DataType() {
+	case IntegerType:
+		converted, err = strconv.ParseInt(s, 10, 64)
+	case DecimalType, RealType, LatitudeType, LongitudeType:
+		converted, err = strconv.ParseFloat(s, 64)
+	case BooleanType, FlagType:
+		converted, err = strconv.ParseBool(s)
+	case DateType:
+		converted, err = parseDateParameter(m.Route.ParsedTemplate, name, s)
+	default:
+		goto end
+	}
+	if err == nil {
+		value = converted
+	}
+
+end:
+	return value, found
+}
+
+// matchResultJSON is the JSON form of a MatchResult.
+type matchResultJSON struct {
+	Index    int            `json:"index"`
+	Method   HTTPMethod     `json:"method,omitempty"`
+	Template string         `json:"template,omitempty"`
+	Values   map[string]any `json:"values"`
+}
+
+// MarshalJSON encodes the result as an object with the matched route's index,
+// method and template, and a "values" object mapping each extracted name to
+// its Typed() value, so {id:int} encodes as a JSON number and {on:bool} as a
+// JSON boolean. Dates keep the spelling they were matched with, lists encode
+// as arrays, and NaN or infinite numbers, which JSON cannot represent, are
+// kept as strings.
+func (m MatchResult) MarshalJSON() ([]byte, error) {
+	vm := m.ValuesMap()
+	out := matchResultJSON{
+		Index:  m.Index,
+		Values: make(map[string]any, vm.Len()),
+	}
+	if m.Route != nil {
+		out.Method = m.Route.Method
+		if m.Route.ParsedTemplate != nil {
+			out.Template = m.Route.ParsedTemplate.Original()
+		}
+	}
+	for name, raw := range vm.Iterator() {
+		value, _ := m.Typed(name)
+		switch v := value.(type) {
+		case time.Time:
+			value = raw
+		case float64:
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				value = raw
+			}
+		}
+		out.Values[string(name)] = value
+	}
+	return json.Marshal(out)
+}
+
 // VarCount returns the number of extracted parameters.
 func (m MatchResult) VarCount() int {
 	return m.valuesMap.Len()
@@ -90,6 +367,69 @@ func (m MatchResult) HasVars() bool {
 	return m.valuesMap.Initialized() && m.valuesMap.Len() > 0
 }
 
+// NegotiatedContentType returns the entry of the route's Produces list that
+// best satisfies the request's Accept header, or "" when the route has no
+// Produces list.
+func (m MatchResult) NegotiatedContentType() string {
+	return m.contentType
+}
+
+// CanonicalPath returns the request path as the matched route spells it,
+// after RouterArgs.IgnoreTrailingSlash and RouterArgs.CaseInsensitive
+// normalization, e.g. "/users" for a request to "/Users/". The bool reports
+// whether it differs from the request's path, in which case a handler may
+// answer with a 301 redirect, appending the query string if any:
+//
+//	if canonical, changed := result.CanonicalPath(); changed {
+//		http.Redirect(w, r, canonical, http.StatusMovedPermanently)
+//		return
+//	}
+func (m MatchResult) CanonicalPath() (string, bool) {
+	return m.canonicalPath, m.normalized
+}
+
+// ClientCert returns the leaf of the request's verified TLS client
+// certificate chain, e.g. to log or authorize on the caller's identity, or
+// nil when the request presented none or was matched with MatchPath().
+func (m MatchResult) ClientCert() *x509.Certificate {
+	return m.clientCert
+}
+
+// ToMap returns a snapshot of the extracted values keyed by plain string,
+// stringified with fmt's %v verb, for templating, logging and serialization.
+// Decomposed multi-segment components such as date_year are included, and
+// list values are rejoined on their delimiter.
+func (m MatchResult) ToMap() map[string]string {
+	vm := m.ValuesMap()
+	result := make(map[string]string, vm.Len())
+	for name, value := range vm.Iterator() {
+		result[string(name)] = m.formatValue(name, value)
+	}
+	return result
+}
+
+// ToStringMap is like ToMap() but keeps the Identifier key type.
+func (m MatchResult) ToStringMap() map[Identifier]string {
+	vm := m.ValuesMap()
+	result := make(map[Identifier]string, vm.Len())
+	for name, value := range vm.Iterator() {
+		result[name] = m.formatValue(name, value)
+	}
+	return result
+}
+
+// formatValue stringifies the value of the named parameter with
+// Parameter.FormatValue(), or with fmt's %v verb for decomposed components.
+func (m MatchResult) formatValue(name Identifier, value any) string {
+	if m.Route != nil && m.Route.ParsedTemplate != nil {
+		p, ok := m.Route.ParsedTemplate.params.Get(name)
+		if ok {
+			return p.FormatValue(value)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
 // ForEachVar iterates over all extracted parameters, calling the provided function
 // for each name-value pair. If the function returns true, iteration continues;
 // if it returns false, iteration stops early.
@@ -0,0 +1,98 @@
+package pathvars
+
+import (
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// matrixParameter is one ";key=value" pair from a path segment. A bare
+// ";key" has an empty value.
+type matrixParameter struct {
+	key   string
+	value string
+}
+
+// splitMatrixParameters removes the RFC 3986 matrix parameters that may follow
+// each segment of path, returning the bare path and the pairs found in each
+// segment, indexed by segment position. matrix is nil when path has none.
+func splitMatrixParameters(path string) (bare string, matrix [][]matrixParameter) {
+	var sb strings.Builder
+
+	if strings.IndexByte(path, ';') < 0 {
+		bare = path
+		goto end
+	}
+
+	sb.Grow(len(path))
+	for i, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		name, params, _ := strings.Cut(part, ";")
+		if strings.HasPrefix(path, "/") || i > 0 {
+			sb.WriteByte('/')
+		}
+		sb.WriteString(name)
+		matrix = append(matrix, parseMatrixParameters(params))
+	}
+	bare = sb.String()
+
+end:
+	return bare, matrix
+}
+
+// parseMatrixParameters parses the ";"-separated pairs after a segment's
+// name, skipping any without a key.
+func parseMatrixParameters(s string) (params []matrixParameter) {
+	for pair := range strings.SplitSeq(s, ";") {
+		key, value, _ := strings.Cut(pair, "=")
+		if key == "" {
+			continue
+		}
+		params = append(params, matrixParameter{key: key, value: value})
+	}
+	return params
+}
+
+// addMatrixValues stores the matrix parameters of a matched path in
+// valuesMap as "<segment>.<key>", where a parameter segment is named after
+// its first parameter and a literal segment uses its text. A multi-segment
+// parameter gathers the pairs of every segment it spans. When a key repeats
+// within a segment the first value wins, as for query parameters.
+func (pt *ParsedTemplate) addMatrixValues(valuesMap *pvtypes.ValuesMap, matrix [][]matrixParameter) {
+	var index int
+
+	if !valuesMap.Initialized() {
+		*valuesMap = pvtypes.NewValuesMap(0)
+	}
+	for _, segment := range pt.segments {
+		name := segment.Raw
+		span := 1
+		if segment.IsParameter() {
+			name = string(segment.Parameters[0].Name)
+			span += pt.extraSegments(*valuesMap, segment)
+		}
+		for ; span > 0 && index < len(matrix); span-- {
+			for _, mp := range matrix[index] {
+				key := Identifier(name + "." + mp.key)
+				_, exists := valuesMap.Get(key)
+				if !exists {
+					valuesMap.Set(key, mp.value)
+				}
+			}
+			index++
+		}
+	}
+}
+
+// extraSegments returns how many path segments beyond the first the matched
+// values of segment's multi-segment parameters span.
+func (pt *ParsedTemplate) extraSegments(valuesMap pvtypes.ValuesMap, segment Segment) (extra int) {
+	for _, param := range segment.Parameters {
+		if !pt.isMultiSegment(param.Name) {
+			continue
+		}
+		value, _ := valuesMap.Get(param.Name)
+		s, _ := value.(string)
+		extra += strings.Count(s, "/")
+	}
+	return extra
+}
@@ -19,7 +19,8 @@ func NewParsedQuery(cap int) *ParsedQuery {
 }
 
 // ParseQuery parses the URL-encoded query string and returns an OrderedMap
-// preserving the parameter order as they appear in the URL.
+// preserving the parameter order as they appear in the URL. Keys are unescaped
+// like values, so user%5Fname=x is found under "user_name".
 //
 // This function is adapted from Go standard library net/url/url.go (BSD-3-Clause).
 // Modified to use OrderedMap for preserving query parameter order, which is
@@ -74,3 +75,28 @@ func parseQuery(pq *ParsedQuery, query string) (err error) {
 	}
 	return err
 }
+
+// parseQueryPairs parses query into its name=value pairs in request order,
+// skipping pairs that parseQuery() would reject.
+func parseQueryPairs(query string) (pairs []QueryPair) {
+	for query != "" {
+		var key, value string
+		var err error
+
+		key, query, _ = strings.Cut(query, "&")
+		if key == "" || strings.Contains(key, ";") {
+			continue
+		}
+		key, value, _ = strings.Cut(key, "=")
+		key, err = url.QueryUnescape(key)
+		if err != nil {
+			continue
+		}
+		value, err = url.QueryUnescape(value)
+		if err != nil {
+			continue
+		}
+		pairs = append(pairs, QueryPair{Name: Identifier(key), Value: value})
+	}
+	return pairs
+}
@@ -5,6 +5,7 @@ package pathvars
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 
@@ -19,34 +20,66 @@ type ParsedTemplate struct {
 	// raw stores the original template string for reference and error reporting.
 	original string
 
+	// method is the method prefix of a PathSpec-style template like
+	// "GET /users/{id}", or empty when the template is a bare path.
+	method HTTPMethod
+
+	// normalized is set by Normalize() so String() and Template() return the
+	// normalized form while original keeps the exact input.
+	normalized bool
+
 	// segments contains the parsed path segments, both literal and parameter segments.
 	segments []Segment
 
 	// params maps parameter names to their definitions for validation and extraction.
 	params *pvtypes.OrderedMap[Identifier, Parameter]
 
-	parsedQuery *ParsedQuery
+	// trimQueryValues strips whitespace around query values before validation,
+	// set from RouterArgs.TrimQueryValues when the route is added.
+	trimQueryValues bool
+
+	// matrixParameters strips ";key=value" matrix parameters from each path
+	// segment before matching, set from RouterArgs.MatrixParameters when the
+	// route is added.
+	matrixParameters bool
+
+	// ignoreInvalidOptional treats an optional query parameter with an invalid
+	// value as absent, set from RouteArgs.IgnoreInvalidOptional when the route
+	// is added.
+	ignoreInvalidOptional bool
+
+	// regexSource is the regular expression equivalent of the template's path.
+	regexSource string
 
 	// regex is the compiled regular expression used for efficient path matching.
+	// It is only compiled when matcher is nil.
 	regex *regexp.Regexp
-}
 
-func (pt *ParsedTemplate) ParsedQuery() *ParsedQuery {
-	return pt.parsedQuery
+	// matcher matches literal and simple-parameter templates without regexp.
+	matcher *segmentMatcher
 }
 
+// Original returns the template exactly as passed to ParseTemplate(), even
+// after Normalize() has been called.
 func (pt *ParsedTemplate) Original() string {
 	// TODO: Verify if we should just return RAW, or if we should assemble from parsed parts.
 	// If we do we can use Substitute() and pass in the template variables as if they were values.
 	return pt.original
 }
 
+// Method returns the HTTP method prefix of a PathSpec-style template such as
+// "GET /users/{id}", or "" for a bare path. The method is never part of the
+// path that is matched or of URLs built by Example() and Substitute().
+func (pt *ParsedTemplate) Method() HTTPMethod {
+	return pt.method
+}
+
 // Template returns the string representation of the template that was parsed but
 // as a string-derived type Template.  See String() comments for more details.
 func (pt *ParsedTemplate) Template() Template {
 	// TODO: Verify if we should just return RAW, or if we should assemble from parsed parts.
 	// If we do we can use Substitute() and pass in the template variables as if they were values.
-	return Template(pt.original)
+	return Template(pt.String())
 }
 
 // String returns the string value of a parsed template which should just be what
@@ -58,13 +91,22 @@ func (pt *ParsedTemplate) Template() Template {
 // so added it too, but did not remove Template() even though I am not currently
 // using it simply because it returns a Template type vs. a string type.
 func (pt *ParsedTemplate) String() string {
+	if pt.normalized {
+		return pt.Normalized()
+	}
 	return pt.original
 }
 
+// Normalize causes String() and Template() to return the Normalized() form.
+// Original() continues to return the template exactly as it was parsed.
 func (pt *ParsedTemplate) Normalize() {
-	if len(pt.original) != 0 && pt.original[0] == '/' {
-		pt.original = pt.original[1:]
-	}
+	pt.normalized = true
+}
+
+// Normalized returns the template with its leading slash removed, e.g.
+// "users/{id}" for "/users/{id}".
+func (pt *ParsedTemplate) Normalized() string {
+	return strings.TrimPrefix(pt.original, "/")
 }
 
 // Match attempts to match a path and query string against this template.
@@ -74,16 +116,26 @@ func (pt *ParsedTemplate) Normalize() {
 func (pt *ParsedTemplate) Match(path, query string) (MatchAttempt, error) {
 	var errs []error
 	var matchedPath, matchedQuery bool
+	var parsedQuery *ParsedQuery
 	var err error
 
+	var matrix [][]matrixParameter
+
 	valuesMap := pvtypes.NewValuesMap(0)
 
+	if pt.matrixParameters {
+		path, matrix = splitMatrixParameters(path)
+	}
+
 	// First, match path parameters using regex
-	matchedPath, err = pt.matchPathParameters(path, &valuesMap)
+	matchedPath, err = pt.matchPathParameters(path, query, &valuesMap)
 	if err != nil {
 		errs = append(errs, err)
 	}
-	matchedQuery, err = pt.matchQueryParameters(query, &valuesMap)
+	if matchedPath && matrix != nil {
+		pt.addMatrixValues(&valuesMap, matrix)
+	}
+	parsedQuery, matchedQuery, err = pt.matchQueryParameters(query, &valuesMap)
 	if err != nil {
 		errs = append(errs, err)
 	}
@@ -95,12 +147,53 @@ func (pt *ParsedTemplate) Match(path, query string) (MatchAttempt, error) {
 		PathMatched:  matchedPath,
 		QueryMatched: matchedQuery,
 		ValuesMap:    valuesMap,
+		Provided:     pt.providedParams(valuesMap, parsedQuery),
+		ParsedQuery:  parsedQuery,
 	}, CombineErrs(errs)
 }
 
+// providedParams returns the names of the template's parameters whose values
+// came from the request: every matched path parameter plus each query
+// parameter present in the request's parsedQuery, whether or not it had a value.
+func (pt *ParsedTemplate) providedParams(valuesMap pvtypes.ValuesMap, parsedQuery *ParsedQuery) (provided []Identifier) {
+	var found bool
+
+	for p := range pt.params.Values() {
+		switch p.Location() {
+		case PathLocation:
+			_, found = valuesMap.Get(p.Name)
+		case QueryLocation:
+			found = false
+			if parsedQuery != nil {
+				_, found = parsedQuery.Get(string(p.Name))
+			}
+		default:
+			found = false
+		}
+		if found {
+			provided = append(provided, p.Name)
+		}
+	}
+	return provided
+}
+
+// matchesPath reports whether path fits the template without extracting or validating values.
+func (pt *ParsedTemplate) matchesPath(path string) bool {
+	if pt.matrixParameters {
+		path, _ = splitMatrixParameters(path)
+	}
+	switch {
+	case pt.matcher != nil:
+		return pt.matcher.matchString(path)
+	case pt.regex == nil:
+		return true
+	}
+	return pt.regex.MatchString(path)
+}
+
 // matchPathParameters matches path parameters using regex and adds them to vars.
 // Returns false if the path doesn't match the template or if parameter validation fails.
-func (pt *ParsedTemplate) matchPathParameters(path string, valuesMap *pvtypes.ValuesMap) (matched bool, err error) {
+func (pt *ParsedTemplate) matchPathParameters(path, query string, valuesMap *pvtypes.ValuesMap) (matched bool, err error) {
 	var matches []string
 	var n int
 	var name Identifier
@@ -110,14 +203,19 @@ func (pt *ParsedTemplate) matchPathParameters(path string, valuesMap *pvtypes.Va
 	var errs []error
 	var validationErrors []paramValidationError
 	var userProvidedParams pvtypes.ValuesMap
+	var parsedQuery *ParsedQuery
+	var spanned int
 
-	if pt.regex == nil {
+	switch {
+	case pt.matcher != nil:
+		matches = pt.matcher.match(path)
+	case pt.regex == nil:
 		// No path regex means no path parameters
 		matched = true
 		goto end
+	default:
+		matches = pt.regex.FindStringSubmatch(path)
 	}
-
-	matches = pt.regex.FindStringSubmatch(path)
 	if matches == nil {
 		// No match is not an error, just no match
 		matched = false
@@ -127,45 +225,58 @@ func (pt *ParsedTemplate) matchPathParameters(path string, valuesMap *pvtypes.Va
 
 	// Extract parameters from regex groups
 	n = 1 // Skip full match at index 0
-	for _, segment := range pt.segments {
+	for i, segment := range pt.segments {
 		if !segment.IsParameter() {
 			continue
 		}
+		// Earlier multi-segment values shift later segments to the right
+		index := i + spanned
 
-		if n >= len(matches) {
-			goto end
-		}
+		for _, segParam := range segment.Parameters {
+			if n >= len(matches) {
+				goto end
+			}
 
-		// We currently only support one parameter per segment
-		name = segment.Parameters[0].Name
-		value = matches[n]
+			name = segParam.Name
+			value = matches[n]
+
+			// Validate parameter type and constraints
+			param, exists = pt.params.Get(name)
+			if exists && param.Location() == PathLocation {
+				err = param.Validate(value)
+				if err != nil {
+					// Collect error metadata - delay full error construction until
+					// after loop completes so SuggestionURL sees complete valuesMap
+					validationErrors = append(validationErrors, paramValidationError{
+						param:    param,
+						value:    value,
+						validErr: err,
+						location: PathLocation,
+						index:    index,
+					})
+				} else {
+					value = param.Canonical(value)
+				}
+			}
+			if !valuesMap.Initialized() {
+				*valuesMap = pvtypes.NewValuesMap(0)
+			}
+			(*valuesMap).Set(name, value)
 
-		// Validate parameter type and constraints
-		param, exists = pt.params.Get(name)
-		if exists && param.Location() == PathLocation {
-			err = param.Validate(value)
-			if err != nil {
-				// Collect error metadata - delay full error construction until
-				// after loop completes so SuggestionURL sees complete valuesMap
-				validationErrors = append(validationErrors, paramValidationError{
-					param:    param,
-					value:    value,
-					validErr: err,
-					location: PathLocation,
-				})
+			// Decompose multi-segment parameters into component values
+			if param.MultiSegment {
+				spanned += strings.Count(value, "/")
+				pt.decomposeValue(*valuesMap, name, value, param.DataType(), param.Separator())
 			}
-		}
-		if !valuesMap.Initialized() {
-			*valuesMap = pvtypes.NewValuesMap(0)
-		}
-		(*valuesMap).Set(name, value)
 
-		// Decompose multi-segment parameters into component values
-		if param.MultiSegment {
-			pt.decomposeValue(*valuesMap, name, value, param.DataType())
+			n++
 		}
+	}
 
-		n++
+	if len(validationErrors) == 0 {
+		// Only error suggestions need the user-provided params; skip building them
+		err = CombineErrs(errs)
+		goto end
 	}
 
 	// For path parameter errors, we need to include query params the user provided
@@ -175,9 +286,11 @@ func (pt *ParsedTemplate) matchPathParameters(path string, valuesMap *pvtypes.Va
 	for name, value := range valuesMap.Iterator() {
 		userProvidedParams.Set(name, value)
 	}
-	// Add query parameters if available (we may not have parsed them yet)
-	if pt.parsedQuery != nil {
-		for paramName, values := range pt.parsedQuery.Iterator() {
+	// Add query parameters if available; an unparseable query is reported by
+	// matchQueryParameters() instead
+	parsedQuery, _ = ParseQuery(query)
+	if parsedQuery != nil {
+		for paramName, values := range parsedQuery.Iterator() {
 			if len(values) > 0 {
 				userProvidedParams.Set(Identifier(paramName), values[0])
 			}
@@ -217,12 +330,30 @@ type paramValidationError struct {
 	value    string
 	validErr error
 	location LocationType
+	// index is the path segment index, or the query parameter's position.
+	index int
+}
+
+// queryValue returns raw prepared for validation as p's query value: trimmed
+// under RouterArgs.TrimQueryValues, and "true" for a bare flag.
+func (pt *ParsedTemplate) queryValue(p Parameter, raw string) (value string) {
+	value = raw
+	if pt.trimQueryValues {
+		value = strings.TrimSpace(value)
+	}
+	if value == "" && p.DataType() == FlagType {
+		// A bare flag such as ?verbose means true
+		value = "true"
+	}
+	return value
 }
 
 // matchQueryParameters matches query parameters and adds them to vars.
 // Returns false if required parameters are missing or if validation fails.
 // Optional parameters are handled gracefully with default values when provided.
-func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.ValuesMap) (matched bool, err error) {
+// Declared names are looked up by the request's unescaped keys. The request's
+// parsed query is returned rather than kept on pt, which concurrent requests share.
+func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.ValuesMap) (parsedQuery *ParsedQuery, matched bool, err error) {
 	var p Parameter
 	var value string
 	var values []string
@@ -231,20 +362,21 @@ func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.
 	var addValue func(Identifier, any)
 	var validationErrors []paramValidationError
 	var userProvidedParams pvtypes.ValuesMap
+	var position int
 
 	// ParseBytes query string
 	if query != "" {
-		pt.parsedQuery, err = ParseQuery(query)
+		parsedQuery, err = ParseQuery(query)
 		if err != nil {
 			err = WithErr(err, ErrInvalidURLQueryString, "url_query", query)
 			goto end
 		}
-		if pt.parsedQuery == nil {
+		if parsedQuery == nil {
 			goto end
 		}
 	}
-	if pt.parsedQuery == nil {
-		pt.parsedQuery = NewParsedQuery(0)
+	if parsedQuery == nil {
+		parsedQuery = NewParsedQuery(0)
 	}
 
 	matched = true
@@ -253,6 +385,11 @@ func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.
 		if !valuesMap.Initialized() {
 			*valuesMap = pvtypes.NewValuesMap(0)
 		}
+		// List parameters such as {fields[,]:string} are stored as []string
+		s, isString := value.(string)
+		if isString && p.IsList() {
+			value = p.SplitList(s)
+		}
 		(*valuesMap).Set(name, value)
 	}
 
@@ -261,16 +398,24 @@ func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.
 		if p.Location() != QueryLocation {
 			continue
 		}
+		position++
 
 		// Check if parameter is present in query string
-		values, found = pt.parsedQuery.Get(string(p.Name))
-		switch {
-		case found && len(values) > 0:
+		values, found = parsedQuery.Get(string(p.Name))
+		if found && len(values) > 0 {
 			// Use the first value if multiple are provided
-			value = values[0]
-
-			// Validate matched parameter
+			value = pt.queryValue(p, values[0])
 			err = p.Validate(value)
+			if err != nil && p.Optional && pt.ignoreInvalidOptional {
+				// Treated as absent, so the default applies and it is not provided;
+				// parsedQuery belongs to this request, so the template is untouched
+				parsedQuery.Delete(string(p.Name))
+				found = false
+				err = nil
+			}
+		}
+		switch {
+		case found && len(values) > 0:
 			if err != nil {
 				// Collect error metadata - delay full error construction until
 				// after loop completes so SuggestionURL sees complete valuesMap
@@ -279,8 +424,11 @@ func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.
 					value:    value,
 					validErr: err,
 					location: QueryLocation,
+					index:    position - 1,
 				})
 				// Still add to valuesMap even if invalid - needed for complete error suggestions
+			} else {
+				value = p.Canonical(value)
 			}
 			addValue(p.Name, value)
 
@@ -291,6 +439,11 @@ func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.
 				addValue(p.Name, *p.DefaultValue)
 				continue
 			}
+			if p.DefaultFunc() != nil {
+				// Use a default computed for this request
+				addValue(p.Name, p.DefaultFunc()())
+				continue
+			}
 			// No explicit default - use type-specific implicit default
 			classifier, err := GetDataTypeClassifier(p.DataType())
 			if err != nil {
@@ -318,10 +471,16 @@ func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.
 		}
 	}
 
+	if len(validationErrors) == 0 {
+		// Only error suggestions need the user-provided params; skip building them
+		err = CombineErrs(errs)
+		goto end
+	}
+
 	// Build a map of ONLY user-provided parameters for ADR-018 compliance
 	// This excludes optional parameters that got default values but weren't in the HTTP request
-	userProvidedParams = pvtypes.NewValuesMap(pt.parsedQuery.Len())
-	for paramName, values := range pt.parsedQuery.Iterator() {
+	userProvidedParams = pvtypes.NewValuesMap(parsedQuery.Len())
+	for paramName, values := range parsedQuery.Iterator() {
 		if len(values) > 0 {
 			userProvidedParams.Set(Identifier(paramName), values[0])
 		}
@@ -348,7 +507,7 @@ func (pt *ParsedTemplate) matchQueryParameters(query string, valuesMap *pvtypes.
 	//}
 	//err = CombineErrs(errs)
 end:
-	return matched, err
+	return parsedQuery, matched, err
 }
 
 func (pt *ParsedTemplate) buildValidationErrors(errs []error, paramErrs []paramValidationError, source string, userProvidedParams *pvtypes.ValuesMap) error {
@@ -359,6 +518,9 @@ func (pt *ParsedTemplate) buildValidationErrors(errs []error, paramErrs []paramV
 			UserProvidedParams: userProvidedParams,
 			ValidationErr:      ve.validErr,
 		})
+		for _, pe := range findAllErrs[*ParameterError](ve.validErr) {
+			pe.SegmentIndex = ve.index
+		}
 		errs = append(errs, NewTemplateError(ve.validErr, TemplateErrorArgs{
 			Endpoint:   pt.Original(),
 			Example:    exampleURL,
@@ -374,16 +536,14 @@ func (pt *ParsedTemplate) buildValidationErrors(errs []error, paramErrs []paramV
 // decomposeValue decomposes a multi-segment value into its component parts and adds them
 // to the values map with suffixed keys. For dates, creates param_year, param_month, param_day.
 // For other types, creates param_1, param_2, param_3, etc.
-func (pt *ParsedTemplate) decomposeValue(valuesMap pvtypes.ValuesMap, name Identifier, value string, dataType PVDataType) {
+func (pt *ParsedTemplate) decomposeValue(valuesMap pvtypes.ValuesMap, name Identifier, value string, dataType PVDataType, separator string) {
 	// Split by the appropriate separator
 	var parts []string
-	var separator string
 
 	switch dataType {
 	case DateType:
-		// Date type uses slash separator
-		separator = "/"
-		parts = strings.Split(value, separator)
+		// Date type always uses slash separator
+		parts = strings.Split(value, MultiSegmentSeparator)
 
 		// Add decomposed date components with semantic names
 		if len(parts) >= 1 && parts[0] != "" {
@@ -397,8 +557,7 @@ func (pt *ParsedTemplate) decomposeValue(valuesMap pvtypes.ValuesMap, name Ident
 		}
 
 	default:
-		// For all other types, use slash separator and numeric suffixes
-		separator = "/"
+		// For all other types, use the parameter's separator and numeric suffixes
 		parts = strings.Split(value, separator)
 
 		// Add decomposed components with numeric suffixes
@@ -412,11 +571,53 @@ func (pt *ParsedTemplate) decomposeValue(valuesMap pvtypes.ValuesMap, name Ident
 	}
 }
 
+// FirstLiteral returns the template's leading path segment when it is a plain
+// literal, e.g. "api" for "/api/v1/users/{id}", so routes can be bucketed by
+// first segment. Returns false when the template starts with a parameter, as
+// in "/{category}/items", or has no path segments at all.
+func (pt *ParsedTemplate) FirstLiteral() (literal string, ok bool) {
+	if len(pt.segments) == 0 {
+		goto end
+	}
+	if pt.segments[0].IsParameter() || pt.segments[0].Raw == "" {
+		goto end
+	}
+	literal = pt.segments[0].Raw
+	ok = true
+end:
+	return literal, ok
+}
+
+// RegexString returns the source of the regular expression compiled from the
+// template's path, e.g. "^/users/([^/]+)$" for "/users/{id:int}", so a
+// template that does not match as expected can be debugged. The query portion
+// of a template is matched separately and never appears in the pattern.
+// Returns "" for a zero ParsedTemplate. Templates with only literals and
+// simple parameters are matched without compiling this pattern, but it still
+// describes exactly what they match.
+func (pt *ParsedTemplate) RegexString() string {
+	return pt.regexSource
+}
+
 // Parameters returns the Ordered Map of parameters
 func (pt *ParsedTemplate) Parameters() *pvtypes.OrderedMap[Identifier, Parameter] {
 	return pt.params
 }
 
+// ParameterPositions returns each parameter's position, e.g. for building
+// positional SQL arguments. Path parameters are numbered from 0 in
+// declaration order, so a path parameter's position plus one is the index
+// of its capture group in RegexString(); query parameters follow them.
+// Parameters supplied only via RouteArgs.Parameters keep their
+// ParameterArgs.Position.
+func (pt *ParsedTemplate) ParameterPositions() map[Identifier]int {
+	positions := make(map[Identifier]int, pt.params.Len())
+	for name, param := range pt.params.Iterator() {
+		positions[name] = param.Position()
+	}
+	return positions
+}
+
 // Validate checks parameter values against the template requirements.
 // It validates each provided parameter value against its type and constraints,
 // and ensures all required parameters are present.
@@ -445,7 +646,7 @@ func (pt *ParsedTemplate) Validate(params map[Identifier]any) (err error) {
 		}
 
 		// Convert value to string for validation
-		valueStr = fmt.Sprintf("%v", value)
+		valueStr = p.FormatValue(value)
 
 		// Validate using existing Parameter.Validate method
 		err = p.Validate(valueStr)
@@ -457,8 +658,9 @@ func (pt *ParsedTemplate) Validate(params map[Identifier]any) (err error) {
 	return CombineErrs(errs)
 }
 
-// Substitute builds a path from parameter values by replacing template placeholders.
-// TODO: Implementation needed - should build path by substituting values.
+// Substitute builds a path from parameter values by replacing template
+// placeholders, appending query parameters in the order of values. Values are
+// written as given, so callers such as Router.URLFor() escape them first.
 func (pt *ParsedTemplate) Substitute(values *pvtypes.OrderedMap[Identifier, any]) (result string, err error) {
 	var errs []error
 	var query string
@@ -471,26 +673,22 @@ func (pt *ParsedTemplate) Substitute(values *pvtypes.OrderedMap[Identifier, any]
 			sbp.WriteString(seg.Raw)
 			continue
 		}
-		if seg.Prefix != "" {
-			sbp.WriteString(seg.Prefix)
-		}
-		// We currently only support one parameter per segment
-		paramName := seg.Parameters[0].Name
-		value, ok := values.Get(paramName)
-		if !ok {
-			errs = append(errs, NewErr(
-				ErrParameterNotFoundInValuesMap,
-				ErrPathParameterNotFoundInValuesMap,
-				"parameter_name", paramName,
-				"values_map", values,
-			))
-			continue
-		}
-		sbp.WriteString(fmt.Sprintf("%v", value))
-		if seg.Suffix != "" {
-			sbp.WriteString(seg.Suffix)
+		for i, segParam := range seg.Parameters {
+			sbp.WriteString(seg.LiteralBefore(i))
+			value, ok := values.Get(segParam.Name)
+			if !ok {
+				errs = append(errs, NewErr(
+					ErrParameterNotFoundInValuesMap,
+					ErrPathParameterNotFoundInValuesMap,
+					"parameter_name", segParam.Name,
+					"values_map", values,
+				))
+				continue
+			}
+			sbp.WriteString(fmt.Sprintf("%v", value))
+			n++
 		}
-		n++
+		sbp.WriteString(seg.Suffix)
 	}
 	sbq := strings.Builder{}
 	for name, value := range values.Iterator() {
@@ -499,7 +697,7 @@ func (pt *ParsedTemplate) Substitute(values *pvtypes.OrderedMap[Identifier, any]
 			errs = append(errs, NewErr(
 				ErrParameterNotFoundInValuesMap,
 				ErrQueryParameterNotFoundInValuesMap,
-				"parameter_name", p.Name,
+				"parameter_name", name,
 				"values_map", values,
 			))
 			continue
@@ -507,7 +705,7 @@ func (pt *ParsedTemplate) Substitute(values *pvtypes.OrderedMap[Identifier, any]
 		if p.Location() != QueryLocation {
 			continue
 		}
-		sbq.WriteString(fmt.Sprintf("%s=%v&", p.Name, value))
+		sbq.WriteString(fmt.Sprintf("%s=%s&", p.Name, p.FormatValue(value)))
 	}
 	if len(errs) > 0 {
 		err = CombineErrs(errs)
@@ -523,7 +721,8 @@ end:
 }
 
 // Example generates an example URL for this template.
-// When called with empty args, generates a simple example with all required parameters.
+// When called with empty args, generates a simple example with all required parameters,
+// using varied values when args[0].Rand is set.
 // When called with error context (ProblematicParam, UserProvidedParams, ValidationErr),
 // generates a context-aware example following ADR-018 guidelines:
 // - Only includes required parameters OR parameters user actually provided
@@ -534,12 +733,16 @@ end:
 func (pt *ParsedTemplate) Example(args ...*pvtypes.ExampleArgs) (result string) {
 	// Handle simple case - no args provided
 	if len(args) == 0 || (args[0].ProblematicParam.Name == "" && args[0].UserProvidedParams == nil) {
+		var arg *pvtypes.ExampleArgs
+		if len(args) != 0 {
+			arg = args[0]
+		}
 		params := pvtypes.NewOrderedMap[Identifier, any](pt.params.Len())
 		for param := range pt.params.Values() {
 			if param.Optional {
 				continue
 			}
-			params.Set(param.Name, param.Example(nil, nil))
+			params.Set(param.Name, param.Example(nil, arg))
 		}
 		result, _ = pt.Substitute(params)
 		return result
@@ -635,6 +838,33 @@ func (pt *ParsedTemplate) Example(args ...*pvtypes.ExampleArgs) (result string)
 	return result
 }
 
+// CurlExample returns a copy-pasteable cURL command for the template built
+// from Example(), e.g. "curl -X GET 'http://host/users/123?limit=20'". An
+// empty method falls back to the template's own method prefix and then to
+// GET. For POST, PUT and PATCH a '{BODY}' placeholder is passed with -d.
+func (pt *ParsedTemplate) CurlExample(method HTTPMethod, baseURL string) string {
+	if method == "" {
+		method = pt.method
+	}
+	if method == "" {
+		method = http.MethodGet
+	}
+	method = HTTPMethod(strings.ToUpper(string(method)))
+	url := strings.TrimSuffix(baseURL, "/") + pt.Example()
+
+	sb := strings.Builder{}
+	sb.WriteString("curl -X ")
+	sb.WriteString(string(method))
+	sb.WriteString(" '")
+	sb.WriteString(strings.ReplaceAll(url, "'", `'\''`))
+	sb.WriteByte('\'')
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		sb.WriteString(" -d '{BODY}'")
+	}
+	return sb.String()
+}
+
 // buildExampleURL constructs the final URL with path params and query params in correct order
 func (pt *ParsedTemplate) buildExampleURL(pathParams, correctQueryParams, problematicQueryParams *pvtypes.OrderedMap[Identifier, any]) string {
 	var errs []error
@@ -647,25 +877,21 @@ func (pt *ParsedTemplate) buildExampleURL(pathParams, correctQueryParams, proble
 			sbp.WriteString(seg.Raw)
 			continue
 		}
-		if seg.Prefix != "" {
-			sbp.WriteString(seg.Prefix)
-		}
-		// We currently only support one parameter per segment
-		paramName := seg.Parameters[0].Name
-		value, ok := pathParams.Get(paramName)
-		if !ok {
-			// This shouldn't happen if logic is correct
-			errs = append(errs, NewErr(
-				ErrParameterNotFoundInValuesMap,
-				ErrPathParameterNotFoundInValuesMap,
-				"parameter_name", paramName,
-			))
-			continue
-		}
-		sbp.WriteString(fmt.Sprintf("%v", value))
-		if seg.Suffix != "" {
-			sbp.WriteString(seg.Suffix)
+		for i, segParam := range seg.Parameters {
+			sbp.WriteString(seg.LiteralBefore(i))
+			value, ok := pathParams.Get(segParam.Name)
+			if !ok {
+				// This shouldn't happen if logic is correct
+				errs = append(errs, NewErr(
+					ErrParameterNotFoundInValuesMap,
+					ErrPathParameterNotFoundInValuesMap,
+					"parameter_name", segParam.Name,
+				))
+				continue
+			}
+			sbp.WriteString(fmt.Sprintf("%v", value))
 		}
+		sbp.WriteString(seg.Suffix)
 	}
 
 	// Build query string: correct params first, then problematic params last
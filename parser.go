@@ -6,15 +6,33 @@ package pathvars
 
 import (
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/mikeschinkel/go-pathvars/pvtypes"
 )
 
+// Template complexity limits enforced by ParseTemplate() so that pathological
+// templates fail with ErrTemplateTooComplex instead of compiling an unbounded
+// regex. Both are generous for hand-written routes and may be raised or
+// lowered before routes are added.
+var (
+	// MaxTemplateLength is the maximum length of a template in bytes.
+	MaxTemplateLength = 8192
+
+	// MaxTemplateParameters is the maximum number of {...} parameters in a template.
+	MaxTemplateParameters = 128
+)
+
 // ParseTemplate parses a template string like "/users/{id:int}/posts?{limit?10:int}"
 // into a Template object with compiled regex and parameter definitions.
-// Returns an error if the template syntax is invalid.
+// A PathSpec-style method prefix such as "GET /users/{id}" is accepted and
+// reported by Method() rather than treated as part of the path.
+// Returns an error if the template syntax is invalid, joined with a
+// *TemplateDiagnostic locating the fault.
 func ParseTemplate(template string) (t *ParsedTemplate, err error) {
 	var segments []Segment
 	var params *pvtypes.OrderedMap[Identifier, Parameter]
@@ -28,8 +46,12 @@ func ParseTemplate(template string) (t *ParsedTemplate, err error) {
 	if err != nil {
 		goto end
 	}
+	t.method, _ = splitTemplateMethod(template)
 
 end:
+	if err != nil {
+		err = withTemplateDiagnostic(template, err)
+	}
 	return t, err
 }
 
@@ -52,6 +74,13 @@ func parseSegments(template string) (segments []Segment, params *pvtypes.Ordered
 		goto end
 	}
 
+	err = checkTemplateComplexity(template)
+	if err != nil {
+		goto end
+	}
+
+	_, template = splitTemplateMethod(template)
+
 	// Split template into path and query parts at the first '?' that's not inside braces
 	pathPart, queryPart, err = splitPathAndQuery(template)
 	if err != nil {
@@ -69,12 +98,12 @@ func parseSegments(template string) (segments []Segment, params *pvtypes.Ordered
 	// Set position for path parameters and add to combined params map
 	params = pvtypes.NewOrderedMap[Identifier, Parameter](len(pathParams))
 	position = 0
-	for name, p := range pathParams {
+	for _, p := range paramsByPosition(pathParams) {
 		p = p.WithLocation(PathLocation).WithPosition(position)
 		if p.Constraints() == nil {
 			p = p.WithConstraints(make([]Constraint, 0))
 		}
-		params.Set(name, p)
+		params.Set(p.Name, p)
 		position++
 	}
 
@@ -87,7 +116,18 @@ func parseSegments(template string) (segments []Segment, params *pvtypes.Ordered
 		}
 
 		// Add query parameters to combined params map
-		for name, p := range queryParams {
+		for _, p := range paramsByPosition(queryParams) {
+			name := p.Name
+			if _, exists := pathParams[name]; exists {
+				err = NewErr(
+					ErrInvalidTemplate,
+					ErrDuplicateParameterName,
+					"parameter_name", name,
+					"parameter_location", QueryLocation,
+					"conflicts_with", PathLocation,
+				)
+				goto end
+			}
 			params.Set(name, p.WithLocation(QueryLocation))
 		}
 	}
@@ -103,6 +143,24 @@ end:
 	return segments, params, err
 }
 
+// splitTemplateMethod splits a leading method token such as "GET " from a
+// PathSpec-style template like "GET /users/{id}", returning an empty method
+// and the template unchanged when it has no such prefix. Only a token of
+// uppercase letters followed by spaces and then '/' counts as a method.
+func splitTemplateMethod(template string) (method HTTPMethod, path string) {
+	token, rest, found := strings.Cut(template, " ")
+	rest = strings.TrimLeft(rest, " ")
+	if !found || token == "" || !strings.HasPrefix(rest, "/") {
+		return "", template
+	}
+	for _, ch := range token {
+		if ch < 'A' || ch > 'Z' {
+			return "", template
+		}
+	}
+	return HTTPMethod(token), rest
+}
+
 // buildParsedTemplate creates a regex pattern from template segments for
 // efficient path matching. Handles both regular parameters and multi-segment
 // parameters that can span multiple path segments.
@@ -114,6 +172,7 @@ func buildParsedTemplate(template string, segments []Segment, params *pvtypes.Or
 	var exists bool
 	var i int
 	var regex *regexp.Regexp
+	var matcher *segmentMatcher
 
 	// Build regex string from segments
 	sb.WriteByte('^')
@@ -125,37 +184,35 @@ func buildParsedTemplate(template string, segments []Segment, params *pvtypes.Or
 			sb.WriteString(regexp.QuoteMeta(segment.Raw))
 			continue
 		}
-		// Extract parameter name to check if it's multi-segment
-		// We currently only support one (1) parameter per segment
-		paramName = segment.Parameters[0].Name
-		param, exists = params.Get(paramName)
-
-		// Regular parameters capture any non-slash characters
-		captureRegex := "([^/]+)"
-		if exists && param.MultiSegment {
-			// Multi-segment parameters capture non-slash chars optionally followed by more segments
-			captureRegex = "([^/]+(?:/[^/]+)*)"
-		}
-		if segment.Prefix != "" {
-			sb.WriteString(segment.Prefix)
-		}
-		sb.WriteString(captureRegex)
-		if segment.Suffix != "" {
-			sb.WriteString(segment.Suffix)
+		for j, segParam := range segment.Parameters {
+			// Extract parameter name to check if it's multi-segment
+			paramName = segParam.Name
+			param, exists = params.Get(paramName)
+
+			// Regular parameters capture any non-slash characters
+			captureRegex := "([^/]+)"
+			if exists && param.MultiSegment {
+				// Multi-segment parameters capture non-slash chars optionally followed by more segments
+				captureRegex = "([^/]+(?:/[^/]+)*)"
+			}
+			sb.WriteString(regexp.QuoteMeta(segment.LiteralBefore(j)))
+			sb.WriteString(captureRegex)
 		}
+		sb.WriteString(regexp.QuoteMeta(segment.Suffix))
 		segments[i] = segment
 	}
 	sb.WriteByte('$')
-	// Compile and return regex
-	regex, err = regexp.Compile(sb.String())
+	regex, matcher, err = compilePathMatcher(sb.String(), segments, params)
 	if err != nil {
 		goto end
 	}
 	pt = &ParsedTemplate{
-		original: template,
-		segments: segments,
-		params:   params,
-		regex:    regex,
+		original:    template,
+		segments:    segments,
+		params:      params,
+		regexSource: sb.String(),
+		regex:       regex,
+		matcher:     matcher,
 	}
 
 end:
@@ -169,6 +226,50 @@ end:
 	return pt, err
 }
 
+// checkTemplateComplexity returns ErrTemplateTooComplex if template exceeds
+// MaxTemplateLength or has more than MaxTemplateParameters top-level '{'
+// parameters. Braces nested within a parameter, e.g. in regex[a{2}], are not
+// counted.
+func checkTemplateComplexity(template string) (err error) {
+	var depth, count int
+
+	if len(template) > MaxTemplateLength {
+		err = NewErr(
+			ErrInvalidTemplate,
+			ErrTemplateTooComplex,
+			"template_length", len(template),
+			"max_template_length", MaxTemplateLength,
+		)
+		goto end
+	}
+
+	for i := 0; i < len(template); i++ {
+		switch template[i] {
+		case '{':
+			if depth == 0 {
+				count++
+			}
+			depth++
+		case '}':
+			if depth > 0 {
+				depth--
+			}
+		}
+	}
+	if count > MaxTemplateParameters {
+		err = NewErr(
+			ErrInvalidTemplate,
+			ErrTemplateTooComplex,
+			"parameter_count", count,
+			"max_template_parameters", MaxTemplateParameters,
+		)
+		goto end
+	}
+
+end:
+	return err
+}
+
 // parsePathSegments splits a path template into segments, being careful not to split
 // on slashes that are inside parameter constraint definitions like {date:date:yyyy/mm/dd}.
 // Handles nested braces and validates brace matching.
@@ -318,14 +419,30 @@ end:
 	return pathPart, queryPart, err
 }
 
+// compilePathMatcher returns a segmentMatcher for templates simple enough to
+// match without regexp, which also spares them the cost of compiling source
+// when routes are added or decoded at cold start. Otherwise it compiles source.
+func compilePathMatcher(source string, segments []Segment, params *pvtypes.OrderedMap[Identifier, Parameter]) (regex *regexp.Regexp, matcher *segmentMatcher, err error) {
+	matcher = newSegmentMatcher(segments, params)
+	if matcher != nil && utf8.ValidString(source) {
+		goto end
+	}
+	// regexp rejects templates that are not valid UTF-8; keep doing so even
+	// for templates the matcher could handle
+	matcher = nil
+	regex, err = regexp.Compile(source)
+end:
+	return regex, matcher, err
+}
+
 // parsePathPart parses the path portion of a template into segments and parameters.
 // Extracts parameter definitions from path segments and validates their syntax.
 func parsePathPart(pathPart string) (segments []Segment, params map[Identifier]Parameter, err error) {
 	var parts []string
 	var part string
 	var segment Segment
-	var param Parameter
 	var position int
+	var exists bool
 	var errs []error
 
 	params = make(map[Identifier]Parameter)
@@ -354,11 +471,22 @@ func parsePathPart(pathPart string) (segments []Segment, params map[Identifier]P
 		if !segment.IsParameter() {
 			continue
 		}
-		param = segment.Parameters[0]
-		segments[len(segments)-1].Parameters[0] = param.WithPosition(position)
-		// We currently only support one parameter per segment
-		params[param.Name] = param
-		position++
+		for i, param := range segment.Parameters {
+			param = param.WithPosition(position)
+			segments[len(segments)-1].Parameters[i] = param
+			_, exists = params[param.Name]
+			if exists {
+				errs = append(errs, NewErr(
+					ErrInvalidTemplate,
+					ErrDuplicateParameterName,
+					"parameter_name", param.Name,
+					"parameter_location", PathLocation,
+				))
+				continue
+			}
+			params[param.Name] = param
+			position++
+		}
 	}
 	err = CombineErrs(errs)
 end:
@@ -370,6 +498,16 @@ end:
 	return segments, params, err
 }
 
+// paramsByPosition returns the parameters of params ordered by Position(), so
+// templates list their parameters in declaration order rather than map order.
+func paramsByPosition(params map[Identifier]Parameter) []Parameter {
+	ps := slices.Collect(maps.Values(params))
+	slices.SortFunc(ps, func(a, b Parameter) int {
+		return a.Position() - b.Position()
+	})
+	return ps
+}
+
 // parseQueryPart parses the query portion of a template like "{owner:email}&{limit?10:int}".
 // Extracts parameter definitions from query parameter specifications.
 func parseQueryPart(queryPart string, startPosition int) (params map[Identifier]Parameter, err error) {
@@ -377,6 +515,7 @@ func parseQueryPart(queryPart string, startPosition int) (params map[Identifier]
 	var paramSpec string
 	var param Parameter
 	var position int
+	var exists bool
 
 	params = make(map[Identifier]Parameter)
 
@@ -403,6 +542,17 @@ func parseQueryPart(queryPart string, startPosition int) (params map[Identifier]
 			)
 			goto end
 		}
+		_, exists = params[param.Name]
+		if exists {
+			err = NewErr(
+				ErrInvalidTemplate,
+				ErrDuplicateParameterName,
+				"parameter_name", param.Name,
+				"parameter_location", QueryLocation,
+				"position", position,
+			)
+			goto end
+		}
 		params[param.Name] = param.WithPosition(position)
 		position++
 	}
@@ -0,0 +1,138 @@
+package pathvars
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// closestMismatch returns an error explaining where path first diverges from
+// the template of the route that came closest to matching it, for
+// RouterArgs.DescribeMismatch. Only routes accepting method, and req's host
+// when there is a request, are considered; the route whose path lines up for
+// the most segments wins, with ties going to the route tried first. It
+// returns nil when no such route diverges structurally.
+func (r *Router) closestMismatch(req *http.Request, method, path string) (err error) {
+	best := -1
+	for _, route := range r.routes {
+		if !route.MatchesMethod(method) {
+			continue
+		}
+		if req != nil && !route.MatchesHost(req.Host) {
+			continue
+		}
+		pt := route.ParsedTemplate
+		index, mismatch := pt.pathMismatch(r.normalizePath(pt, path), r.caseInsensitive)
+		if mismatch == nil || index <= best {
+			continue
+		}
+		best = index
+		err = WithErr(mismatch,
+			"route_method", route.Method,
+			"template", pt.Original(),
+		)
+	}
+	return err
+}
+
+// pathMismatch reports the first point at which path diverges structurally
+// from the template. For a literal that differs, index is the position of its
+// segment counted from 0 after the leading slash, as for
+// ParameterError.SegmentIndex; for a segment count the template cannot
+// accept, index is the number of segments that lined up. It returns a nil
+// error when path has the template's shape, e.g. when only a value failed to
+// match a parameter's pattern.
+func (pt *ParsedTemplate) pathMismatch(path string, foldCase bool) (index int, err error) {
+	var parts []string
+	var span int
+
+	if pt.matrixParameters {
+		path, _ = splitMatrixParameters(path)
+	}
+	path = strings.TrimPrefix(path, "/")
+	if path != "" {
+		parts = strings.Split(path, "/")
+	}
+	segments := pt.segments
+
+	// Segments line up from the start until the first multi-segment parameter
+	span = slices.IndexFunc(segments, pt.spansSegments)
+	if span < 0 {
+		span = len(segments)
+	}
+	for index = 0; index < span && index < len(parts); index++ {
+		if !segmentFits(segments[index], parts[index], foldCase) {
+			err = segmentLiteralMismatch(segments[index], parts, index)
+			goto end
+		}
+	}
+
+	if span == len(segments) {
+		if len(parts) != len(segments) {
+			err = NewErr(
+				ErrPathMismatch,
+				ErrSegmentCountMismatch,
+				"expected_segments", len(segments),
+				"actual_segments", len(parts),
+			)
+		}
+		goto end
+	}
+
+	// A multi-segment parameter spans one or more segments, after which the
+	// rest of the template lines up with the end of the path
+	if len(parts) < len(segments) {
+		err = NewErr(
+			ErrPathMismatch,
+			ErrSegmentCountMismatch,
+			"minimum_segments", len(segments),
+			"actual_segments", len(parts),
+		)
+		goto end
+	}
+	for i := span + 1; i < len(segments); i++ {
+		if pt.spansSegments(segments[i]) {
+			continue
+		}
+		index = len(parts) - len(segments) + i
+		if !segmentFits(segments[i], parts[index], foldCase) {
+			err = segmentLiteralMismatch(segments[i], parts, index)
+			goto end
+		}
+	}
+	index = len(parts)
+
+end:
+	return index, err
+}
+
+// segmentFits reports whether part has the literal text of segment: all of it
+// for a literal segment, or the text around the parameters of a parameter
+// segment such as "v{n:int}".
+func segmentFits(segment Segment, part string, foldCase bool) bool {
+	equal := func(a, b string) bool {
+		if foldCase {
+			return strings.EqualFold(a, b)
+		}
+		return a == b
+	}
+	if !segment.IsParameter() {
+		return equal(part, segment.Raw)
+	}
+	if len(part) < len(segment.Prefix)+len(segment.Suffix) {
+		return false
+	}
+	return equal(part[:len(segment.Prefix)], segment.Prefix) &&
+		equal(part[len(part)-len(segment.Suffix):], segment.Suffix)
+}
+
+// segmentLiteralMismatch returns the error for parts[index] not fitting segment.
+func segmentLiteralMismatch(segment Segment, parts []string, index int) error {
+	return NewErr(
+		ErrPathMismatch,
+		ErrSegmentLiteralMismatch,
+		"segment_index", index,
+		"expected", segment.Raw,
+		"actual", parts[index],
+	)
+}
@@ -0,0 +1,40 @@
+package pathvars
+
+import (
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// PathValidator validates a route's path as a whole once each path segment
+// has matched and passed its own validation, for rules no single segment can
+// express, e.g. that /{y:int}/{m:int}/{d:int} forms a real date. Unlike a
+// CrossCheck it sees only values taken from the path, including those
+// decomposed from multi-segment parameters, and never query values.
+type PathValidator func(values pvtypes.ValuesMap) error
+
+// validatePath runs the route's PathValidator, if any, against the path
+// values of values. A rejection is the client's fault.
+func (r Route) validatePath(values pvtypes.ValuesMap) (err error) {
+	var pathValues pvtypes.ValuesMap
+
+	if r.PathValidator == nil {
+		goto end
+	}
+	pathValues = pvtypes.NewValuesMap(values.Len())
+	for name, value := range values.Iterator() {
+		p, exists := r.ParsedTemplate.params.Get(name)
+		if exists && p.Location() != PathLocation {
+			continue
+		}
+		pathValues.Set(name, value)
+	}
+	err = r.PathValidator(pathValues)
+	if err != nil {
+		err = WithErr(err,
+			ErrPathValidationFailed,
+			"endpoint", r.Endpoint(),
+			"fault_source", ClientFaultSource.Slug(),
+		)
+	}
+end:
+	return err
+}
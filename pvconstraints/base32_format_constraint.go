@@ -0,0 +1,153 @@
+package pvconstraints
+
+import (
+	"encoding/base32"
+	"fmt"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// Format names supported by format[base32] and format[base32crockford] on strings
+const (
+	Base32Format          = "base32"
+	Base32CrockfordFormat = "base32crockford"
+)
+
+// crockfordAlphabet is Crockford's base32 alphabet, which omits I, L, O and U
+// to avoid confusion with 1, 0 and each other, as used by ULIDs.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// crockfordEncoding decodes Crockford base32, which is never padded.
+var crockfordEncoding = base32.NewEncoding(crockfordAlphabet).WithPadding(base32.NoPadding)
+
+// Note: Base32FormatConstraint is not registered directly.
+// It's handled by DateFormatConstraint.Parse() for the string data type.
+
+var _ pvtypes.Constraint = (*Base32FormatConstraint)(nil)
+
+// Base32FormatConstraint validates that a string is base32 encoded. With
+// format[base32] it uses the RFC 4648 §6 alphabet A-Z and 2-7, as in TOTP
+// secrets, with or without '=' padding to a multiple of 8 characters. With
+// format[base32crockford] it uses Crockford's alphabet of digits and
+// uppercase letters other than I, L, O and U, without padding.
+type Base32FormatConstraint struct {
+	pvtypes.BaseConstraint
+	crockford bool
+}
+
+func NewBase32FormatConstraint(crockford bool) *Base32FormatConstraint {
+	c := &Base32FormatConstraint{crockford: crockford}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *Base32FormatConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *Base32FormatConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.FormatConstraintType
+}
+
+func (c *Base32FormatConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseBase32FormatConstraint(value)
+}
+
+func (c *Base32FormatConstraint) Rule() string {
+	if c.crockford {
+		return Base32CrockfordFormat
+	}
+	return Base32Format
+}
+
+func (c *Base32FormatConstraint) Validate(value string) (err error) {
+	_, err = c.decode(value)
+	if err != nil {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidBase32Format,
+			"value", value,
+			"format", c.Rule(),
+			err,
+		)
+	}
+	return err
+}
+
+func (c *Base32FormatConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	alphabet := "A-Z and 2-7, optionally '='-padded to a multiple of 8 characters"
+	if c.crockford {
+		alphabet = "0-9 and A-Z except I, L, O and U, without padding"
+	}
+	return fmt.Sprintf("Ensure parameter '%s' is base32 encoded using only %s, for example: %s",
+		param.Name,
+		alphabet,
+		example,
+	)
+}
+
+// Example returns the unpadded encoding of "sample-token" in the
+// constraint's alphabet.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *Base32FormatConstraint) Example(err error) any {
+	if c.crockford {
+		return crockfordEncoding.EncodeToString([]byte("sample-token"))
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("sample-token"))
+}
+
+// decode decodes value in the constraint's alphabet. Standard base32 is
+// padded when value ends in '=' and unpadded otherwise.
+func (c *Base32FormatConstraint) decode(value string) (decoded []byte, err error) {
+	if c.crockford {
+		err = checkUnpaddedBase32Length(value)
+		if err != nil {
+			goto end
+		}
+		decoded, err = crockfordEncoding.DecodeString(value)
+		goto end
+	}
+	if strings.HasSuffix(value, "=") {
+		decoded, err = base32.StdEncoding.DecodeString(value)
+		goto end
+	}
+	err = checkUnpaddedBase32Length(value)
+	if err != nil {
+		goto end
+	}
+	decoded, err = base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(value)
+end:
+	return decoded, err
+}
+
+// checkUnpaddedBase32Length rejects lengths that leave a partial byte, which
+// encoding/base32 silently drops when decoding without padding. A final group
+// of 8 characters may only be cut to 2, 4, 5 or 7 characters.
+func checkUnpaddedBase32Length(value string) (err error) {
+	switch len(value) % 8 {
+	case 1, 3, 6:
+		err = pvtypes.NewErr(
+			ErrInvalidBase32Length,
+			"length", len(value),
+		)
+	}
+	return err
+}
+
+// ParseBase32FormatConstraint parses the base32 or base32crockford format
+// specification, neither of which takes options.
+func ParseBase32FormatConstraint(spec string) (constraint *Base32FormatConstraint, err error) {
+	switch strings.ToLower(strings.TrimSpace(spec)) {
+	case Base32Format:
+		constraint = NewBase32FormatConstraint(false)
+	case Base32CrockfordFormat:
+		constraint = NewBase32FormatConstraint(true)
+	default:
+		err = pvtypes.NewErr(
+			ErrInvalidBase32FormatConstraint,
+			"base32_format_spec", spec,
+		)
+	}
+	return constraint, err
+}
@@ -0,0 +1,114 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.Base32FormatConstraint)(nil)
+
+func TestBase32FormatConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantRule string
+		wantErr  bool
+	}{
+		{"base32", "base32", "base32", false},
+		{"uppercase", "BASE32", "base32", false},
+		{"crockford", "base32crockford", "base32crockford", false},
+		{"crockford-uppercase", "BASE32CROCKFORD", "base32crockford", false},
+		{"with-options", "base32:padded", "", true},
+		{"base32hex", "base32hex", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseBase32FormatConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseBase32FormatConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseBase32FormatConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.FormatConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.FormatConstraintType)
+			}
+			if constraint.Rule() != tt.wantRule {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestBase32FormatConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		value   string
+		wantErr bool
+	}{
+		// Standard RFC 4648 alphabet: A-Z and 2-7
+		{"std-totp-secret", "base32", "JBSWY3DPEHPK3PXP", false},
+		{"std-padded", "base32", "MZXW6YTBOI======", false},
+		{"std-unpadded", "base32", "MZXW6YTBOI", false},
+		{"std-rejects-crockford-digits", "base32", "CSQPYRK1E8", true},
+		{"std-rejects-lowercase", "base32", "jbswy3dpehpk3pxp", true},
+		{"std-rejects-short-padding", "base32", "MZXW6YTBOI==", true},
+		{"std-rejects-misplaced-padding", "base32", "MZ=W6YTBOI======", true},
+		{"std-rejects-bad-length", "base32", "MZXW6YTBO", true},
+		{"std-rejects-partial-group", "base32", "MZX", true},
+
+		// Crockford alphabet: 0-9 and A-Z without I, L, O, U
+		{"crockford-valid", "base32crockford", "CSQPYRK1E8", false},
+		{"crockford-ulid", "base32crockford", "01ARZ3NDEKTSV4RRFFQ69G5FAV", false},
+		{"crockford-rejects-i-and-o", "base32crockford", "MZXW6YTBOI", true},
+		{"crockford-rejects-l", "base32crockford", "CSQPYRKLE8", true},
+		{"crockford-rejects-u", "base32crockford", "CSQPYRKUE8", true},
+		{"crockford-rejects-padding", "base32crockford", "CSQPYRK1E8======", true},
+		{"crockford-rejects-lowercase", "base32crockford", "csqpyrk1e8", true},
+		{"crockford-rejects-bad-length", "base32crockford", "CSQPYRK1E", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseBase32FormatConstraint(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseBase32FormatConstraint() failed: %v", err)
+			}
+			err = constraint.Validate(tt.value)
+			if tt.wantErr && err == nil {
+				t.Errorf("Validate(%q) expected error but got none", tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate(%q) unexpected error: %v", tt.value, err)
+			}
+		})
+	}
+}
+
+func TestBase32FormatConstraintExample(t *testing.T) {
+	for _, spec := range []string{"base32", "base32crockford"} {
+		t.Run(spec, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseBase32FormatConstraint(spec)
+			if err != nil {
+				t.Fatalf("ParseBase32FormatConstraint() failed: %v", err)
+			}
+			example := constraint.Example(nil)
+			err = constraint.Validate(example.(string))
+			if err != nil {
+				t.Errorf("Example() %v does not satisfy its own constraint: %v", example, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package pvconstraints
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// Base64URLFormat is the format name supported by format[base64url] on strings
+const Base64URLFormat = "base64url"
+
+// Note: Base64URLFormatConstraint is not registered directly.
+// It's handled by DateFormatConstraint.Parse() for the string data type.
+
+var _ pvtypes.Constraint = (*Base64URLFormatConstraint)(nil)
+
+// Base64URLFormatConstraint validates that a string is base64url encoded per
+// RFC 4648 §5, with or without '=' padding, as is common for opaque tokens in
+// paths. Combine it with decodedlen[...] to bound the decoded byte length.
+type Base64URLFormatConstraint struct {
+	pvtypes.BaseConstraint
+}
+
+func NewBase64URLFormatConstraint() *Base64URLFormatConstraint {
+	c := &Base64URLFormatConstraint{}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *Base64URLFormatConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *Base64URLFormatConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.FormatConstraintType
+}
+
+func (c *Base64URLFormatConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseBase64URLFormatConstraint(value)
+}
+
+func (c *Base64URLFormatConstraint) Rule() string {
+	return Base64URLFormat
+}
+
+func (c *Base64URLFormatConstraint) Validate(value string) (err error) {
+	_, err = decodeBase64URL(value)
+	if err != nil {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidBase64URLFormat,
+			"value", value,
+			err,
+		)
+	}
+	return err
+}
+
+func (c *Base64URLFormatConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is base64url encoded using only A-Z, a-z, 0-9, '-' and '_', for example: %s",
+		param.Name,
+		example,
+	)
+}
+
+// Example returns the unpadded base64url encoding of "sample-token".
+// The error parameter is currently unused but maintains interface consistency.
+func (c *Base64URLFormatConstraint) Example(err error) any {
+	return base64.RawURLEncoding.EncodeToString([]byte("sample-token"))
+}
+
+// ParseBase64URLFormatConstraint parses the base64url format specification, which takes no options.
+func ParseBase64URLFormatConstraint(spec string) (constraint *Base64URLFormatConstraint, err error) {
+	if !strings.EqualFold(strings.TrimSpace(spec), Base64URLFormat) {
+		err = pvtypes.NewErr(
+			ErrInvalidBase64URLFormatConstraint,
+			"base64url_format_spec", spec,
+		)
+		goto end
+	}
+	constraint = NewBase64URLFormatConstraint()
+end:
+	return constraint, err
+}
+
+// decodeBase64URL decodes value as base64url, padded when it ends in '='
+// and unpadded otherwise.
+func decodeBase64URL(value string) ([]byte, error) {
+	if strings.HasSuffix(value, "=") {
+		return base64.URLEncoding.DecodeString(value)
+	}
+	return base64.RawURLEncoding.DecodeString(value)
+}
@@ -0,0 +1,86 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.Base64URLFormatConstraint)(nil)
+
+func TestBase64URLFormatConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"base64url", "base64url", false},
+		{"uppercase", "BASE64URL", false},
+		{"with-options", "base64url:padded", true},
+		{"standard-base64", "base64", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseBase64URLFormatConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseBase64URLFormatConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseBase64URLFormatConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.FormatConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.FormatConstraintType)
+			}
+		})
+	}
+}
+
+func TestBase64URLFormatConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"unpadded", "AAECAwQFBgcICQoLDA0ODw", false},
+		{"padded", "-__-AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxw=", false},
+		{"url-safe-alphabet", "-__-", false},
+		{"empty", "", false},
+
+		{"standard-alphabet", "+//+", true},
+		{"invalid-length", "AAECA", true},
+		{"stray-character", "AAEC$wQF", true},
+		{"misplaced-padding", "AA=A", true},
+	}
+
+	constraint := pvconstraints.NewBase64URLFormatConstraint()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := constraint.Validate(tt.value)
+			if tt.wantErr && err == nil {
+				t.Errorf("Validate(%q) expected error but got none", tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate(%q) unexpected error: %v", tt.value, err)
+			}
+		})
+	}
+}
+
+func TestBase64URLFormatConstraintExample(t *testing.T) {
+	constraint := pvconstraints.NewBase64URLFormatConstraint()
+	example := constraint.Example(nil)
+	err := constraint.Validate(example.(string))
+	if err != nil {
+		t.Errorf("Example() %v does not satisfy its own constraint: %v", example, err)
+	}
+}
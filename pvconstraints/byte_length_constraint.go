@@ -0,0 +1,96 @@
+package pvconstraints
+
+import (
+	"fmt"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvtypes.RegisterConstraint(&ByteLengthConstraint{})
+}
+
+var _ pvtypes.Constraint = (*ByteLengthConstraint)(nil)
+
+// ByteLengthConstraint validates the UTF-8 encoded byte length of a string,
+// e.g. for database columns or buffers where "🙂" counts as 4, not 1.
+type ByteLengthConstraint struct {
+	pvtypes.BaseConstraint
+	min int
+	max int
+}
+
+func NewByteLengthConstraint(min int, max int) *ByteLengthConstraint {
+	c := &ByteLengthConstraint{min: min, max: max}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *ByteLengthConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{
+		pvtypes.StringType,
+		pvtypes.IdentifierType,
+		pvtypes.AlphanumericType,
+		pvtypes.SlugType,
+		pvtypes.EmailType,
+	}
+}
+
+func (c *ByteLengthConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseByteLengthConstraint(value)
+}
+
+func (c *ByteLengthConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.ByteLengthConstraintType
+}
+
+func (c *ByteLengthConstraint) Validate(value string) (err error) {
+	length := len(value)
+	if length < c.min || length > c.max {
+		err = fmt.Errorf("byte length must be between %d and %d", c.min, c.max)
+	}
+	return err
+}
+
+func (c *ByteLengthConstraint) Rule() string {
+	return fmt.Sprintf("%d..%d", c.min, c.max)
+}
+
+func (c *ByteLengthConstraint) ErrorDetail(param *pvtypes.Parameter, value string) string {
+	return fmt.Sprintf("Parameter '%s' failed constraint validation: value is %d bytes when UTF-8 encoded but must be between %d and %d bytes",
+		param.Name,
+		len(value),
+		c.min,
+		c.max,
+	)
+}
+
+func (c *ByteLengthConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is between %d and %d bytes when UTF-8 encoded (multi-byte characters count more than once), for example: %s",
+		param.Name,
+		c.min,
+		c.max,
+		example,
+	)
+}
+
+// ParseByteLengthConstraint parses min..max format
+func ParseByteLengthConstraint(lengthSpec string) (constraint *ByteLengthConstraint, err error) {
+	var minimum, maximum int
+
+	minimum, maximum, err = parseLengthBounds(lengthSpec, ErrExpectedByteLengthFormat)
+	if err != nil {
+		goto end
+	}
+
+	constraint = NewByteLengthConstraint(minimum, maximum)
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			ErrInvalidByteLengthConstraint,
+			"bytelength_spec", lengthSpec,
+		)
+	}
+	return constraint, err
+}
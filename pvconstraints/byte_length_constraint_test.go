@@ -0,0 +1,113 @@
+package pvconstraints_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.ByteLengthConstraint)(nil)
+
+func TestByteLengthConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"valid-range", "1..255", false},
+		{"exact-length", "4..4", false},
+		{"zero-min", "0..10", false},
+
+		{"missing-separator", "1-255", true},
+		{"single-value", "10", true},
+		{"empty-spec", "", true},
+		{"non-numeric-max", "1..xyz", true},
+		{"negative-min", "-1..10", true},
+		{"min-greater-than-max", "10..1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseByteLengthConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseByteLengthConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseByteLengthConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.ByteLengthConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.ByteLengthConstraintType)
+			}
+
+			if constraint.Rule() != tt.spec {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.spec)
+			}
+		})
+	}
+}
+
+func TestByteLengthConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		testValue string
+		wantValid bool
+	}{
+		{"ascii-within", "1..5", "hello", true},
+		{"ascii-too-long", "1..5", "hello!", false},
+		{"emoji-is-four-bytes", "4..4", "🙂", true},
+		{"emoji-exceeds-max", "1..3", "🙂", false},
+		{"accented-is-six-bytes", "1..5", "héllo", false},
+		{"max-bytes", "0..64", strings.Repeat("a", 64), true},
+		{"empty-string", "1..10", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseByteLengthConstraint(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseByteLengthConstraint() failed: %v", err)
+			}
+
+			err = constraint.Validate(tt.testValue)
+
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error (bytes=%d)", tt.testValue, len(tt.testValue))
+			}
+		})
+	}
+}
+
+func TestByteLengthVersusLength(t *testing.T) {
+	const value = "🙂🙂🙂" // 3 runes, 12 bytes
+
+	length, err := pvconstraints.ParseLengthConstraint("1..3")
+	if err != nil {
+		t.Fatalf("ParseLengthConstraint() failed: %v", err)
+	}
+	byteLength, err := pvconstraints.ParseByteLengthConstraint("1..3")
+	if err != nil {
+		t.Fatalf("ParseByteLengthConstraint() failed: %v", err)
+	}
+
+	if err := length.Validate(value); err != nil {
+		t.Errorf("length[1..3] Validate(%q) expected valid but got error: %v", value, err)
+	}
+	if err := byteLength.Validate(value); err == nil {
+		t.Errorf("bytelength[1..3] Validate(%q) expected invalid but got no error", value)
+	}
+}
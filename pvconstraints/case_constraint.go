@@ -0,0 +1,122 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// Letter cases supported by case[...] on strings
+const (
+	LowerCase = "lower"
+	UpperCase = "upper"
+)
+
+func init() {
+	pvtypes.RegisterConstraint(&CaseConstraint{})
+}
+
+var _ pvtypes.Constraint = (*CaseConstraint)(nil)
+
+// CaseConstraint validates that every letter in a string is lowercase, as for
+// case[lower], or uppercase, as for case[upper]. Values in the wrong case are
+// rejected rather than converted, and characters without case such as digits
+// and punctuation are always allowed.
+type CaseConstraint struct {
+	pvtypes.BaseConstraint
+	letterCase string
+}
+
+func NewCaseConstraint(letterCase string) *CaseConstraint {
+	c := &CaseConstraint{letterCase: letterCase}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *CaseConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *CaseConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseCaseConstraint(value)
+}
+
+func (c *CaseConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.CaseConstraintType
+}
+
+func (c *CaseConstraint) Rule() string {
+	return c.letterCase
+}
+
+// convert returns value in the constraint's required case.
+func (c *CaseConstraint) convert(value string) string {
+	if c.letterCase == UpperCase {
+		return strings.ToUpper(value)
+	}
+	return strings.ToLower(value)
+}
+
+func (c *CaseConstraint) Validate(value string) (err error) {
+	if value != c.convert(value) {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrValueNotInRequiredCase,
+			"value", value,
+			"case", c.letterCase,
+		)
+	}
+	return err
+}
+
+func (c *CaseConstraint) ErrorDetail(param *pvtypes.Parameter, value string) string {
+	if c.Validate(value) != nil {
+		return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: value must be all %scase",
+			param.Name,
+			value,
+			c.letterCase,
+		)
+	}
+	return c.BaseConstraint.ErrorDetail(param, value)
+}
+
+func (c *CaseConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is all %scase, for example: %s",
+		param.Name,
+		c.letterCase,
+		c.convert(value),
+	)
+}
+
+// Example returns a short word in the required case.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *CaseConstraint) Example(err error) any {
+	return c.convert("abc")
+}
+
+// ParseCaseConstraint parses a case specification, either "lower" or "upper".
+func ParseCaseConstraint(caseSpec string) (constraint *CaseConstraint, err error) {
+	letterCase := strings.ToLower(strings.TrimSpace(caseSpec))
+
+	switch letterCase {
+	case LowerCase, UpperCase:
+	default:
+		err = pvtypes.NewErr(
+			ErrExpectedCaseFormat,
+			"case", caseSpec,
+		)
+		goto end
+	}
+
+	constraint = NewCaseConstraint(letterCase)
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			ErrInvalidCaseConstraint,
+			"case_spec", caseSpec,
+		)
+	}
+	return constraint, err
+}
@@ -0,0 +1,104 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.CaseConstraint)(nil)
+
+func TestCaseConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantRule string
+		wantErr  bool
+	}{
+		{"lower", "lower", "lower", false},
+		{"upper", "upper", "upper", false},
+		{"uppercase-spec", "UPPER", "upper", false},
+		{"padded-spec", " lower ", "lower", false},
+		{"empty", "", "", true},
+		{"title", "title", "", true},
+		{"both", "lower,upper", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseCaseConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseCaseConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseCaseConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.CaseConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.CaseConstraintType)
+			}
+
+			if constraint.Rule() != tt.wantRule {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestCaseConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		testValue string
+		wantValid bool
+	}{
+		{"upper-accepts-upper", "upper", "ABC", true},
+		{"upper-accepts-digits", "upper", "AB-12", true},
+		{"upper-rejects-mixed", "upper", "Abc", false},
+		{"upper-rejects-lower", "upper", "abc", false},
+		{"lower-accepts-lower", "lower", "abc", true},
+		{"lower-accepts-unicode", "lower", "café", true},
+		{"lower-rejects-mixed", "lower", "Abc", false},
+		{"lower-rejects-unicode-upper", "lower", "CAFÉ", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseCaseConstraint(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseCaseConstraint() unexpected error: %v", err)
+			}
+
+			err = constraint.Validate(tt.testValue)
+
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error", tt.testValue)
+			}
+		})
+	}
+}
+
+func TestCaseConstraintExample(t *testing.T) {
+	for _, letterCase := range []string{pvconstraints.LowerCase, pvconstraints.UpperCase} {
+		constraint := pvconstraints.NewCaseConstraint(letterCase)
+		example, ok := constraint.Example(nil).(string)
+		if !ok {
+			t.Fatalf("Example() returned %T, want string", constraint.Example(nil))
+		}
+		if err := constraint.Validate(example); err != nil {
+			t.Errorf("case[%s] Example() %q does not validate: %v", letterCase, example, err)
+		}
+	}
+}
@@ -10,12 +10,18 @@ import (
 
 // Built-in date/time format aliases
 const (
-	DateOnlyFormat      = "dateonly"
-	UTCDateTimeFormat   = "utc"
-	LocalDateTimeFormat = "local"
-	DateTimeFormat      = "datetime"
+	DateOnlyFormat       = "dateonly"
+	UTCDateTimeFormat    = "utc"
+	LocalDateTimeFormat  = "local"
+	DateTimeFormat       = "datetime"
+	OffsetDateTimeFormat = "offset"
 )
 
+// OffsetUTCOption makes format[offset] store values converted to UTC, as in
+// format[offset:utc], so "2023-12-25T10:30:00+02:00" is stored as
+// "2023-12-25T08:30:00Z".
+const OffsetUTCOption = "utc"
+
 func init() {
 	pvtypes.RegisterConstraint(&DateFormatConstraint{})
 }
@@ -27,6 +33,8 @@ type DateFormatConstraint struct {
 	pvtypes.BaseConstraint
 	format string
 	parser func(string) (time.Time, error)
+	// normalizeUTC is set by format[offset:utc]; see Canonical.
+	normalizeUTC bool
 }
 
 func NewDateFormatConstraint(format string, parser func(string) (time.Time, error)) *DateFormatConstraint {
@@ -56,11 +64,28 @@ func (c *DateFormatConstraint) Parse(value string, dataType pvtypes.PVDataType)
 	case pvtypes.UUIDType:
 		ct, err = ParseUUIDFormatConstraint(value)
 	case pvtypes.StringType:
-		// Check if this is a UUID-like format for strings
-		switch strings.ToLower(value) {
+		// Check if this is a UUID-like or URL format for strings
+		format, _, _ := strings.Cut(value, ":")
+		switch strings.ToLower(format) {
 		// TODO Make constants for these
-		case "ulid", "ksuid", "nanoid":
+		case "ulid", "ksuid", "nanoid", IDFormat:
 			ct, err = ParseUUIDFormatConstraint(value)
+		case URLFormat, HTTPSURLFormat:
+			ct, err = ParseURLFormatConstraint(value)
+		case JWTFormat:
+			ct, err = ParseJWTFormatConstraint(value)
+		case MIMEFormat:
+			ct, err = ParseMIMEFormatConstraint(value)
+		case JSONFormat:
+			ct, err = ParseJSONFormatConstraint(value)
+		case Base64URLFormat:
+			ct, err = ParseBase64URLFormatConstraint(value)
+		case Base32Format, Base32CrockfordFormat:
+			ct, err = ParseBase32FormatConstraint(value)
+		case DurationFormat:
+			ct, err = ParseDurationFormatConstraint(value)
+		case E164Format:
+			ct, err = ParseE164FormatConstraint(value)
 		default:
 			err = pvtypes.NewErr(
 				ErrStringFormatOnlySupportsIDFormats,
@@ -90,6 +115,28 @@ func (c *DateFormatConstraint) Validate(value string) (err error) {
 	return err
 }
 
+// ParseTime parses value using the constraint's format, accepting the same
+// partial dates for multi-segment parameters as Validate(), e.g. "2025/10"
+// for format[yyyy/mm/dd], which yields the first instant of the period.
+func (c *DateFormatConstraint) ParseTime(value string) (t time.Time, err error) {
+	var partialLayout string
+
+	t, err = c.parser(value)
+	if err == nil {
+		goto end
+	}
+
+	partialLayout, err = c.buildPartialLayout(value)
+	if err != nil {
+		goto end
+	}
+
+	t, err = time.Parse(partialLayout, value)
+
+end:
+	return t, err
+}
+
 // validatePartialDate validates partial date formats for multi-segment parameters
 func (c *DateFormatConstraint) validatePartialDate(value string) (err error) {
 	var partialLayout string
@@ -156,13 +203,35 @@ func (c *DateFormatConstraint) Rule() string {
 	return c.format
 }
 
+// Canonical returns value converted to UTC in RFC 3339 form for
+// format[offset:utc], so stored values share one offset. For all other
+// formats ok is false and the value is stored as sent.
+func (c *DateFormatConstraint) Canonical(value string) (canonical string, ok bool) {
+	var t time.Time
+	var err error
+
+	if !c.normalizeUTC {
+		goto end
+	}
+	t, err = c.parser(value)
+	if err != nil {
+		goto end
+	}
+	canonical = t.UTC().Format(time.RFC3339Nano)
+	ok = true
+end:
+	return canonical, ok
+}
+
 // ParseDateFormatConstraint parses date format specifications.
 //
-// Date format constraints support four built-in aliases:
+// Date format constraints support five built-in aliases:
 //   - format[dateonly]: Date only (yyyy-mm-dd)
 //   - format[utc]: Strict UTC timestamps (yyyy-mm-ddThh:mm:ssZ, Z required)
 //   - format[local]: Timezone-naive timestamps (yyyy-mm-ddThh:mm:ss, Z forbidden)
 //   - format[datetime]: Flexible timestamps (yyyy-mm-ddThh:mm:ss with optional Z, defaults to UTC)
+//   - format[offset]: RFC 3339 timestamps with a required Z or numeric offset
+//     such as +02:00; format[offset:utc] also stores them converted to UTC
 //
 // Custom formats use token-based parsing with tokens like: yyyy, mm, dd, hh, ii, ss
 func ParseDateFormatConstraint(spec string) (constraint *DateFormatConstraint, err error) {
@@ -215,6 +284,15 @@ func ParseDateFormatConstraint(spec string) (constraint *DateFormatConstraint, e
 		}
 		constraint = NewDateFormatConstraint(spec, parser)
 		goto end
+
+	case OffsetDateTimeFormat, OffsetDateTimeFormat + ":" + OffsetUTCOption:
+		// Zoned: yyyy-mm-ddThh:mm:ss followed by Z or ±hh:mm (naive forbidden)
+		parser = func(s string) (time.Time, error) {
+			return time.Parse(time.RFC3339, s)
+		}
+		constraint = NewDateFormatConstraint(spec, parser)
+		constraint.normalizeUTC = strings.Contains(spec, ":")
+		goto end
 	}
 
 	// ParseBytes the format specification to build Go time layout
@@ -22,6 +22,8 @@ func TestDateFormatConstraintParsing(t *testing.T) {
 		{"utc-alias", "utc", false},
 		{"local-alias", "local", false},
 		{"datetime-alias", "datetime", false},
+		{"offset-alias", "offset", false},
+		{"offset-utc-alias", "offset:utc", false},
 
 		// Custom formats - Date only
 		{"yyyy-mm-dd", "yyyy-mm-dd", false},
@@ -101,6 +103,17 @@ func TestDateFormatConstraintValidation_BuiltinAliases(t *testing.T) {
 		{"datetime-valid-with-z", "datetime", "2023-12-25T10:30:00Z", true},
 		{"datetime-valid-without-z", "datetime", "2023-12-25T10:30:00", true},
 		{"datetime-invalid-date-only", "datetime", "2023-12-25", false},
+
+		// offset format (Z or numeric offset required)
+		{"offset-valid-plus", "offset", "2023-12-25T10:30:00+02:00", true},
+		{"offset-valid-minus", "offset", "2023-12-25T10:30:00-05:00", true},
+		{"offset-valid-z", "offset", "2023-12-25T10:30:00Z", true},
+		{"offset-valid-fraction", "offset", "2023-12-25T10:30:00.123+05:30", true},
+		{"offset-invalid-naive", "offset", "2023-12-25T10:30:00", false},
+		{"offset-invalid-compact-offset", "offset", "2023-12-25T10:30:00+0200", false},
+		{"offset-invalid-date-only", "offset", "2023-12-25", false},
+		{"offset-utc-valid-plus", "offset:utc", "2023-12-25T10:30:00+02:00", true},
+		{"offset-utc-invalid-naive", "offset:utc", "2023-12-25T10:30:00", false},
 	}
 
 	for _, tt := range tests {
@@ -292,3 +305,34 @@ func TestDateFormatConstraintErrorMessages(t *testing.T) {
 		t.Error("ErrorSuggestion() returned empty string")
 	}
 }
+
+func TestDateFormatConstraintCanonical_Offset(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   string
+		value  string
+		want   string
+		wantOK bool
+	}{
+		{"utc-plus-offset", "offset:utc", "2023-12-25T10:30:00+02:00", "2023-12-25T08:30:00Z", true},
+		{"utc-minus-offset", "offset:utc", "2023-12-25T22:30:00-05:00", "2023-12-26T03:30:00Z", true},
+		{"utc-z", "offset:utc", "2023-12-25T10:30:00Z", "2023-12-25T10:30:00Z", true},
+		{"utc-fraction", "offset:utc", "2023-12-25T10:30:00.5+01:00", "2023-12-25T09:30:00.5Z", true},
+		{"utc-invalid", "offset:utc", "2023-12-25T10:30:00", "", false},
+		{"offset-kept-as-sent", "offset", "2023-12-25T10:30:00+02:00", "", false},
+		{"datetime-kept-as-sent", "datetime", "2023-12-25T10:30:00Z", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseDateFormatConstraint(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseDateFormatConstraint() failed: %v", err)
+			}
+			got, ok := constraint.Canonical(tt.value)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Canonical(%q) = %q, %t; want %q, %t", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
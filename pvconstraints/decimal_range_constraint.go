@@ -2,6 +2,8 @@ package pvconstraints
 
 import (
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"strconv"
 	"strings"
 
@@ -13,6 +15,7 @@ func init() {
 }
 
 var _ pvtypes.Constraint = (*DecimalRangeConstraint)(nil)
+var _ pvtypes.RandomExampler = (*DecimalRangeConstraint)(nil)
 
 // DecimalRangeConstraint validates decimal ranges
 type DecimalRangeConstraint struct {
@@ -31,7 +34,7 @@ func NewDecimalRangeConstraint(min float64, max float64) *DecimalRangeConstraint
 }
 
 func (c *DecimalRangeConstraint) ValidDataTypes() []pvtypes.PVDataType {
-	return []pvtypes.PVDataType{pvtypes.DecimalType, pvtypes.RealType}
+	return []pvtypes.PVDataType{pvtypes.DecimalType, pvtypes.RealType, pvtypes.LatitudeType, pvtypes.LongitudeType}
 }
 
 func (c *DecimalRangeConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
@@ -62,6 +65,17 @@ func (c *DecimalRangeConstraint) Rule() string {
 	return fmt.Sprintf("%g..%g", c.min, c.max)
 }
 
+// RandomExample returns a value within the range, rounded to two decimal places
+// when rounding keeps it in range.
+func (c *DecimalRangeConstraint) RandomExample(r *rand.Rand) any {
+	n := c.min + r.Float64()*(c.max-c.min)
+	rounded := math.Round(n*100) / 100
+	if rounded >= c.min && rounded <= c.max {
+		n = rounded
+	}
+	return n
+}
+
 // ParseDecimalRangeConstraint parses min..max format for decimals
 func ParseDecimalRangeConstraint(rangeSpec string) (constraint *DecimalRangeConstraint, err error) {
 	var parts []string
@@ -0,0 +1,137 @@
+package pvconstraints
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvtypes.RegisterConstraint(&DecodedLengthConstraint{})
+}
+
+var _ pvtypes.Constraint = (*DecodedLengthConstraint)(nil)
+
+// DecodedLengthConstraint validates the byte length of a base64url value after
+// decoding rather than the length of the encoded string, e.g. decodedlen[32..32]
+// for a 256-bit key. It is typically combined with format[base64url]; a value
+// that does not decode fails on its own.
+type DecodedLengthConstraint struct {
+	pvtypes.BaseConstraint
+	min int
+	max int
+}
+
+func NewDecodedLengthConstraint(min int, max int) *DecodedLengthConstraint {
+	c := &DecodedLengthConstraint{min: min, max: max}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *DecodedLengthConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *DecodedLengthConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseDecodedLengthConstraint(value)
+}
+
+func (c *DecodedLengthConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.DecodedLengthConstraintType
+}
+
+func (c *DecodedLengthConstraint) Validate(value string) (err error) {
+	var decoded []byte
+
+	decoded, err = decodeBase64URL(value)
+	if err != nil {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidBase64URLFormat,
+			"value", value,
+			err,
+		)
+		goto end
+	}
+
+	if len(decoded) < c.min || len(decoded) > c.max {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrDecodedLengthOutOfRange,
+			"decoded_length", len(decoded),
+			"minimum", c.min,
+			"maximum", c.max,
+		)
+	}
+
+end:
+	return err
+}
+
+func (c *DecodedLengthConstraint) Rule() string {
+	return fmt.Sprintf("%d..%d", c.min, c.max)
+}
+
+// bounds describes the allowed decoded length for messages.
+func (c *DecodedLengthConstraint) bounds() string {
+	if c.min == c.max {
+		return fmt.Sprintf("exactly %d bytes", c.min)
+	}
+	return fmt.Sprintf("between %d and %d bytes", c.min, c.max)
+}
+
+func (c *DecodedLengthConstraint) ErrorDetail(param *pvtypes.Parameter, value string) string {
+	decoded, err := decodeBase64URL(value)
+	if err != nil {
+		return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: value is not valid base64url",
+			param.Name,
+			value,
+		)
+	}
+	return fmt.Sprintf("Parameter '%s' failed constraint validation: value decodes to %d bytes but must decode to %s",
+		param.Name,
+		len(decoded),
+		c.bounds(),
+	)
+}
+
+func (c *DecodedLengthConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is base64url that decodes to %s, for example: %s",
+		param.Name,
+		c.bounds(),
+		example,
+	)
+}
+
+// Example returns the unpadded base64url encoding of min bytes, or of one
+// byte for a zero minimum.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *DecodedLengthConstraint) Example(err error) any {
+	data := make([]byte, max(c.min, 1))
+	for i := range data {
+		data[i] = byte(i)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseDecodedLengthConstraint parses min..max format
+func ParseDecodedLengthConstraint(lengthSpec string) (constraint *DecodedLengthConstraint, err error) {
+	var minimum, maximum int
+
+	minimum, maximum, err = parseLengthBounds(lengthSpec, ErrExpectedDecodedLengthFormat)
+	if err != nil {
+		goto end
+	}
+
+	constraint = NewDecodedLengthConstraint(minimum, maximum)
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			ErrInvalidDecodedLengthConstraint,
+			"decodedlen_spec", lengthSpec,
+		)
+	}
+	return constraint, err
+}
@@ -0,0 +1,96 @@
+package pvconstraints_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.DecodedLengthConstraint)(nil)
+
+func TestDecodedLengthConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantRule string
+		wantErr  bool
+	}{
+		{"exact", "32..32", "32..32", false},
+		{"range", "16..64", "16..64", false},
+		{"single-value", "32", "", true},
+		{"min-greater-than-max", "64..32", "", true},
+		{"negative-min", "-1..32", "", true},
+		{"non-numeric", "a..b", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseDecodedLengthConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseDecodedLengthConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseDecodedLengthConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.DecodedLengthConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.DecodedLengthConstraintType)
+			}
+
+			if constraint.Rule() != tt.wantRule {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestDecodedLengthConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr error
+	}{
+		// 43 unpadded characters decode to 32 bytes
+		{"32-bytes-unpadded", "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8", nil},
+		{"32-bytes-padded", "-__-AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxw=", nil},
+		{"16-bytes", "AAECAwQFBgcICQoLDA0ODw", pvconstraints.ErrDecodedLengthOutOfRange},
+		{"33-bytes", "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8g", pvconstraints.ErrDecodedLengthOutOfRange},
+		{"not-base64url", "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh+", pvconstraints.ErrInvalidBase64URLFormat},
+	}
+
+	constraint := pvconstraints.NewDecodedLengthConstraint(32, 32)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := constraint.Validate(tt.value)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Validate(%q) unexpected error: %v", tt.value, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate(%q) error = %v, want %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodedLengthConstraintExample(t *testing.T) {
+	for _, bounds := range [][2]int{{32, 32}, {0, 8}, {16, 64}} {
+		constraint := pvconstraints.NewDecodedLengthConstraint(bounds[0], bounds[1])
+		example := constraint.Example(nil)
+		err := constraint.Validate(example.(string))
+		if err != nil {
+			t.Errorf("decodedlen[%s] Example() %v does not satisfy its own constraint: %v", constraint.Rule(), example, err)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// DurationFormat is the format name supported by format[duration] on strings
+const DurationFormat = "duration"
+
+// Note: DurationFormatConstraint is not registered directly.
+// It's handled by DateFormatConstraint.Parse() for the string data type.
+
+var _ pvtypes.Constraint = (*DurationFormatConstraint)(nil)
+
+// DurationFormatConstraint validates that a string is a Go duration accepted
+// by time.ParseDuration(), such as 30s, 500ms or 1h30m, optionally bounded.
+//
+// Supported specs:
+//   - format[duration] accepts any duration
+//   - format[duration:min=1s] rejects durations shorter than 1s
+//   - format[duration:max=1h] rejects durations longer than 1h
+//   - format[duration:min=1s;max=1h] applies both bounds
+//
+// A number without a unit is rejected, except "0" which time.ParseDuration()
+// accepts. The value is stored as sent, not normalized.
+type DurationFormatConstraint struct {
+	pvtypes.BaseConstraint
+	options string
+	min     time.Duration
+	max     time.Duration
+	hasMin  bool
+	hasMax  bool
+}
+
+func NewDurationFormatConstraint() *DurationFormatConstraint {
+	c := &DurationFormatConstraint{}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *DurationFormatConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *DurationFormatConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.FormatConstraintType
+}
+
+func (c *DurationFormatConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseDurationFormatConstraint(value)
+}
+
+func (c *DurationFormatConstraint) Rule() string {
+	if c.options == "" {
+		return DurationFormat
+	}
+	return DurationFormat + ":" + c.options
+}
+
+func (c *DurationFormatConstraint) Validate(value string) (err error) {
+	var d time.Duration
+
+	d, err = time.ParseDuration(value)
+	if err != nil {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidDurationFormat,
+			"value", value,
+			err,
+		)
+		goto end
+	}
+	if c.hasMin && d < c.min {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrDurationOutOfRange,
+			"value", value,
+			"min", c.min.String(),
+		)
+		goto end
+	}
+	if c.hasMax && d > c.max {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrDurationOutOfRange,
+			"value", value,
+			"max", c.max.String(),
+		)
+	}
+end:
+	return err
+}
+
+func (c *DurationFormatConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	bounds := ""
+	switch {
+	case c.hasMin && c.hasMax:
+		bounds = fmt.Sprintf(" between %s and %s", c.min, c.max)
+	case c.hasMin:
+		bounds = fmt.Sprintf(" of at least %s", c.min)
+	case c.hasMax:
+		bounds = fmt.Sprintf(" of at most %s", c.max)
+	}
+	return fmt.Sprintf("Ensure parameter '%s' is a duration%s with a unit such as ms, s, m or h, for example: %s",
+		param.Name,
+		bounds,
+		example,
+	)
+}
+
+// Example returns 30s, or the minimum when 30s is out of bounds.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *DurationFormatConstraint) Example(err error) any {
+	example := 30 * time.Second
+	if (c.hasMin && example < c.min) || (c.hasMax && example > c.max) {
+		example = c.min
+		if !c.hasMin {
+			example = c.max
+		}
+	}
+	return example.String()
+}
+
+// ParseDurationFormatConstraint parses the duration format specification with
+// its optional ';'-separated min= and max= bounds.
+func ParseDurationFormatConstraint(spec string) (constraint *DurationFormatConstraint, err error) {
+	var format, options string
+
+	format, options, _ = strings.Cut(spec, ":")
+	if !strings.EqualFold(strings.TrimSpace(format), DurationFormat) {
+		err = pvtypes.NewErr(ErrUnsupportedDurationFormat, "format", format)
+		goto end
+	}
+
+	constraint = NewDurationFormatConstraint()
+	constraint.options = strings.TrimSpace(options)
+	for _, option := range strings.Split(constraint.options, ";") {
+		var key, value string
+		var found bool
+		var d time.Duration
+
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+		key, value, found = strings.Cut(option, "=")
+		if !found {
+			err = pvtypes.NewErr(ErrInvalidDurationFormatOption, "option", option)
+			goto end
+		}
+		d, err = time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			err = pvtypes.NewErr(ErrInvalidDurationFormatOption, "option", option, err)
+			goto end
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "min":
+			constraint.min, constraint.hasMin = d, true
+		case "max":
+			constraint.max, constraint.hasMax = d, true
+		default:
+			err = pvtypes.NewErr(ErrInvalidDurationFormatOption, "option", option, "key", key)
+			goto end
+		}
+	}
+	if constraint.hasMin && constraint.hasMax && constraint.min > constraint.max {
+		err = pvtypes.NewErr(
+			ErrInvalidDurationFormatOption,
+			"min", constraint.min.String(),
+			"max", constraint.max.String(),
+		)
+	}
+
+end:
+	if err != nil {
+		constraint = nil
+		err = pvtypes.WithErr(err,
+			ErrInvalidDurationFormatConstraint,
+			"duration_format_spec", spec,
+		)
+	}
+	return constraint, err
+}
@@ -0,0 +1,130 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.DurationFormatConstraint)(nil)
+
+func TestDurationFormatConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantRule string
+		wantErr  bool
+	}{
+		{"duration", "duration", "duration", false},
+		{"uppercase", "DURATION", "duration", false},
+		{"min", "duration:min=1s", "duration:min=1s", false},
+		{"max", "duration:max=1h", "duration:max=1h", false},
+		{"min-and-max", "duration:min=1s;max=1h", "duration:min=1s;max=1h", false},
+		{"min-above-max", "duration:min=1h;max=1s", "", true},
+		{"bad-bound", "duration:min=soon", "", true},
+		{"unitless-bound", "duration:max=30", "", true},
+		{"missing-value", "duration:min", "", true},
+		{"unknown-option", "duration:step=1s", "", true},
+		{"unknown-format", "durations", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseDurationFormatConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseDurationFormatConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseDurationFormatConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.FormatConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.FormatConstraintType)
+			}
+			if constraint.Rule() != tt.wantRule {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestDurationFormatConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		value   string
+		wantErr bool
+	}{
+		{"hours-and-minutes", "duration", "1h30m", false},
+		{"milliseconds", "duration", "500ms", false},
+		{"seconds", "duration", "30s", false},
+		{"fractional", "duration", "1.5h", false},
+		{"negative", "duration", "-5m", false},
+		{"zero", "duration", "0", false},
+
+		{"no-unit", "duration", "30", true},
+		{"unknown-unit", "duration", "1x", true},
+		{"days", "duration", "1d", true},
+		{"spaces", "duration", "1h 30m", true},
+		{"empty", "duration", "", true},
+
+		{"min-boundary", "duration:min=1s;max=1h", "1s", false},
+		{"max-boundary", "duration:min=1s;max=1h", "60m", false},
+		{"below-min", "duration:min=1s;max=1h", "999ms", true},
+		{"above-max", "duration:min=1s;max=1h", "1h1s", true},
+		{"max-only", "duration:max=1h", "-1h", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseDurationFormatConstraint(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseDurationFormatConstraint() failed: %v", err)
+			}
+			err = constraint.Validate(tt.value)
+			if tt.wantErr && err == nil {
+				t.Errorf("Validate(%q) expected error but got none", tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate(%q) unexpected error: %v", tt.value, err)
+			}
+		})
+	}
+}
+
+func TestDurationFormatConstraintExample(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"duration", "30s"},
+		{"duration:min=1m", "1m0s"},
+		{"duration:max=10s", "10s"},
+		{"duration:min=1s;max=1h", "30s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseDurationFormatConstraint(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseDurationFormatConstraint() failed: %v", err)
+			}
+			example := constraint.Example(nil)
+			if example != tt.want {
+				t.Errorf("Example() = %v, want %q", example, tt.want)
+			}
+			err = constraint.Validate(example.(string))
+			if err != nil {
+				t.Errorf("Example() %v does not satisfy its own constraint: %v", example, err)
+			}
+		})
+	}
+}
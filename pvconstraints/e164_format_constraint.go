@@ -0,0 +1,97 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// E164Format is the format name supported by format[e164] on strings
+const E164Format = "e164"
+
+// MaxE164Digits is the most digits, country code included, an E.164 number may have.
+const MaxE164Digits = 15
+
+// Note: E164FormatConstraint is not registered directly.
+// It's handled by DateFormatConstraint.Parse() for the string data type.
+
+var _ pvtypes.Constraint = (*E164FormatConstraint)(nil)
+
+// E164FormatConstraint validates that a string is an E.164 phone number: a
+// '+' followed by 1 to 15 digits with no spaces or separators, such as
+// +14155552671. Values are validated after the router has decoded them, and
+// since query decoding turns '+' into a space, clients must send a leading
+// '+' in a query as %2B; in a path it may be sent as is.
+type E164FormatConstraint struct {
+	pvtypes.BaseConstraint
+}
+
+func NewE164FormatConstraint() *E164FormatConstraint {
+	c := &E164FormatConstraint{}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *E164FormatConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *E164FormatConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.FormatConstraintType
+}
+
+func (c *E164FormatConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseE164FormatConstraint(value)
+}
+
+func (c *E164FormatConstraint) Rule() string {
+	return E164Format
+}
+
+func (c *E164FormatConstraint) Validate(value string) (err error) {
+	digits, found := strings.CutPrefix(value, "+")
+	if !found || digits == "" || len(digits) > MaxE164Digits || !isDigits(digits) {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidE164Format,
+			"value", value,
+			"max_digits", MaxE164Digits,
+		)
+	}
+	return err
+}
+
+func (c *E164FormatConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	hint := ""
+	if strings.HasPrefix(value, " ") && isDigits(value[1:]) {
+		// A '+' sent unencoded in a query decodes as a space
+		hint = " (encode a leading '+' in a query as %2B)"
+	}
+	return fmt.Sprintf("Ensure parameter '%s' is an E.164 phone number: '+' followed by up to %d digits with no spaces or dashes%s, for example: %s",
+		param.Name,
+		MaxE164Digits,
+		hint,
+		example,
+	)
+}
+
+// Example returns a US number in E.164 form.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *E164FormatConstraint) Example(err error) any {
+	return "+14155552671"
+}
+
+// ParseE164FormatConstraint parses the e164 format specification, which takes no options.
+func ParseE164FormatConstraint(spec string) (constraint *E164FormatConstraint, err error) {
+	if !strings.EqualFold(strings.TrimSpace(spec), E164Format) {
+		err = pvtypes.NewErr(
+			ErrInvalidE164FormatConstraint,
+			"e164_format_spec", spec,
+		)
+		goto end
+	}
+	constraint = NewE164FormatConstraint()
+end:
+	return constraint, err
+}
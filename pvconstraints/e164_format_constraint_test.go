@@ -0,0 +1,93 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.E164FormatConstraint)(nil)
+
+func TestE164FormatConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"e164", "e164", false},
+		{"uppercase", "E164", false},
+		{"with-options", "e164:region=us", true},
+		{"unknown-format", "e.164", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseE164FormatConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseE164FormatConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseE164FormatConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.FormatConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.FormatConstraintType)
+			}
+		})
+	}
+}
+
+func TestE164FormatConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"us-number", "+14155552671", false},
+		{"uk-number", "+442071838750", false},
+		{"max-digits", "+123456789012345", false},
+		{"one-digit", "+1", false},
+
+		{"no-plus", "14155552671", true},
+		{"spaces", "+1 415 555 2671", true},
+		{"dashes", "+1-415-555-2671", true},
+		{"national-format", "(415) 555-2671", true},
+		{"decoded-query-plus", " 14155552671", true},
+		{"too-long", "+1234567890123456", true},
+		{"plus-only", "+", true},
+		{"empty", "", true},
+	}
+
+	constraint := pvconstraints.NewE164FormatConstraint()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := constraint.Validate(tt.value)
+			if tt.wantErr && err == nil {
+				t.Errorf("Validate(%q) expected error but got none", tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate(%q) unexpected error: %v", tt.value, err)
+			}
+		})
+	}
+}
+
+func TestE164FormatConstraintExample(t *testing.T) {
+	constraint := pvconstraints.NewE164FormatConstraint()
+	example := constraint.Example(nil)
+	if example != "+14155552671" {
+		t.Errorf("Example() = %v, want %q", example, "+14155552671")
+	}
+	err := constraint.Validate(example.(string))
+	if err != nil {
+		t.Errorf("Example() %v does not satisfy its own constraint: %v", example, err)
+	}
+}
@@ -2,6 +2,8 @@ package pvconstraints
 
 import (
 	"fmt"
+	"math/rand/v2"
+	"strconv"
 	"strings"
 
 	"github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -12,12 +14,17 @@ func init() {
 }
 
 var _ pvtypes.Constraint = (*EnumConstraint)(nil)
+var _ pvtypes.RandomExampler = (*EnumConstraint)(nil)
 
-// EnumConstraint validates against allowed values
+// EnumConstraint validates against allowed values. For integer parameters
+// members are compared numerically, so enum[1,2,3] accepts "02" as 2. Matched
+// values are stored using the member's spelling from the spec; see Canonical.
 type EnumConstraint struct {
 	pvtypes.BaseConstraint
-	values map[string]bool
-	list   []string
+	values   map[string]bool
+	list     []string
+	members  map[string]string
+	dataType pvtypes.PVDataType
 }
 
 func NewEnumConstraint(values map[string]bool, list []string) *EnumConstraint {
@@ -27,7 +34,7 @@ func NewEnumConstraint(values map[string]bool, list []string) *EnumConstraint {
 }
 
 func (c *EnumConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
-	return ParseEnumConstraint(value)
+	return ParseTypedEnumConstraint(value, dataType)
 }
 
 func (c *EnumConstraint) Type() pvtypes.ConstraintType {
@@ -35,12 +42,25 @@ func (c *EnumConstraint) Type() pvtypes.ConstraintType {
 }
 
 func (c *EnumConstraint) Validate(value string) (err error) {
-	if !c.values[value] {
+	key, ok := canonicalEnumKey(value, c.dataType)
+	if !ok || !c.values[key] {
 		err = fmt.Errorf("value must be one of: %s", strings.Join(c.list, ", "))
 	}
 	return err
 }
 
+// Canonical returns the member of the enum that value matched, spelled as in
+// the spec, e.g. "2" for "02" on an integer enum[1,2,3].
+func (c *EnumConstraint) Canonical(value string) (member string, ok bool) {
+	key, ok := canonicalEnumKey(value, c.dataType)
+	if !ok {
+		goto end
+	}
+	member, ok = c.members[key]
+end:
+	return member, ok
+}
+
 func (c *EnumConstraint) Rule() string {
 	return strings.Join(c.list, ",")
 }
@@ -74,10 +94,25 @@ func (c *EnumConstraint) Example(err error) (ex any) {
 	return ex
 }
 
-// ParseEnumConstraint parses val1,val2,val3 format
+// RandomExample returns a random member of the enum.
+func (c *EnumConstraint) RandomExample(r *rand.Rand) (ex any) {
+	if len(c.list) > 0 {
+		ex = c.list[r.IntN(len(c.list))]
+	}
+	return ex
+}
+
+// ParseEnumConstraint parses val1,val2,val3 format, comparing members as strings
 func ParseEnumConstraint(enumSpec string) (constraint *EnumConstraint, err error) {
+	return ParseTypedEnumConstraint(enumSpec, pvtypes.StringType)
+}
+
+// ParseTypedEnumConstraint parses val1,val2,val3 format with each member parsed
+// as dataType, so integer members are matched numerically rather than by spelling.
+func ParseTypedEnumConstraint(enumSpec string, dataType pvtypes.PVDataType) (constraint *EnumConstraint, err error) {
 	var values []string
 	var valueMap map[string]bool
+	var members map[string]string
 	var value string
 	var errs []error
 
@@ -96,15 +131,30 @@ func ParseEnumConstraint(enumSpec string) (constraint *EnumConstraint, err error
 	// Split by comma
 	values = strings.Split(enumSpec, ",")
 	valueMap = make(map[string]bool)
+	members = make(map[string]string)
 
-	for _, value = range values {
-		value = strings.TrimSpace(value)
+	for i := range values {
+		value = strings.TrimSpace(values[i])
+		values[i] = value
 		if value == "" {
 			errs = append(errs, enumError())
 			continue
 		}
 		// TODO Ensure value is a valid identifier
-		valueMap[value] = true
+		key, ok := canonicalEnumKey(value, dataType)
+		if !ok {
+			errs = append(errs, pvtypes.NewErr(
+				ErrEnumValueNotOfDataType,
+				"enum_value", value,
+				"data_type", dataType.Slug(),
+			))
+			continue
+		}
+		valueMap[key] = true
+		if _, ok := members[key]; !ok {
+			// The first spelling of a duplicated member wins
+			members[key] = value
+		}
 	}
 	err = pvtypes.CombineErrs(errs)
 	if err != nil {
@@ -112,6 +162,8 @@ func ParseEnumConstraint(enumSpec string) (constraint *EnumConstraint, err error
 	}
 
 	constraint = NewEnumConstraint(valueMap, values)
+	constraint.members = members
+	constraint.dataType = dataType
 
 end:
 	if err != nil {
@@ -122,3 +174,16 @@ end:
 	}
 	return constraint, err
 }
+
+// canonicalEnumKey returns the lookup key for an enum member or candidate
+// value: the canonical decimal form for integers, the value itself otherwise.
+func canonicalEnumKey(value string, dataType pvtypes.PVDataType) (key string, ok bool) {
+	if dataType != pvtypes.IntegerType {
+		return value, true
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return "", false
+	}
+	return strconv.FormatInt(n, 10), true
+}
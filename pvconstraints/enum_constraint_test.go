@@ -254,3 +254,80 @@ func enumContains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestTypedEnumConstraint(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		dataType  pvtypes.PVDataType
+		testValue string
+		wantValid bool
+	}{
+		{"int-exact", "1,2,3,5,8", pvtypes.IntegerType, "5", true},
+		{"int-leading-zero", "1,2,3,5,8", pvtypes.IntegerType, "05", true},
+		{"int-plus-sign", "1,2,3", pvtypes.IntegerType, "+2", true},
+		{"int-member-leading-zero", "01,02", pvtypes.IntegerType, "2", true},
+		{"int-not-member", "1,2,3", pvtypes.IntegerType, "4", false},
+		{"int-non-numeric", "1,2,3", pvtypes.IntegerType, "two", false},
+		{"string-leading-zero", "1,2,3", pvtypes.StringType, "02", false},
+		{"string-exact", "1,2,3", pvtypes.StringType, "2", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseTypedEnumConstraint(tt.spec, tt.dataType)
+			if err != nil {
+				t.Fatalf("ParseTypedEnumConstraint() failed: %v", err)
+			}
+
+			err = constraint.Validate(tt.testValue)
+
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error", tt.testValue)
+			}
+		})
+	}
+
+	t.Run("int-rejects-non-numeric-member", func(t *testing.T) {
+		_, err := pvconstraints.ParseTypedEnumConstraint("1,two,3", pvtypes.IntegerType)
+		if err == nil {
+			t.Error("ParseTypedEnumConstraint() expected error for non-integer member but got none")
+		}
+	})
+}
+
+func TestEnumConstraintCanonical(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		dataType  pvtypes.PVDataType
+		testValue string
+		want      string
+		wantOK    bool
+	}{
+		{"mixed-number", "1, active, 0, inactive", pvtypes.StringType, "1", "1", true},
+		{"mixed-word", "1, active, 0, inactive", pvtypes.StringType, "inactive", "inactive", true},
+		{"mixed-not-member", "1, active, 0, inactive", pvtypes.StringType, "01", "", false},
+		{"int-leading-zero", "1,2,3", pvtypes.IntegerType, "02", "2", true},
+		{"int-plus-sign", "1,2,3", pvtypes.IntegerType, "+3", "3", true},
+		{"int-member-spelling", "01,02", pvtypes.IntegerType, "2", "02", true},
+		{"int-not-member", "1,2,3", pvtypes.IntegerType, "4", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseTypedEnumConstraint(tt.spec, tt.dataType)
+			if err != nil {
+				t.Fatalf("ParseTypedEnumConstraint() failed: %v", err)
+			}
+			got, ok := constraint.Canonical(tt.testValue)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Canonical(%q) = %q, %v; want %q, %v", tt.testValue, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -25,6 +25,62 @@ var (
 	// ErrExpectedLengthFormat indicates the expected format for length constraints.
 	ErrExpectedLengthFormat = errors.New("expected format 'length['min..max]")
 
+	// ByteLength Constraint Errors
+
+	// ErrExpectedByteLengthFormat indicates the expected format for bytelength constraints.
+	ErrExpectedByteLengthFormat = errors.New("expected format 'bytelength[min..max]'")
+
+	// ErrInvalidByteLengthConstraint indicates that bytelength constraint syntax is invalid.
+	ErrInvalidByteLengthConstraint = errors.New("invalid bytelength constraint")
+
+	// IntDigits and Scale Constraint Errors
+
+	// ErrExpectedIntDigitsFormat indicates the expected format for intdigits constraints.
+	ErrExpectedIntDigitsFormat = errors.New("expected format 'intdigits[max]' with max of at least 1")
+
+	// ErrInvalidIntDigitsConstraint indicates that intdigits constraint syntax is invalid.
+	ErrInvalidIntDigitsConstraint = errors.New("invalid intdigits constraint")
+
+	// ErrExpectedScaleFormat indicates the expected format for scale constraints.
+	ErrExpectedScaleFormat = errors.New("expected format 'scale[max]' with max of at least 0")
+
+	// ErrInvalidScaleConstraint indicates that scale constraint syntax is invalid.
+	ErrInvalidScaleConstraint = errors.New("invalid scale constraint")
+
+	// ErrDigitLimitTooSmall indicates that an intdigits or scale limit is below its minimum.
+	ErrDigitLimitTooSmall = errors.New("digit limit is too small")
+
+	// ErrNotFixedPointDecimal indicates that a value uses an exponent, hex or other non fixed-point notation.
+	ErrNotFixedPointDecimal = errors.New("value is not a plain fixed-point decimal")
+
+	// ErrTooManyIntegerDigits indicates that a value has too many digits before the decimal point.
+	ErrTooManyIntegerDigits = errors.New("too many digits before the decimal point")
+
+	// ErrTooManyFractionalDigits indicates that a value has too many digits after the decimal point.
+	ErrTooManyFractionalDigits = errors.New("too many digits after the decimal point")
+
+	// Words Constraint Errors
+
+	// ErrExpectedWordsFormat indicates the expected format for words constraints.
+	ErrExpectedWordsFormat = errors.New("expected format 'words[max]' with max of at least 1")
+
+	// ErrInvalidWordsConstraint indicates that words constraint syntax is invalid.
+	ErrInvalidWordsConstraint = errors.New("invalid words constraint")
+
+	// ErrTooManyWords indicates that a slug has more hyphen-separated words than allowed.
+	ErrTooManyWords = errors.New("too many words in slug")
+
+	// DecodedLength Constraint Errors
+
+	// ErrExpectedDecodedLengthFormat indicates the expected format for decodedlen constraints.
+	ErrExpectedDecodedLengthFormat = errors.New("expected format 'decodedlen[min..max]'")
+
+	// ErrInvalidDecodedLengthConstraint indicates that decodedlen constraint syntax is invalid.
+	ErrInvalidDecodedLengthConstraint = errors.New("invalid decodedlen constraint")
+
+	// ErrDecodedLengthOutOfRange indicates that a value decodes to too few or too many bytes.
+	ErrDecodedLengthOutOfRange = errors.New("decoded length out of range")
+
 	// Date Format Constraint Errors
 
 	// ErrExpectedDateOnlyFormat indicates that only date format (no time) is expected.
@@ -40,8 +96,8 @@ var (
 
 	ErrDateGreaterThanMaximum = errors.New("date must be less than or equal to maximum")
 
-	// ErrStringFormatOnlySupportsIDFormats indicates that string format constraint only supports ulid, ksuid, nanoid.
-	ErrStringFormatOnlySupportsIDFormats = errors.New("string format constraint only supports ulid, ksuid, nanoid")
+	// ErrStringFormatOnlySupportsIDFormats indicates that string format constraint only supports ulid, ksuid, nanoid, url, httpsurl.
+	ErrStringFormatOnlySupportsIDFormats = errors.New("string format constraint only supports ulid, ksuid, nanoid, url, httpsurl")
 
 	// ErrFormatConstraintUnsupportedDataType indicates that format constraint only supports date, uuid, and string data types.
 	ErrFormatConstraintUnsupportedDataType = errors.New("format constraint only supports date, uuid, and string data types")
@@ -66,6 +122,9 @@ var (
 	// ErrInvalidEnumConstraint indicates that enum constraint syntax is invalid.
 	ErrInvalidEnumConstraint = errors.New("invalid enum constraint")
 
+	// ErrEnumValueNotOfDataType indicates that an enum member cannot be parsed as the parameter's data type.
+	ErrEnumValueNotOfDataType = errors.New("enum constraint value is not valid for the parameter's data type")
+
 	// Range Constraint Errors
 
 	// ErrExpectedRangeFormat indicates the expected format for range constraints.
@@ -89,6 +148,42 @@ var (
 	// ErrInvalidMinMaxDate indicates that minimum date cannot be less than maximum date.
 	ErrInvalidMinMaxDate = errors.New("minimum date cannot be less than maximum date")
 
+	// MultipleOf Constraint Errors
+
+	// ErrExpectedMultipleOfFormat indicates the expected format for multipleof constraints.
+	ErrExpectedMultipleOfFormat = errors.New("expected format 'multipleof[n]'")
+
+	// ErrInvalidMultipleOfConstraint indicates that multipleof constraint syntax is invalid.
+	ErrInvalidMultipleOfConstraint = errors.New("invalid multipleof constraint")
+
+	// ErrMultipleOfStepMustBePositive indicates that the multipleof step is zero or negative.
+	ErrMultipleOfStepMustBePositive = errors.New("multipleof step must be greater than zero")
+
+	// Luhn Constraint Errors
+
+	// ErrLuhnValueNotDigits indicates that a luhn-constrained value contains something other than digits.
+	ErrLuhnValueNotDigits = errors.New("value must contain only digits")
+
+	// ErrLuhnValueTooShort indicates that a luhn-constrained value has no digits besides the check digit.
+	ErrLuhnValueTooShort = errors.New("value must have at least two digits")
+
+	// ErrLuhnChecksumFailed indicates that a value's Luhn check digit is invalid.
+	ErrLuhnChecksumFailed = errors.New("invalid Luhn check digit")
+
+	// ErrLuhnConstraintTakesNoRule indicates that luhn was written with a bracketed rule.
+	ErrLuhnConstraintTakesNoRule = errors.New("luhn constraint does not accept arguments")
+
+	// Case Constraint Errors
+
+	// ErrExpectedCaseFormat indicates the expected format for case constraints.
+	ErrExpectedCaseFormat = errors.New("expected format 'case[lower]' or 'case[upper]'")
+
+	// ErrInvalidCaseConstraint indicates that case constraint syntax is invalid.
+	ErrInvalidCaseConstraint = errors.New("invalid case constraint")
+
+	// ErrValueNotInRequiredCase indicates that a value contains letters in the wrong case.
+	ErrValueNotInRequiredCase = errors.New("value is not in the required case")
+
 	// Regex Constraint Errors
 
 	// ErrEmptyRegexPattern indicates that regex pattern is empty.
@@ -100,6 +195,9 @@ var (
 	// ErrInvalidRegexConstraint indicates that regex constraint syntax is invalid.
 	ErrInvalidRegexConstraint = errors.New("invalid regex constraint")
 
+	// ErrRegexTooComplex indicates that a regex pattern compiles to more than MaxRegexProgramSize instructions.
+	ErrRegexTooComplex = errors.New("regex pattern is too complex")
+
 	// ErrRegexPatternContainsStartAnchor indicates that regex pattern contains ^ start anchor.
 	ErrRegexPatternContainsStartAnchor = errors.New("regex pattern contains ^ start anchor")
 
@@ -152,6 +250,9 @@ var (
 	// ErrInvalidCUIDFormat indicates that value is not a valid CUID.
 	ErrInvalidCUIDFormat = errors.New("invalid CUID format")
 
+	// ErrUnrecognizedIDFormat indicates that a format[id] value is not a UUID, ULID, KSUID, NanoID or CUID.
+	ErrUnrecognizedIDFormat = errors.New("unrecognized ID format")
+
 	// ErrInvalidSnowflakeFormat indicates that value is not a valid Snowflake ID.
 	ErrInvalidSnowflakeFormat = errors.New("invalid Snowflake ID format")
 
@@ -165,4 +266,104 @@ var (
 
 	// ErrInvalidSnowflakeEpoch indicates that the custom epoch parameter is invalid.
 	ErrInvalidSnowflakeEpoch = errors.New("invalid Snowflake epoch parameter")
+
+	// URL Format Constraint Errors
+
+	// ErrUnsupportedURLFormat indicates that the URL format is not supported.
+	ErrUnsupportedURLFormat = errors.New("unsupported URL format")
+
+	// ErrInvalidURLFormatConstraint indicates that URL format constraint syntax is invalid.
+	ErrInvalidURLFormatConstraint = errors.New("invalid URL format constraint")
+
+	// ErrInvalidURLFormatOption indicates that a URL format option is not of the form host=... or scheme=....
+	ErrInvalidURLFormatOption = errors.New("invalid URL format option; expected host=... or scheme=...")
+
+	// ErrInvalidURLFormat indicates that value is not a parseable URL.
+	ErrInvalidURLFormat = errors.New("invalid URL format")
+
+	// ErrSchemeRelativeURLNotAllowed indicates that value is a scheme-relative URL like //evil.com.
+	ErrSchemeRelativeURLNotAllowed = errors.New("scheme-relative URL not allowed")
+
+	// ErrAbsoluteURLRequired indicates that value must be an absolute URL with a host.
+	ErrAbsoluteURLRequired = errors.New("absolute URL with host required")
+
+	// ErrURLSchemeNotAllowed indicates that the URL scheme is not in the allowed set.
+	ErrURLSchemeNotAllowed = errors.New("URL scheme not allowed")
+
+	// ErrURLHostNotAllowed indicates that the URL host is not in the allowed set.
+	ErrURLHostNotAllowed = errors.New("URL host not allowed")
+
+	// JWT Format Constraint Errors
+
+	// ErrInvalidJWTFormatConstraint indicates that JWT format constraint syntax is invalid.
+	ErrInvalidJWTFormatConstraint = errors.New("invalid JWT format constraint")
+
+	// ErrInvalidJWTFormat indicates that value is not shaped like a JWT.
+	ErrInvalidJWTFormat = errors.New("invalid JWT format")
+
+	// ErrJWTWrongSegmentCount indicates that a JWT does not have exactly three dot-separated segments.
+	ErrJWTWrongSegmentCount = errors.New("JWT must have three dot-separated segments")
+
+	// ErrJWTSegmentNotBase64URL indicates that a JWT segment is not unpadded base64url.
+	ErrJWTSegmentNotBase64URL = errors.New("JWT segment is not valid base64url")
+
+	// MIME Format Constraint Errors
+
+	// ErrInvalidMIMEFormatConstraint indicates that MIME format constraint syntax is invalid.
+	ErrInvalidMIMEFormatConstraint = errors.New("invalid MIME format constraint")
+
+	// ErrInvalidMIMEFormat indicates that value is not a type/subtype MIME type.
+	ErrInvalidMIMEFormat = errors.New("invalid MIME type format")
+
+	// JSON Format Constraint Errors
+
+	// ErrInvalidJSONFormatConstraint indicates that JSON format constraint syntax is invalid.
+	ErrInvalidJSONFormatConstraint = errors.New("invalid JSON format constraint")
+
+	// ErrInvalidJSONFormat indicates that value is not well-formed JSON.
+	ErrInvalidJSONFormat = errors.New("invalid JSON format")
+
+	// Base32 Format Constraint Errors
+
+	// ErrInvalidBase32FormatConstraint indicates that base32 format constraint syntax is invalid.
+	ErrInvalidBase32FormatConstraint = errors.New("invalid base32 format constraint")
+
+	// ErrInvalidBase32Format indicates that value is not valid in the constraint's base32 alphabet.
+	ErrInvalidBase32Format = errors.New("invalid base32 format")
+
+	// ErrInvalidBase32Length indicates that an unpadded base32 value's length leaves a partial byte.
+	ErrInvalidBase32Length = errors.New("invalid base32 length")
+
+	// Duration Format Constraint Errors
+
+	// ErrInvalidDurationFormatConstraint indicates that duration format constraint syntax is invalid.
+	ErrInvalidDurationFormatConstraint = errors.New("invalid duration format constraint")
+
+	// ErrUnsupportedDurationFormat indicates that the format name is not duration.
+	ErrUnsupportedDurationFormat = errors.New("unsupported duration format")
+
+	// ErrInvalidDurationFormatOption indicates that a duration format option is malformed, unknown or that min exceeds max.
+	ErrInvalidDurationFormatOption = errors.New("invalid duration format option")
+
+	// ErrInvalidDurationFormat indicates that value is not a duration accepted by time.ParseDuration.
+	ErrInvalidDurationFormat = errors.New("invalid duration format")
+
+	// ErrDurationOutOfRange indicates that a duration is outside the constraint's min/max bounds.
+	ErrDurationOutOfRange = errors.New("duration out of range")
+
+	// E.164 Format Constraint Errors
+
+	// ErrInvalidE164FormatConstraint indicates that E.164 format constraint syntax is invalid.
+	ErrInvalidE164FormatConstraint = errors.New("invalid E.164 format constraint")
+
+	// ErrInvalidE164Format indicates that value is not a '+' followed by 1 to 15 digits.
+	ErrInvalidE164Format = errors.New("invalid E.164 phone number format")
+
+	// Base64URL Format Constraint Errors
+
+	// ErrInvalidBase64URLFormatConstraint indicates that base64url format constraint syntax is invalid.
+	ErrInvalidBase64URLFormatConstraint = errors.New("invalid base64url format constraint")
+
+	// ErrInvalidBase64URLFormat indicates that value is not valid base64url.
+	ErrInvalidBase64URLFormat = errors.New("invalid base64url format")
 )
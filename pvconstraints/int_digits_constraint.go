@@ -0,0 +1,177 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvtypes.RegisterConstraint(&IntDigitsConstraint{})
+}
+
+var _ pvtypes.Constraint = (*IntDigitsConstraint)(nil)
+
+// IntDigitsConstraint limits how many digits a decimal may have before the
+// decimal point, e.g. intdigits[6] for amounts below one million. Digits are
+// counted in the raw string, so leading zeros count, and no float conversion
+// is involved. Combined with scale[...] it fully bounds a fixed-point value.
+type IntDigitsConstraint struct {
+	pvtypes.BaseConstraint
+	max int
+}
+
+func NewIntDigitsConstraint(max int) *IntDigitsConstraint {
+	c := &IntDigitsConstraint{max: max}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *IntDigitsConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.DecimalType}
+}
+
+func (c *IntDigitsConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseIntDigitsConstraint(value)
+}
+
+func (c *IntDigitsConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.IntDigitsConstraintType
+}
+
+func (c *IntDigitsConstraint) Rule() string {
+	return strconv.Itoa(c.max)
+}
+
+func (c *IntDigitsConstraint) Validate(value string) (err error) {
+	var intPart string
+	var ok bool
+
+	intPart, _, ok = splitFixedPoint(value)
+	if !ok {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrNotFixedPointDecimal,
+			"value", value,
+		)
+		goto end
+	}
+
+	if len(intPart) > c.max {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrTooManyIntegerDigits,
+			"value", value,
+			"integer_digits", len(intPart),
+			"max_integer_digits", c.max,
+		)
+	}
+
+end:
+	return err
+}
+
+func (c *IntDigitsConstraint) ErrorDetail(param *pvtypes.Parameter, value string) string {
+	intPart, _, ok := splitFixedPoint(value)
+	if ok && len(intPart) > c.max {
+		return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: value has %d digits before the decimal point but at most %d are allowed",
+			param.Name,
+			value,
+			len(intPart),
+			c.max,
+		)
+	}
+	return c.BaseConstraint.ErrorDetail(param, value)
+}
+
+func (c *IntDigitsConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is a plain decimal with at most %d digits before the decimal point, for example: %s",
+		param.Name,
+		c.max,
+		example,
+	)
+}
+
+// Example returns a small value with a single integer digit.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *IntDigitsConstraint) Example(err error) any {
+	return 1.23
+}
+
+// ParseIntDigitsConstraint parses the maximum number of integer digits, which must be at least 1.
+func ParseIntDigitsConstraint(maxSpec string) (constraint *IntDigitsConstraint, err error) {
+	var limit int
+
+	limit, err = parseDigitLimit(maxSpec, 1, ErrExpectedIntDigitsFormat)
+	if err != nil {
+		goto end
+	}
+	constraint = NewIntDigitsConstraint(limit)
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			ErrInvalidIntDigitsConstraint,
+			"intdigits_spec", maxSpec,
+		)
+	}
+	return constraint, err
+}
+
+// splitFixedPoint splits a plain decimal such as "-123.45" into its integer
+// and fractional digits, "123" and "45". It reports false for anything else
+// the decimal type accepts via strconv.ParseFloat, such as exponents, hex
+// floats, Inf and NaN, since their digits say nothing about magnitude.
+func splitFixedPoint(value string) (intPart, fracPart string, ok bool) {
+	value = strings.TrimLeft(value, "+-")
+	intPart, fracPart, _ = strings.Cut(value, ".")
+	if intPart == "" && fracPart == "" {
+		goto end
+	}
+	if !isDigits(intPart) || !isDigits(fracPart) {
+		goto end
+	}
+	ok = true
+end:
+	return intPart, fracPart, ok
+}
+
+// isDigits reports whether s contains only ASCII digits; "" qualifies.
+func isDigits(s string) bool {
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDigitLimit parses a single digit count of at least minimum, as used
+// by intdigits[max] and scale[max].
+func parseDigitLimit(limitSpec string, minimum int, formatErr error) (limit int, err error) {
+	limitSpec = strings.TrimSpace(limitSpec)
+
+	limit, err = strconv.Atoi(limitSpec)
+	if err != nil {
+		err = pvtypes.NewErr(
+			formatErr,
+			"limit", limitSpec,
+			err,
+		)
+		goto end
+	}
+
+	if limit < minimum {
+		err = pvtypes.NewErr(
+			formatErr,
+			ErrDigitLimitTooSmall,
+			"limit", limit,
+			"minimum", minimum,
+		)
+	}
+
+end:
+	return limit, err
+}
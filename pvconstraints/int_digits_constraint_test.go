@@ -0,0 +1,91 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.IntDigitsConstraint)(nil)
+
+func TestIntDigitsConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantRule string
+		wantErr  bool
+	}{
+		{"six", "6", "6", false},
+		{"one", "1", "1", false},
+		{"padded", " 3 ", "3", false},
+		{"zero", "0", "", true},
+		{"negative", "-1", "", true},
+		{"empty", "", "", true},
+		{"range", "1..6", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseIntDigitsConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseIntDigitsConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseIntDigitsConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.IntDigitsConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.IntDigitsConstraintType)
+			}
+
+			if constraint.Rule() != tt.wantRule {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestIntDigitsConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		max       int
+		testValue string
+		wantValid bool
+	}{
+		{"at-limit", 6, "123456.78", true},
+		{"below-limit", 6, "12.5", true},
+		{"whole-number", 6, "123456", true},
+		{"negative", 6, "-123456.00", true},
+		{"no-integer-part", 1, ".5", true},
+		{"over-limit", 6, "1234567.00", false},
+		{"leading-zeros-count", 2, "007.5", false},
+		{"negative-over-limit", 2, "-123", false},
+		{"exponent", 6, "1e9", false},
+		{"infinity", 6, "Inf", false},
+		{"only-point", 6, ".", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint := pvconstraints.NewIntDigitsConstraint(tt.max)
+
+			err := constraint.Validate(tt.testValue)
+
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error", tt.testValue)
+			}
+		})
+	}
+}
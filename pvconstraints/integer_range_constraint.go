@@ -2,6 +2,7 @@ package pvconstraints
 
 import (
 	"fmt"
+	"math/rand/v2"
 	"strconv"
 	"strings"
 
@@ -13,6 +14,7 @@ func init() {
 }
 
 var _ pvtypes.Constraint = (*IntegerRangeConstraint)(nil)
+var _ pvtypes.RandomExampler = (*IntegerRangeConstraint)(nil)
 
 // IntegerRangeConstraint validates integer ranges
 type IntegerRangeConstraint struct {
@@ -89,6 +91,16 @@ func (c *IntegerRangeConstraint) Example(err error) any {
 	return (c.min + c.max) / 2
 }
 
+// RandomExample returns an integer chosen uniformly from the range.
+func (c *IntegerRangeConstraint) RandomExample(r *rand.Rand) any {
+	span := uint64(c.max-c.min) + 1
+	if span == 0 {
+		// The range covers every int64
+		return r.Int64()
+	}
+	return c.min + int64(r.Uint64N(span))
+}
+
 // ParseIntRangeConstraint parses min..max format for integers
 func ParseIntRangeConstraint(rangeSpec string) (constraint *IntegerRangeConstraint, err error) {
 	var parts []string
@@ -1,6 +1,9 @@
 package pvconstraints_test
 
 import (
+	"fmt"
+	"math"
+	"math/rand/v2"
 	"testing"
 
 	"github.com/mikeschinkel/go-pathvars/pvconstraints"
@@ -164,3 +167,29 @@ func TestIntegerRangeConstraintInterface(t *testing.T) {
 		t.Error("ValidDataTypes() should include IntegerType")
 	}
 }
+
+func TestIntegerRangeConstraintRandomExample(t *testing.T) {
+	tests := []struct {
+		name string
+		min  int64
+		max  int64
+	}{
+		{"small-range", 1, 10},
+		{"single-value", 5, 5},
+		{"negative-range", -100, -10},
+		{"full-int64-range", math.MinInt64, math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := pvconstraints.NewIntRangeConstraint(tt.min, tt.max)
+			r := rand.New(rand.NewPCG(1, 2))
+			for range 100 {
+				example := fmt.Sprintf("%v", c.RandomExample(r))
+				if err := c.Validate(example); err != nil {
+					t.Fatalf("RandomExample() = %s, which fails validation: %v", example, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package pvconstraints
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// JSONFormat is the format name supported by format[json] on strings
+const JSONFormat = "json"
+
+// Note: JSONFormatConstraint is not registered directly.
+// It's handled by DateFormatConstraint.Parse() for the string data type.
+
+var _ pvtypes.Constraint = (*JSONFormatConstraint)(nil)
+
+// JSONFormatConstraint validates that a string is well-formed JSON, e.g. a
+// filter object passed as ?filter={"status":"active"}. Values are validated
+// after the router has URL-decoded them, so clients should percent-encode the
+// JSON as usual. Any JSON value is accepted, including bare scalars such as
+// 42 or "text", but unquoted words are not.
+type JSONFormatConstraint struct {
+	pvtypes.BaseConstraint
+}
+
+func NewJSONFormatConstraint() *JSONFormatConstraint {
+	c := &JSONFormatConstraint{}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *JSONFormatConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *JSONFormatConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.FormatConstraintType
+}
+
+func (c *JSONFormatConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseJSONFormatConstraint(value)
+}
+
+func (c *JSONFormatConstraint) Rule() string {
+	return JSONFormat
+}
+
+func (c *JSONFormatConstraint) Validate(value string) (err error) {
+	if !json.Valid([]byte(value)) {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidJSONFormat,
+			"value", value,
+		)
+	}
+	return err
+}
+
+func (c *JSONFormatConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is well-formed, URL-encoded JSON with quoted strings and balanced brackets, for example: %s",
+		param.Name,
+		example,
+	)
+}
+
+// Example returns a small JSON object.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *JSONFormatConstraint) Example(err error) any {
+	return `{"status":"active"}`
+}
+
+// ParseJSONFormatConstraint parses the json format specification, which takes no options.
+func ParseJSONFormatConstraint(spec string) (constraint *JSONFormatConstraint, err error) {
+	if !strings.EqualFold(strings.TrimSpace(spec), JSONFormat) {
+		err = pvtypes.NewErr(
+			ErrInvalidJSONFormatConstraint,
+			"json_format_spec", spec,
+		)
+		goto end
+	}
+	constraint = NewJSONFormatConstraint()
+end:
+	return constraint, err
+}
@@ -0,0 +1,87 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.JSONFormatConstraint)(nil)
+
+func TestJSONFormatConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"json", "json", false},
+		{"uppercase", "JSON", false},
+		{"with-options", "json:schema=filter", true},
+		{"unknown-format", "jsonl", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseJSONFormatConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseJSONFormatConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseJSONFormatConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.FormatConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.FormatConstraintType)
+			}
+		})
+	}
+}
+
+func TestJSONFormatConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"object", `{"status":"active","tags":["a","b"]}`, false},
+		{"array", `[1,2,3]`, false},
+		{"quoted-string", `"active"`, false},
+		{"number", `42`, false},
+
+		{"truncated-object", `{"status":"act`, true},
+		{"truncated-array", `[1,2`, true},
+		{"bare-string", `active`, true},
+		{"single-quotes", `{'status':'active'}`, true},
+		{"empty", ``, true},
+	}
+
+	constraint := pvconstraints.NewJSONFormatConstraint()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := constraint.Validate(tt.value)
+			if tt.wantErr && err == nil {
+				t.Errorf("Validate(%q) expected error but got none", tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate(%q) unexpected error: %v", tt.value, err)
+			}
+		})
+	}
+}
+
+func TestJSONFormatConstraintExample(t *testing.T) {
+	constraint := pvconstraints.NewJSONFormatConstraint()
+	example := constraint.Example(nil)
+	err := constraint.Validate(example.(string))
+	if err != nil {
+		t.Errorf("Example() %v does not satisfy its own constraint: %v", example, err)
+	}
+}
@@ -2,8 +2,10 @@ package pvconstraints
 
 import (
 	"fmt"
+	"math/rand/v2"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/mikeschinkel/go-pathvars/pvtypes"
 )
@@ -13,8 +15,10 @@ func init() {
 }
 
 var _ pvtypes.Constraint = (*LengthConstraint)(nil)
+var _ pvtypes.RandomExampler = (*LengthConstraint)(nil)
 
-// LengthConstraint validates string length
+// LengthConstraint validates string length in characters (runes), so that
+// "héllo" has a length of 5. Use ByteLengthConstraint to limit UTF-8 bytes.
 type LengthConstraint struct {
 	pvtypes.BaseConstraint
 	min int
@@ -46,7 +50,7 @@ func (c *LengthConstraint) Type() pvtypes.ConstraintType {
 }
 
 func (c *LengthConstraint) Validate(value string) (err error) {
-	length := len(value)
+	length := utf8.RuneCountInString(value)
 	if length < c.min || length > c.max {
 		err = fmt.Errorf("length must be between %d and %d", c.min, c.max)
 	}
@@ -57,15 +61,46 @@ func (c *LengthConstraint) Rule() string {
 	return fmt.Sprintf("%d..%d", c.min, c.max)
 }
 
+// RandomExample returns lowercase letters of a random length within the range,
+// capped at 16 letters beyond the minimum so examples stay readable.
+func (c *LengthConstraint) RandomExample(r *rand.Rand) any {
+	n := c.min + r.IntN(min(c.max, c.min+16)-c.min+1)
+	sb := strings.Builder{}
+	for range n {
+		sb.WriteByte(byte('a' + r.IntN(26)))
+	}
+	return sb.String()
+}
+
 // ParseLengthConstraint parses min..max format
 func ParseLengthConstraint(lengthSpec string) (constraint *LengthConstraint, err error) {
-	var parts []string
 	var minimum, maximum int
 
+	minimum, maximum, err = parseLengthBounds(lengthSpec, ErrExpectedLengthFormat)
+	if err != nil {
+		goto end
+	}
+
+	constraint = NewLengthConstraint(minimum, maximum)
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			"length_spec", lengthSpec,
+		)
+	}
+	return constraint, err
+}
+
+// parseLengthBounds parses a non-negative min..max pair, reporting formatErr
+// when the spec is not of that shape.
+func parseLengthBounds(lengthSpec string, formatErr error) (minimum, maximum int, err error) {
+	var parts []string
+
 	// Split by ".."
 	parts = strings.Split(lengthSpec, "..")
 	if len(parts) != 2 {
-		err = pvtypes.NewErr(ErrExpectedLengthFormat)
+		err = pvtypes.NewErr(formatErr)
 		goto end
 	}
 
@@ -104,13 +139,6 @@ func ParseLengthConstraint(lengthSpec string) (constraint *LengthConstraint, err
 		goto end
 	}
 
-	constraint = NewLengthConstraint(minimum, maximum)
-
 end:
-	if err != nil {
-		err = pvtypes.WithErr(err,
-			"length_spec", lengthSpec,
-		)
-	}
-	return constraint, err
+	return minimum, maximum, err
 }
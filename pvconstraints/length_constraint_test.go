@@ -102,6 +102,8 @@ func TestLengthConstraintValidation(t *testing.T) {
 
 		// Edge cases
 		{"unicode-characters", "5..10", "hello", true},
+		{"multibyte-counts-runes", "5..5", "héllo", true},
+		{"emoji-counts-as-one", "1..1", "🙂", true},
 		{"spaces-count", "3..10", "a b c", true},
 		{"single-char-valid", "1..5", "x", true},
 		{"single-char-invalid", "2..5", "x", false},
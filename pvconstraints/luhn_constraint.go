@@ -0,0 +1,147 @@
+package pvconstraints
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvtypes.RegisterConstraint(&LuhnConstraint{})
+}
+
+var _ pvtypes.Constraint = (*LuhnConstraint)(nil)
+
+// LuhnConstraint validates that a value is all digits and that its last digit
+// is a valid Luhn (mod 10) check digit, as used by card numbers, IMEIs and
+// some national ids. It takes no rule and is written bare, e.g. {card:string:luhn}.
+type LuhnConstraint struct {
+	pvtypes.BaseConstraint
+}
+
+func NewLuhnConstraint() *LuhnConstraint {
+	c := &LuhnConstraint{}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *LuhnConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{
+		pvtypes.IntegerType,
+		pvtypes.StringType,
+	}
+}
+
+func (c *LuhnConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseLuhnConstraint(value)
+}
+
+func (c *LuhnConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.LuhnConstraintType
+}
+
+func (c *LuhnConstraint) Rule() string {
+	return ""
+}
+
+func (c *LuhnConstraint) String() string {
+	return string(pvtypes.LuhnConstraintType)
+}
+
+func (c *LuhnConstraint) Validate(value string) (err error) {
+	var sum int
+
+	if len(value) < 2 {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrLuhnValueTooShort,
+			"value", value,
+		)
+		goto end
+	}
+
+	for i := range len(value) {
+		ch := value[len(value)-1-i]
+		if ch < '0' || ch > '9' {
+			err = pvtypes.NewErr(
+				ErrParameterValidationFailed,
+				ErrLuhnValueNotDigits,
+				"value", value,
+			)
+			goto end
+		}
+		digit := int(ch - '0')
+		if i%2 == 1 {
+			// Double every second digit counting from the check digit
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+
+	if sum%10 != 0 {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrLuhnChecksumFailed,
+			"value", value,
+		)
+	}
+
+end:
+	return err
+}
+
+func (c *LuhnConstraint) ErrorDetail(param *pvtypes.Parameter, value string) string {
+	err := c.Validate(value)
+	switch {
+	case errors.Is(err, ErrLuhnChecksumFailed):
+		return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: the Luhn check digit is invalid",
+			param.Name,
+			value,
+		)
+	case err != nil:
+		return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: value must be two or more digits ending in a Luhn check digit",
+			param.Name,
+			value,
+		)
+	}
+	return c.BaseConstraint.ErrorDetail(param, value)
+}
+
+func (c *LuhnConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	if errors.Is(c.Validate(value), ErrLuhnChecksumFailed) {
+		return fmt.Sprintf("Ensure parameter '%s' has a valid check digit; the last digit of '%s' does not satisfy the Luhn checksum, which usually means a digit was mistyped or transposed, for example: %s",
+			param.Name,
+			value,
+			example,
+		)
+	}
+	return fmt.Sprintf("Ensure parameter '%s' contains only digits ending in a valid Luhn check digit, for example: %s",
+		param.Name,
+		example,
+	)
+}
+
+// Example returns a well-known Luhn-valid test card number.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *LuhnConstraint) Example(err error) any {
+	return "4111111111111111"
+}
+
+// ParseLuhnConstraint parses a luhn constraint (no arguments expected)
+func ParseLuhnConstraint(value string) (constraint *LuhnConstraint, err error) {
+	if value != "" {
+		err = pvtypes.NewErr(
+			ErrLuhnConstraintTakesNoRule,
+			"rule", value,
+		)
+		goto end
+	}
+	constraint = NewLuhnConstraint()
+
+end:
+	return constraint, err
+}
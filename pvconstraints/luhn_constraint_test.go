@@ -0,0 +1,99 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.LuhnConstraint)(nil)
+
+func TestLuhnConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"empty-spec", "", false},
+		{"with-argument", "16", true},
+		{"with-word", "luhn", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseLuhnConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseLuhnConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseLuhnConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.LuhnConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.LuhnConstraintType)
+			}
+
+			if constraint.String() != "luhn" {
+				t.Errorf("String() = %q, want %q", constraint.String(), "luhn")
+			}
+		})
+	}
+}
+
+func TestLuhnConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		testValue string
+		wantValid bool
+	}{
+		{"classic-example", "79927398713", true},
+		{"visa-test-card", "4111111111111111", true},
+		{"amex-test-card", "378282246310005", true},
+		{"imei", "490154203237518", true},
+		{"two-digits", "18", true},
+		{"zeros", "00", true},
+		{"wrong-check-digit", "79927398710", false},
+		{"adjacent-transposition", "79972398713", false},
+		{"single-digit-changed", "4111111111111121", false},
+		{"non-numeric", "4111-1111-1111-1111", false},
+		{"letters", "abcdefg", false},
+		{"spaces", "4111 1111 1111 1111", false},
+		{"signed", "+79927398713", false},
+		{"single-digit", "0", false},
+		{"empty", "", false},
+	}
+
+	constraint := pvconstraints.NewLuhnConstraint()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := constraint.Validate(tt.testValue)
+
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error", tt.testValue)
+			}
+		})
+	}
+}
+
+func TestLuhnConstraintExample(t *testing.T) {
+	constraint := pvconstraints.NewLuhnConstraint()
+	example, ok := constraint.Example(nil).(string)
+	if !ok {
+		t.Fatalf("Example() returned %T, want string", constraint.Example(nil))
+	}
+	if err := constraint.Validate(example); err != nil {
+		t.Errorf("Example() %q does not validate: %v", example, err)
+	}
+}
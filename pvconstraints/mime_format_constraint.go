@@ -0,0 +1,103 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// MIMEFormat is the format name supported by format[mime] on strings
+const MIMEFormat = "mime"
+
+// Note: MIMEFormatConstraint is not registered directly.
+// It's handled by DateFormatConstraint.Parse() for the string data type.
+
+var _ pvtypes.Constraint = (*MIMEFormatConstraint)(nil)
+
+// MIMEFormatConstraint validates that a string is a MIME type of the form
+// type/subtype with optional parameters, e.g. "text/html; charset=utf-8".
+// The type and subtype are not checked against a registry of known types.
+type MIMEFormatConstraint struct {
+	pvtypes.BaseConstraint
+}
+
+func NewMIMEFormatConstraint() *MIMEFormatConstraint {
+	c := &MIMEFormatConstraint{}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *MIMEFormatConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *MIMEFormatConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.FormatConstraintType
+}
+
+func (c *MIMEFormatConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseMIMEFormatConstraint(value)
+}
+
+func (c *MIMEFormatConstraint) Rule() string {
+	return MIMEFormat
+}
+
+func (c *MIMEFormatConstraint) Validate(value string) (err error) {
+	var mediaType, typ, subtype string
+	var found bool
+
+	mediaType, _, err = mime.ParseMediaType(value)
+	if err != nil {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidMIMEFormat,
+			"value", value,
+			err,
+		)
+		goto end
+	}
+
+	// mime.ParseMediaType() also accepts bare disposition values like "inline"
+	typ, subtype, found = strings.Cut(mediaType, "/")
+	if !found || typ == "" || subtype == "" {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidMIMEFormat,
+			"value", value,
+		)
+		goto end
+	}
+
+end:
+	return err
+}
+
+func (c *MIMEFormatConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is a MIME type of the form type/subtype, for example: %s",
+		param.Name,
+		example,
+	)
+}
+
+// Example returns a common MIME type.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *MIMEFormatConstraint) Example(err error) any {
+	return "application/json"
+}
+
+// ParseMIMEFormatConstraint parses the mime format specification, which takes no options.
+func ParseMIMEFormatConstraint(spec string) (constraint *MIMEFormatConstraint, err error) {
+	if !strings.EqualFold(strings.TrimSpace(spec), MIMEFormat) {
+		err = pvtypes.NewErr(
+			ErrInvalidMIMEFormatConstraint,
+			"mime_format_spec", spec,
+		)
+		goto end
+	}
+	constraint = NewMIMEFormatConstraint()
+end:
+	return constraint, err
+}
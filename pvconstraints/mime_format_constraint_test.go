@@ -0,0 +1,91 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.MIMEFormatConstraint)(nil)
+
+func TestMIMEFormatConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"mime", "mime", false},
+		{"uppercase", "MIME", false},
+		{"with-options", "mime:type=image", true},
+		{"unknown-format", "mimetype", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseMIMEFormatConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseMIMEFormatConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseMIMEFormatConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.FormatConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.FormatConstraintType)
+			}
+		})
+	}
+}
+
+func TestMIMEFormatConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"text-html", "text/html", false},
+		{"vendor-suffix", "application/vnd.api+json", false},
+		{"with-charset", "text/plain; charset=utf-8", false},
+		{"wildcard-subtype", "image/*", false},
+
+		{"no-subtype", "image", true},
+		{"double-slash", "image//png", true},
+		{"empty-type", "/png", true},
+		{"trailing-slash", "image/", true},
+		{"malformed-parameter", "text/html; charset", true},
+		{"empty", "", true},
+	}
+
+	constraint := pvconstraints.NewMIMEFormatConstraint()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := constraint.Validate(tt.value)
+			if tt.wantErr && err == nil {
+				t.Errorf("Validate(%q) expected error but got none", tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate(%q) unexpected error: %v", tt.value, err)
+			}
+		})
+	}
+}
+
+func TestMIMEFormatConstraintExample(t *testing.T) {
+	constraint := pvconstraints.NewMIMEFormatConstraint()
+	example := constraint.Example(nil)
+	if example != "application/json" {
+		t.Errorf("Example() = %v, want %v", example, "application/json")
+	}
+	err := constraint.Validate(example.(string))
+	if err != nil {
+		t.Errorf("Example() %v does not satisfy its own constraint: %v", example, err)
+	}
+}
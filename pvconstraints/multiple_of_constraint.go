@@ -0,0 +1,152 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvtypes.RegisterConstraint(&MultipleOfConstraint{})
+}
+
+var _ pvtypes.Constraint = (*MultipleOfConstraint)(nil)
+
+// MultipleOfConstraint validates that an integer is an exact multiple of a step
+type MultipleOfConstraint struct {
+	pvtypes.BaseConstraint
+	step int64
+}
+
+func NewMultipleOfConstraint(step int64) *MultipleOfConstraint {
+	c := &MultipleOfConstraint{step: step}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *MultipleOfConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.IntegerType}
+}
+
+func (c *MultipleOfConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseMultipleOfConstraint(value)
+}
+
+func (c *MultipleOfConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.MultipleOfConstraintType
+}
+
+func (c *MultipleOfConstraint) Validate(value string) (err error) {
+	var n int64
+
+	n, err = strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		goto end
+	}
+
+	if n%c.step != 0 {
+		err = fmt.Errorf("value must be a multiple of %d", c.step)
+	}
+
+end:
+	return err
+}
+
+func (c *MultipleOfConstraint) Rule() string {
+	return strconv.FormatInt(c.step, 10)
+}
+
+// Nearest returns the closest multiples of the step at or below and above n.
+// When n is itself a multiple, lower and upper are both n.
+func (c *MultipleOfConstraint) Nearest(n int64) (lower, upper int64) {
+	rem := ((n % c.step) + c.step) % c.step
+	lower = n - rem
+	upper = lower
+	if rem != 0 {
+		upper = lower + c.step
+	}
+	return lower, upper
+}
+
+func (c *MultipleOfConstraint) ErrorDetail(param *pvtypes.Parameter, value string) string {
+	var n int64
+	var err error
+	n, err = strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		goto end
+	}
+	if n%c.step != 0 {
+		return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: value %d is not a multiple of %d",
+			param.Name,
+			value,
+			n,
+			c.step,
+		)
+	}
+end:
+	return c.BaseConstraint.ErrorDetail(param, value)
+}
+
+func (c *MultipleOfConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	var lower, upper int64
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Sprintf("Ensure parameter '%s' is a multiple of %d, for example: %s", param.Name, c.step, example)
+	}
+	lower, upper = c.Nearest(n)
+	return fmt.Sprintf("Ensure parameter '%s' is a multiple of %d; the nearest valid values are %d and %d, for example: %s",
+		param.Name,
+		c.step,
+		lower,
+		upper,
+		example,
+	)
+}
+
+// Example returns the step itself as the smallest positive valid value.
+// The error parameter is currently unused but allows for future context-aware examples.
+func (c *MultipleOfConstraint) Example(err error) any {
+	return c.step
+}
+
+// ParseMultipleOfConstraint parses a single positive integer step
+func ParseMultipleOfConstraint(stepSpec string) (constraint *MultipleOfConstraint, err error) {
+	var step int64
+
+	stepSpec = strings.TrimSpace(stepSpec)
+	if stepSpec == "" {
+		err = pvtypes.NewErr(ErrExpectedMultipleOfFormat)
+		goto end
+	}
+
+	step, err = strconv.ParseInt(stepSpec, 10, 64)
+	if err != nil {
+		err = pvtypes.NewErr(
+			ErrExpectedMultipleOfFormat,
+			"step", stepSpec,
+			err,
+		)
+		goto end
+	}
+
+	if step <= 0 {
+		err = pvtypes.NewErr(
+			ErrMultipleOfStepMustBePositive,
+			"step", step,
+		)
+		goto end
+	}
+
+	constraint = NewMultipleOfConstraint(step)
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			ErrInvalidMultipleOfConstraint,
+			"multipleof_spec", stepSpec,
+		)
+	}
+	return constraint, err
+}
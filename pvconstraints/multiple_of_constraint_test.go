@@ -0,0 +1,146 @@
+package pvconstraints_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.MultipleOfConstraint)(nil)
+
+func TestMultipleOfConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"valid-step", "8", false},
+		{"step-of-one", "1", false},
+		{"large-step", "1000", false},
+		{"whitespace-step", " 10 ", false},
+
+		{"empty-spec", "", true},
+		{"zero-step", "0", true},
+		{"negative-step", "-5", true},
+		{"non-numeric", "abc", true},
+		{"decimal-step", "2.5", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseMultipleOfConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseMultipleOfConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseMultipleOfConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.MultipleOfConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.MultipleOfConstraintType)
+			}
+
+			if constraint.Rule() != strings.TrimSpace(tt.spec) {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), strings.TrimSpace(tt.spec))
+			}
+		})
+	}
+}
+
+func TestMultipleOfConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		step      string
+		testValue string
+		wantValid bool
+	}{
+		{"exact-step", "8", "8", true},
+		{"double-step", "8", "16", true},
+		{"zero", "8", "0", true},
+		{"negative-multiple", "8", "-24", true},
+		{"off-by-one", "8", "17", false},
+		{"below-step", "8", "7", false},
+		{"negative-non-multiple", "8", "-9", false},
+		{"step-of-ten", "10", "100", true},
+		{"step-of-ten-invalid", "10", "105", false},
+		{"non-numeric", "8", "abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseMultipleOfConstraint(tt.step)
+			if err != nil {
+				t.Fatalf("ParseMultipleOfConstraint() failed: %v", err)
+			}
+
+			err = constraint.Validate(tt.testValue)
+
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error", tt.testValue)
+			}
+		})
+	}
+}
+
+func TestMultipleOfConstraintNearest(t *testing.T) {
+	constraint, err := pvconstraints.ParseMultipleOfConstraint("8")
+	if err != nil {
+		t.Fatalf("ParseMultipleOfConstraint() failed: %v", err)
+	}
+
+	tests := []struct {
+		value     int64
+		wantLower int64
+		wantUpper int64
+	}{
+		{17, 16, 24},
+		{16, 16, 16},
+		{1, 0, 8},
+		{-1, -8, 0},
+		{-17, -24, -16},
+	}
+
+	for _, tt := range tests {
+		lower, upper := constraint.Nearest(tt.value)
+		if lower != tt.wantLower || upper != tt.wantUpper {
+			t.Errorf("Nearest(%d) = %d, %d; want %d, %d", tt.value, lower, upper, tt.wantLower, tt.wantUpper)
+		}
+	}
+}
+
+func TestMultipleOfConstraintInterface(t *testing.T) {
+	constraint, err := pvconstraints.ParseMultipleOfConstraint("8")
+	if err != nil {
+		t.Fatalf("Failed to parse multipleof constraint: %v", err)
+	}
+
+	if constraint.String() != "multipleof[8]" {
+		t.Errorf("String() = %q, want %q", constraint.String(), "multipleof[8]")
+	}
+
+	if constraint.ValidatesType() {
+		t.Error("ValidatesType() should return false for multipleof constraints")
+	}
+
+	if constraint.Example(nil) != int64(8) {
+		t.Errorf("Example() = %v, want 8", constraint.Example(nil))
+	}
+
+	validTypes := constraint.ValidDataTypes()
+	if len(validTypes) != 1 || validTypes[0] != pvtypes.IntegerType {
+		t.Errorf("ValidDataTypes() = %v, want [IntegerType]", validTypes)
+	}
+}
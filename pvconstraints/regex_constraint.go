@@ -3,6 +3,7 @@ package pvconstraints
 import (
 	"fmt"
 	"regexp"
+	"regexp/syntax"
 	"strings"
 
 	"github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -12,7 +13,17 @@ func init() {
 	pvtypes.RegisterConstraint(&RegexConstraint{})
 }
 
+// MaxRegexProgramSize is the maximum number of instructions a regex[...]
+// pattern may compile to before ParseRegexConstraint() rejects it with
+// ErrRegexTooComplex. RE2 semantics rule out catastrophic backtracking, but
+// large alternations and counted repetitions, e.g. \w{1,1000} at about 2,000
+// instructions, still cost memory per route and time per match. The
+// default is generous for hand-written patterns and may be raised or lowered
+// before routes are added.
+var MaxRegexProgramSize = 10000
+
 var _ pvtypes.Constraint = (*RegexConstraint)(nil)
+var _ pvtypes.RegexpConstraint = (*RegexConstraint)(nil)
 
 // RegexConstraint validates against regex regex
 type RegexConstraint struct {
@@ -30,6 +41,23 @@ func NewRegexConstraint(regex *regexp.Regexp, raw string) *RegexConstraint {
 	return c
 }
 
+// NewCompiledRegexConstraint creates a regex constraint from a pre-compiled
+// pattern. Unless re is already anchored with both ^ and $ it is wrapped as
+// ^(?:pattern)$ so it matches the complete parameter value.
+func NewCompiledRegexConstraint(re *regexp.Regexp) *RegexConstraint {
+	raw := re.String()
+	if !strings.HasPrefix(raw, "^") || !strings.HasSuffix(raw, "$") {
+		// Wrapping an already-valid pattern in a non-capturing group cannot fail
+		re = regexp.MustCompile("^(?:" + raw + ")$")
+	}
+	return NewRegexConstraint(re, raw)
+}
+
+// WithRegexp implements pvtypes.RegexpConstraint.
+func (c *RegexConstraint) WithRegexp(re *regexp.Regexp) pvtypes.Constraint {
+	return NewCompiledRegexConstraint(re)
+}
+
 func (c *RegexConstraint) ValidDataTypes() []pvtypes.PVDataType {
 	return []pvtypes.PVDataType{
 		pvtypes.StringType,
@@ -113,6 +141,11 @@ func ParseRegexConstraint(pattern string) (constraint *RegexConstraint, err erro
 		goto end
 	}
 
+	err = checkRegexProgramSize(anchoredPattern)
+	if err != nil {
+		goto end
+	}
+
 	// Store original pattern (without anchors) for display
 	constraint = NewRegexConstraint(regex, pattern)
 
@@ -125,3 +158,30 @@ end:
 	}
 	return constraint, err
 }
+
+// checkRegexProgramSize returns ErrRegexTooComplex when pattern, which must
+// already compile, has more instructions than MaxRegexProgramSize.
+func checkRegexProgramSize(pattern string) (err error) {
+	var re *syntax.Regexp
+	var prog *syntax.Prog
+
+	// Parse with the flags regexp.Compile() uses so the sizes agree
+	re, err = syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		goto end
+	}
+	prog, err = syntax.Compile(re.Simplify())
+	if err != nil {
+		goto end
+	}
+	if len(prog.Inst) > MaxRegexProgramSize {
+		err = pvtypes.NewErr(
+			ErrRegexTooComplex,
+			"program_size", len(prog.Inst),
+			"max_program_size", MaxRegexProgramSize,
+		)
+	}
+
+end:
+	return err
+}
@@ -1,6 +1,10 @@
 package pvconstraints_test
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/mikeschinkel/go-pathvars/pvconstraints"
@@ -340,3 +344,104 @@ func regexContains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestCompiledRegexConstraint(t *testing.T) {
+	tests := []struct {
+		name      string
+		pattern   string
+		testValue string
+		wantValid bool
+	}{
+		{"unanchored-full-match", `[[:upper:]]{2}\[[0-9]{2}\]`, "AB[12]", true},
+		{"unanchored-rejects-substring", `[[:upper:]]{2}\[[0-9]{2}\]`, "xAB[12]x", false},
+		{"alternation-anchored-as-group", `cat|dog`, "catalog", false},
+		{"alternation-member", `cat|dog`, "dog", true},
+		{"pre-anchored", `^[a-z]+$`, "abc", true},
+		{"pre-anchored-rejects", `^[a-z]+$`, "abc1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re := regexp.MustCompile(tt.pattern)
+			constraint := pvconstraints.NewCompiledRegexConstraint(re)
+
+			if constraint.Rule() != tt.pattern {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.pattern)
+			}
+
+			err := constraint.Validate(tt.testValue)
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error", tt.testValue)
+			}
+		})
+	}
+}
+
+func TestParameterArgsRegex(t *testing.T) {
+	param := pvtypes.NewParameter(pvtypes.ParameterArgs{
+		NameProps: pvtypes.NameSpecProps{
+			Name: "code",
+		},
+		Location: pvtypes.PathLocation,
+		DataType: pvtypes.StringType,
+		Regex:    regexp.MustCompile(`[[:upper:]]{2}\[[0-9]{2}\]`),
+	})
+
+	if param.Regexp() == nil {
+		t.Fatal("Regexp() returned nil for a parameter created with ParameterArgs.Regex")
+	}
+	if len(param.Constraints()) != 1 || param.Constraints()[0].Type() != pvtypes.RegexConstraintType {
+		t.Fatalf("Constraints() = %v, want a single regex constraint", param.Constraints())
+	}
+	if err := param.Validate("AB[12]"); err != nil {
+		t.Errorf("Validate(%q) expected valid but got error: %v", "AB[12]", err)
+	}
+	if err := param.Validate("AB12"); err == nil {
+		t.Errorf("Validate(%q) expected invalid but got no error", "AB12")
+	}
+}
+
+func TestRegexConstraintProgramSize(t *testing.T) {
+	words := make([]string, 3000)
+	for i := range words {
+		words[i] = fmt.Sprintf("k%dx", i*7919%100000)
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		wantErr bool
+	}{
+		{name: "normal", pattern: `[a-z0-9-]{3,64}`},
+		{name: "large-repetition", pattern: `\w{1,1000}`},
+		{name: "enormous-alternation", pattern: strings.Join(words, "|"), wantErr: true},
+		{name: "enormous-repetition", pattern: `[a-z]{1,1000}[0-9]{1,1000}[A-Z]{1,1000}[a-f]{1,1000}[g-z]{1,1000}[0-4]{1,1000}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pvconstraints.ParseRegexConstraint(tt.pattern)
+			if tt.wantErr {
+				if !errors.Is(err, pvconstraints.ErrRegexTooComplex) {
+					t.Errorf("ParseRegexConstraint() error = %v, want %v", err, pvconstraints.ErrRegexTooComplex)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ParseRegexConstraint() unexpected error: %v", err)
+			}
+		})
+	}
+
+	// The limit is configurable
+	saved := pvconstraints.MaxRegexProgramSize
+	defer func() { pvconstraints.MaxRegexProgramSize = saved }()
+	pvconstraints.MaxRegexProgramSize = 100
+	_, err := pvconstraints.ParseRegexConstraint(`[a-z0-9-]{3,64}`)
+	if !errors.Is(err, pvconstraints.ErrRegexTooComplex) {
+		t.Errorf("ParseRegexConstraint() with MaxRegexProgramSize=100 error = %v, want %v", err, pvconstraints.ErrRegexTooComplex)
+	}
+}
@@ -0,0 +1,124 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvtypes.RegisterConstraint(&ScaleConstraint{})
+}
+
+var _ pvtypes.Constraint = (*ScaleConstraint)(nil)
+
+// ScaleConstraint limits how many digits a decimal may have after the decimal
+// point, e.g. scale[2] for cents. Digits are counted in the raw string, so
+// "1.50" has a scale of 2 and scale[0] allows only whole numbers.
+type ScaleConstraint struct {
+	pvtypes.BaseConstraint
+	max int
+}
+
+func NewScaleConstraint(max int) *ScaleConstraint {
+	c := &ScaleConstraint{max: max}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *ScaleConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.DecimalType}
+}
+
+func (c *ScaleConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseScaleConstraint(value)
+}
+
+func (c *ScaleConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.ScaleConstraintType
+}
+
+func (c *ScaleConstraint) Rule() string {
+	return strconv.Itoa(c.max)
+}
+
+func (c *ScaleConstraint) Validate(value string) (err error) {
+	var fracPart string
+	var ok bool
+
+	_, fracPart, ok = splitFixedPoint(value)
+	if !ok {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrNotFixedPointDecimal,
+			"value", value,
+		)
+		goto end
+	}
+
+	if len(fracPart) > c.max {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrTooManyFractionalDigits,
+			"value", value,
+			"fractional_digits", len(fracPart),
+			"max_fractional_digits", c.max,
+		)
+	}
+
+end:
+	return err
+}
+
+func (c *ScaleConstraint) ErrorDetail(param *pvtypes.Parameter, value string) string {
+	_, fracPart, ok := splitFixedPoint(value)
+	if ok && len(fracPart) > c.max {
+		return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: value has %d digits after the decimal point but at most %d are allowed",
+			param.Name,
+			value,
+			len(fracPart),
+			c.max,
+		)
+	}
+	return c.BaseConstraint.ErrorDetail(param, value)
+}
+
+func (c *ScaleConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is a plain decimal with at most %d digits after the decimal point, for example: %s",
+		param.Name,
+		c.max,
+		example,
+	)
+}
+
+// Example returns a value using every fractional digit allowed, e.g. "1.23"
+// for scale[2] or "1" for scale[0].
+// The error parameter is currently unused but maintains interface consistency.
+func (c *ScaleConstraint) Example(err error) any {
+	if c.max == 0 {
+		return "1"
+	}
+	return "1." + strings.Repeat("23", (c.max+1)/2)[:c.max]
+}
+
+// ParseScaleConstraint parses the maximum number of fractional digits, which may be 0.
+func ParseScaleConstraint(maxSpec string) (constraint *ScaleConstraint, err error) {
+	var limit int
+
+	limit, err = parseDigitLimit(maxSpec, 0, ErrExpectedScaleFormat)
+	if err != nil {
+		goto end
+	}
+	constraint = NewScaleConstraint(limit)
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			ErrInvalidScaleConstraint,
+			"scale_spec", maxSpec,
+		)
+	}
+	return constraint, err
+}
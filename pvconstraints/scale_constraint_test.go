@@ -0,0 +1,98 @@
+package pvconstraints_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.ScaleConstraint)(nil)
+
+func TestScaleConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantRule string
+		wantErr  bool
+	}{
+		{"two", "2", "2", false},
+		{"zero", "0", "0", false},
+		{"negative", "-1", "", true},
+		{"empty", "", "", true},
+		{"word", "cents", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseScaleConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseScaleConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseScaleConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.ScaleConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.ScaleConstraintType)
+			}
+
+			if constraint.Rule() != tt.wantRule {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestScaleConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		max       int
+		testValue string
+		wantValid bool
+	}{
+		{"at-limit", 2, "123456.78", true},
+		{"below-limit", 2, "12.5", true},
+		{"whole-number", 2, "12", true},
+		{"trailing-zeros-count", 2, "1.500", false},
+		{"over-limit", 2, "12.345", false},
+		{"zero-scale-whole", 0, "42", true},
+		{"zero-scale-fraction", 0, "42.0", false},
+		{"exponent", 2, "1e-9", false},
+		{"nan", 2, "NaN", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint := pvconstraints.NewScaleConstraint(tt.max)
+
+			err := constraint.Validate(tt.testValue)
+
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error", tt.testValue)
+			}
+		})
+	}
+}
+
+func TestScaleConstraintExample(t *testing.T) {
+	for _, scale := range []int{0, 1, 2, 3} {
+		constraint := pvconstraints.NewScaleConstraint(scale)
+		example := fmt.Sprint(constraint.Example(nil))
+		if err := constraint.Validate(example); err != nil {
+			t.Errorf("scale[%d] Example() %q does not validate: %v", scale, example, err)
+		}
+	}
+}
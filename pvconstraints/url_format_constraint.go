@@ -0,0 +1,302 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// URL format names supported by format[...] on strings
+const (
+	URLFormat      = "url"
+	HTTPSURLFormat = "httpsurl"
+)
+
+// Note: URLFormatConstraint is not registered directly.
+// It's handled by DateFormatConstraint.Parse() for the string data type.
+
+var _ pvtypes.Constraint = (*URLFormatConstraint)(nil)
+
+// URLFormatConstraint validates that a string is a parseable URL, optionally
+// restricted to an allow-list of schemes and/or hosts.
+//
+// Supported specs:
+//   - format[url] accepts absolute URLs and relative references
+//   - format[httpsurl] accepts only absolute https URLs
+//   - format[url:host=example.com|*.example.com] restricts hosts
+//   - format[url:scheme=http|https] restricts schemes
+//
+// Options are separated by ';' and alternatives by '|'. Scheme-relative
+// references such as "//evil.com" are always rejected because browsers treat
+// them as absolute URLs, which makes them a common open-redirect vector.
+type URLFormatConstraint struct {
+	pvtypes.BaseConstraint
+	format  string
+	options string
+	schemes []string
+	hosts   []string
+}
+
+func NewURLFormatConstraint(format string, schemes, hosts []string) *URLFormatConstraint {
+	c := &URLFormatConstraint{
+		format:  format,
+		schemes: schemes,
+		hosts:   hosts,
+	}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *URLFormatConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.StringType}
+}
+
+func (c *URLFormatConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.FormatConstraintType
+}
+
+func (c *URLFormatConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseURLFormatConstraint(value)
+}
+
+func (c *URLFormatConstraint) Rule() string {
+	if c.options == "" {
+		return c.format
+	}
+	return c.format + ":" + c.options
+}
+
+// restricted reports whether only absolute URLs are acceptable.
+func (c *URLFormatConstraint) restricted() bool {
+	return c.format == HTTPSURLFormat || len(c.schemes) != 0 || len(c.hosts) != 0
+}
+
+// allowedSchemes returns the schemes an absolute URL may use, or nil for any.
+// A host allow-list without an explicit scheme list implies http and https so
+// that e.g. "javascript:" cannot slip through a host-restricted constraint.
+func (c *URLFormatConstraint) allowedSchemes() []string {
+	switch {
+	case c.format == HTTPSURLFormat:
+		return []string{"https"}
+	case len(c.schemes) != 0:
+		return c.schemes
+	case len(c.hosts) != 0:
+		return []string{"http", "https"}
+	}
+	return nil
+}
+
+func (c *URLFormatConstraint) Validate(value string) (err error) {
+	var u *url.URL
+	var schemes []string
+
+	if isSchemeRelative(value) {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrSchemeRelativeURLNotAllowed,
+			"value", value,
+		)
+		goto end
+	}
+
+	u, err = url.Parse(value)
+	if err != nil {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrInvalidURLFormat,
+			"value", value,
+			err,
+		)
+		goto end
+	}
+
+	if !c.restricted() {
+		goto end
+	}
+
+	if !u.IsAbs() || u.Host == "" {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrAbsoluteURLRequired,
+			"value", value,
+		)
+		goto end
+	}
+
+	schemes = c.allowedSchemes()
+	if len(schemes) != 0 && !containsFold(schemes, u.Scheme) {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrURLSchemeNotAllowed,
+			"value", value,
+			"scheme", u.Scheme,
+			"allowed_schemes", strings.Join(schemes, "|"),
+		)
+		goto end
+	}
+
+	if len(c.hosts) != 0 && !hostAllowed(c.hosts, u.Hostname()) {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrURLHostNotAllowed,
+			"value", value,
+			"host", u.Hostname(),
+			"allowed_hosts", strings.Join(c.hosts, "|"),
+		)
+		goto end
+	}
+
+end:
+	return err
+}
+
+func (c *URLFormatConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	if len(c.hosts) != 0 {
+		return fmt.Sprintf("Ensure parameter '%s' is an absolute URL on one of these hosts: %s, for example: %s",
+			param.Name,
+			strings.Join(c.hosts, ", "),
+			example,
+		)
+	}
+	if c.restricted() {
+		return fmt.Sprintf("Ensure parameter '%s' is an absolute %s URL, for example: %s",
+			param.Name,
+			strings.Join(c.allowedSchemes(), " or "),
+			example,
+		)
+	}
+	return fmt.Sprintf("Ensure parameter '%s' is a valid URL, for example: %s", param.Name, example)
+}
+
+// Example returns a URL that satisfies the constraint's scheme and host lists.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *URLFormatConstraint) Example(err error) any {
+	scheme := "https"
+	host := "example.com"
+	schemes := c.allowedSchemes()
+	if len(schemes) != 0 && !containsFold(schemes, scheme) {
+		scheme = schemes[0]
+	}
+	if len(c.hosts) != 0 {
+		host = strings.TrimPrefix(c.hosts[0], "*.")
+		if strings.HasPrefix(c.hosts[0], "*.") {
+			host = "www." + host
+		}
+	}
+	return fmt.Sprintf("%s://%s/callback", scheme, host)
+}
+
+// ParseURLFormatConstraint parses url and httpsurl format specifications,
+// e.g. "url", "httpsurl" or "url:host=example.com|*.example.com;scheme=https".
+func ParseURLFormatConstraint(spec string) (constraint *URLFormatConstraint, err error) {
+	var format, options string
+	var schemes, hosts []string
+
+	format, options, _ = strings.Cut(spec, ":")
+	format = strings.ToLower(strings.TrimSpace(format))
+	options = strings.TrimSpace(options)
+
+	switch format {
+	case URLFormat, HTTPSURLFormat:
+	default:
+		err = pvtypes.NewErr(
+			ErrUnsupportedURLFormat,
+			"format", format,
+		)
+		goto end
+	}
+
+	for _, option := range strings.Split(options, ";") {
+		var key, value string
+		var found bool
+
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+		key, value, found = strings.Cut(option, "=")
+		if !found || strings.TrimSpace(value) == "" {
+			err = pvtypes.NewErr(
+				ErrInvalidURLFormatOption,
+				"option", option,
+			)
+			goto end
+		}
+		values := splitAlternatives(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "host":
+			hosts = append(hosts, values...)
+		case "scheme":
+			schemes = append(schemes, values...)
+		default:
+			err = pvtypes.NewErr(
+				ErrInvalidURLFormatOption,
+				"option", option,
+				"key", key,
+			)
+			goto end
+		}
+	}
+
+	constraint = NewURLFormatConstraint(format, schemes, hosts)
+	constraint.options = options
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			ErrInvalidURLFormatConstraint,
+			"url_format_spec", spec,
+		)
+	}
+	return constraint, err
+}
+
+// splitAlternatives splits a '|'-separated option value into lowercased, trimmed parts.
+func splitAlternatives(value string) (parts []string) {
+	for _, part := range strings.Split(value, "|") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// isSchemeRelative reports whether value begins with two slashes, counting
+// backslashes as slashes since browsers normalize "/\evil.com" to "//evil.com".
+func isSchemeRelative(value string) bool {
+	value = strings.TrimLeft(value, " \t")
+	if len(value) < 2 {
+		return false
+	}
+	return (value[0] == '/' || value[0] == '\\') && (value[1] == '/' || value[1] == '\\')
+}
+
+// containsFold reports whether list contains s, ignoring case.
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostAllowed reports whether host matches an entry in hosts, where an entry
+// of the form "*.example.com" matches any subdomain of example.com.
+func hostAllowed(hosts []string, host string) bool {
+	host = strings.ToLower(host)
+	for _, allowed := range hosts {
+		suffix, wildcard := strings.CutPrefix(allowed, "*.")
+		switch {
+		case wildcard && strings.HasSuffix(host, "."+suffix):
+			return true
+		case !wildcard && host == allowed:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.URLFormatConstraint)(nil)
+
+func TestURLFormatConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{"url", "url", false},
+		{"httpsurl", "httpsurl", false},
+		{"uppercase", "URL", false},
+		{"host-allow-list", "url:host=example.com", false},
+		{"multiple-hosts", "url:host=example.com|*.example.org", false},
+		{"host-and-scheme", "url:host=example.com;scheme=https", false},
+
+		{"unknown-format", "uri", true},
+		{"empty-option-value", "url:host=", true},
+		{"option-without-value", "url:host", true},
+		{"unknown-option", "url:port=443", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseURLFormatConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseURLFormatConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseURLFormatConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.FormatConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.FormatConstraintType)
+			}
+		})
+	}
+}
+
+func TestURLFormatConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		value   string
+		wantErr bool
+	}{
+		{"url-absolute", "url", "https://example.com/cb", false},
+		{"url-relative-path", "url", "/dashboard?tab=1", false},
+		{"url-scheme-relative", "url", "//evil.com", true},
+		{"url-backslash-scheme-relative", "url", "/\\evil.com", true},
+		{"url-unparseable", "url", "http://[::1", true},
+
+		{"httpsurl-valid", "httpsurl", "https://example.com/cb", false},
+		{"httpsurl-http", "httpsurl", "http://example.com/cb", true},
+		{"httpsurl-javascript", "httpsurl", "javascript:alert(1)", true},
+		{"httpsurl-scheme-relative", "httpsurl", "//evil.com", true},
+		{"httpsurl-relative", "httpsurl", "/cb", true},
+
+		{"host-exact", "url:host=example.com", "https://example.com/cb", false},
+		{"host-mismatch", "url:host=example.com", "https://evil.com/cb", true},
+		{"host-suffix-trick", "url:host=example.com", "https://example.com.evil.com/cb", true},
+		{"host-javascript", "url:host=example.com", "javascript:alert(1)", true},
+		{"host-scheme-relative", "url:host=example.com", "//example.com", true},
+		{"host-wildcard", "url:host=*.example.com", "https://api.example.com/cb", false},
+		{"host-wildcard-apex", "url:host=*.example.com", "https://example.com/cb", true},
+		{"host-case-insensitive", "url:host=example.com", "https://EXAMPLE.com/cb", false},
+		{"scheme-allow-list", "url:scheme=https", "http://example.com/cb", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseURLFormatConstraint(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseURLFormatConstraint(%q) unexpected error: %v", tt.spec, err)
+			}
+
+			err = constraint.Validate(tt.value)
+			if tt.wantErr && err == nil {
+				t.Errorf("Validate(%q) expected error but got none", tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate(%q) unexpected error: %v", tt.value, err)
+			}
+		})
+	}
+}
+
+func TestURLFormatConstraintExample(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"url", "https://example.com/callback"},
+		{"httpsurl", "https://example.com/callback"},
+		{"url:host=auth.example.com", "https://auth.example.com/callback"},
+		{"url:host=*.example.com", "https://www.example.com/callback"},
+		{"url:scheme=http", "http://example.com/callback"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseURLFormatConstraint(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseURLFormatConstraint(%q) unexpected error: %v", tt.spec, err)
+			}
+			example := constraint.Example(nil)
+			if example != tt.want {
+				t.Errorf("Example() = %v, want %v", example, tt.want)
+			}
+			err = constraint.Validate(example.(string))
+			if err != nil {
+				t.Errorf("Example() %v does not satisfy its own constraint: %v", example, err)
+			}
+		})
+	}
+}
@@ -24,6 +24,23 @@ var (
 	snowflakeRegex = regexp.MustCompile(`^[0-9]{1,19}$`)
 )
 
+// IDFormat is the format[id] meta-format, accepting any of the ID schemes in
+// idFormats; DetectIDFormat() reports which one a value uses.
+const IDFormat = "id"
+
+// idFormats lists the schemes format[id] accepts, in detection order. Their
+// lengths differ, so at most one matches any value.
+var idFormats = []struct {
+	format    string
+	validator func(string) error
+}{
+	{"uuid", validateUUIDGeneric},
+	{"ulid", validateULID},
+	{"ksuid", validateKSUID},
+	{"nanoid", validateNanoID},
+	{"cuid", validateCUID},
+}
+
 // Snowflake ID constants
 const (
 	// DefaultSnowflakeEpoch is Twitter's custom epoch: 2010-11-04 01:42:54.657 UTC
@@ -113,6 +130,8 @@ func (c *UUIDFormatConstraint) Example(err error) any {
 		return "V1StGXR8_Z5jdHi6B-myT" // 21-char URL-safe example
 	case "cuid":
 		return "ckf0f9e5x0000q3yz4dq7a1qf" // From paralleldrive/cuid repo (2023-06-29 18:45:00 UTC)
+	case IDFormat:
+		return "01ARZ3NDEKTSV4RRFFQ69G5FAV" // Same as ULID; any ID scheme is accepted
 	case "snowflake":
 		return "1888944671579078978" // Wikipedia example (@Wikipedia tweet, 2025-02-10 13:34:39.256 UTC)
 	default:
@@ -120,6 +139,16 @@ func (c *UUIDFormatConstraint) Example(err error) any {
 	}
 }
 
+// IsStandardUUID reports whether the constraint validates RFC 9562 UUIDs, as
+// opposed to alternative ID formats like ULID or KSUID that have no version.
+func (c *UUIDFormatConstraint) IsStandardUUID() bool {
+	switch c.format {
+	case "ulid", "ksuid", "nanoid", "cuid", "snowflake", IDFormat:
+		return false
+	}
+	return true
+}
+
 func (c *UUIDFormatConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
 	return ParseUUIDFormatConstraint(value)
 }
@@ -176,6 +205,8 @@ func ParseUUIDFormatConstraint(spec string) (constraint *UUIDFormatConstraint, e
 		validator = validateNanoID
 	case "cuid":
 		validator = validateCUID
+	case IDFormat:
+		validator = validateAnyID
 	case "snowflake":
 		// Snowflake with optional epoch parameter
 		validator = createSnowflakeValidator(formatParam)
@@ -302,6 +333,12 @@ func validateSpecificUUIDVersion(value string, expectedVersion int) error {
 	return nil
 }
 
+// UUIDVersion returns the version (1-8) of a standard RFC 9562 UUID string,
+// or an error if value is not one.
+func UUIDVersion(value string) (version int, err error) {
+	return parseStandardUUID(value)
+}
+
 // parseStandardUUID parses and validates a standard UUID, returning the version
 func parseStandardUUID(value string) (version int, err error) {
 	var b [16]byte
@@ -415,6 +452,32 @@ func validateCUID(value string) error {
 	return nil
 }
 
+// DetectIDFormat returns which of the schemes accepted by format[id] value
+// uses: "uuid", "ulid", "ksuid", "nanoid" or "cuid". It fails with
+// ErrUnrecognizedIDFormat if value uses none of them.
+func DetectIDFormat(value string) (format string, err error) {
+	for _, f := range idFormats {
+		if f.validator(value) == nil {
+			format = f.format
+			goto end
+		}
+	}
+	err = pvtypes.NewErr(
+		ErrParameterValidationFailed,
+		ErrUnrecognizedIDFormat,
+		"value", value,
+		"formats", "uuid, ulid, ksuid, nanoid or cuid",
+	)
+end:
+	return format, err
+}
+
+// validateAnyID validates that value uses one of the format[id] schemes
+func validateAnyID(value string) error {
+	_, err := DetectIDFormat(value)
+	return err
+}
+
 // createSnowflakeValidator creates a Snowflake validator with optional custom epoch
 func createSnowflakeValidator(epochParam string) func(string) error {
 	// Parse custom epoch if provided, otherwise use Twitter's default
@@ -1,6 +1,7 @@
 package pvconstraints
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/mikeschinkel/go-pathvars/pvtypes"
@@ -31,6 +32,7 @@ func TestUUIDFormatConstraintParsing(t *testing.T) {
 		{"ulid-format", "ulid", false, "ULID format"},
 		{"ksuid-format", "ksuid", false, "KSUID format"},
 		{"nanoid-format", "nanoid", false, "NanoID format"},
+		{"id-format", "id", false, "Any ID scheme"},
 
 		// Case insensitive
 		{"v4-uppercase", "V4", false, "Uppercase V4"},
@@ -139,6 +141,14 @@ func TestUUIDFormatValidation(t *testing.T) {
 		{"nanoid-invalid-chars", "nanoid", "V1StGXR8@Z5jdHi6B-myT", false, "NanoID with invalid chars"},
 		{"nanoid-uuid", "nanoid", "550e8400-e29b-41d4-a716-446655440000", false, "UUID when expecting NanoID"},
 
+		// Any ID scheme tests
+		{"id-uuid", "id", "550e8400-e29b-41d4-a716-446655440000", true, "UUID accepted as an ID"},
+		{"id-ulid", "id", "01ARZ3NDEKTSV4RRFFQ69G5FAV", true, "ULID accepted as an ID"},
+		{"id-ksuid", "id", "0ujsswThIGTUYm2K8FjOOfXtY1K", true, "KSUID accepted as an ID"},
+		{"id-nanoid", "id", "V1StGXR8_Z5jdHi6B-myT", true, "NanoID accepted as an ID"},
+		{"id-cuid", "id", "ckf0f9e5x0000q3yz4dq7a1qf", true, "CUID accepted as an ID"},
+		{"id-short", "id", "abc123", false, "Short string matches no ID scheme"},
+
 		// Edge cases
 		{"empty-value", "v4", "", false, "Empty value"},
 		{"whitespace", "v4", "   ", false, "Whitespace value"},
@@ -343,3 +353,37 @@ func TestUUIDFormatConstraint_EasterEggExamples(t *testing.T) {
 		})
 	}
 }
+
+func TestDetectIDFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		wantFormat string
+		wantErr    bool
+	}{
+		{"uuid", "550e8400-e29b-41d4-a716-446655440000", "uuid", false},
+		{"ulid", "01ARZ3NDEKTSV4RRFFQ69G5FAV", "ulid", false},
+		{"ksuid", "0ujsswThIGTUYm2K8FjOOfXtY1K", "ksuid", false},
+		{"nanoid", "V1StGXR8_Z5jdHi6B-myT", "nanoid", false},
+		{"cuid", "ckf0f9e5x0000q3yz4dq7a1qf", "cuid", false},
+		{"short-string", "abc123", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := DetectIDFormat(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnrecognizedIDFormat) {
+					t.Errorf("DetectIDFormat(%q) error = %v, want ErrUnrecognizedIDFormat", tt.value, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DetectIDFormat(%q) unexpected error: %v", tt.value, err)
+			}
+			if format != tt.wantFormat {
+				t.Errorf("DetectIDFormat(%q) = %q, want %q", tt.value, format, tt.wantFormat)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package pvconstraints
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func init() {
+	pvtypes.RegisterConstraint(&WordsConstraint{})
+}
+
+var _ pvtypes.Constraint = (*WordsConstraint)(nil)
+
+// WordsConstraint limits how many hyphen-separated words a slug may have,
+// e.g. words[5] to keep SEO-friendly URLs short. The slug type itself still
+// enforces the lowercase-hyphenated format, and length[...] can be combined
+// with it to bound the characters as well.
+type WordsConstraint struct {
+	pvtypes.BaseConstraint
+	max int
+}
+
+func NewWordsConstraint(max int) *WordsConstraint {
+	c := &WordsConstraint{max: max}
+	c.BaseConstraint = pvtypes.NewBaseConstraint(c)
+	return c
+}
+
+func (c *WordsConstraint) ValidDataTypes() []pvtypes.PVDataType {
+	return []pvtypes.PVDataType{pvtypes.SlugType}
+}
+
+func (c *WordsConstraint) Parse(value string, dataType pvtypes.PVDataType) (pvtypes.Constraint, error) {
+	return ParseWordsConstraint(value)
+}
+
+func (c *WordsConstraint) Type() pvtypes.ConstraintType {
+	return pvtypes.WordsConstraintType
+}
+
+func (c *WordsConstraint) Rule() string {
+	return strconv.Itoa(c.max)
+}
+
+func (c *WordsConstraint) Validate(value string) (err error) {
+	words := countWords(value)
+	if words > c.max {
+		err = pvtypes.NewErr(
+			ErrParameterValidationFailed,
+			ErrTooManyWords,
+			"value", value,
+			"words", words,
+			"max_words", c.max,
+		)
+	}
+	return err
+}
+
+func (c *WordsConstraint) ErrorDetail(param *pvtypes.Parameter, value string) string {
+	words := countWords(value)
+	if words > c.max {
+		return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: slug has %d words but at most %d are allowed",
+			param.Name,
+			value,
+			words,
+			c.max,
+		)
+	}
+	return c.BaseConstraint.ErrorDetail(param, value)
+}
+
+func (c *WordsConstraint) ErrorSuggestion(param *pvtypes.Parameter, value, example string) string {
+	return fmt.Sprintf("Ensure parameter '%s' is a slug of at most %d hyphen-separated words, for example: %s",
+		param.Name,
+		c.max,
+		example,
+	)
+}
+
+// Example returns a slug of at most two words that fits the limit.
+// The error parameter is currently unused but maintains interface consistency.
+func (c *WordsConstraint) Example(err error) any {
+	if c.max == 1 {
+		return "hello"
+	}
+	return "hello-world"
+}
+
+// ParseWordsConstraint parses the maximum number of words, which must be at least 1.
+func ParseWordsConstraint(maxSpec string) (constraint *WordsConstraint, err error) {
+	var limit int
+
+	limit, err = strconv.Atoi(strings.TrimSpace(maxSpec))
+	if err != nil {
+		err = pvtypes.NewErr(
+			ErrExpectedWordsFormat,
+			err,
+		)
+		goto end
+	}
+	if limit < 1 {
+		err = pvtypes.NewErr(
+			ErrExpectedWordsFormat,
+			"limit", limit,
+		)
+		goto end
+	}
+	constraint = NewWordsConstraint(limit)
+
+end:
+	if err != nil {
+		err = pvtypes.WithErr(err,
+			ErrInvalidWordsConstraint,
+			"words_spec", maxSpec,
+		)
+	}
+	return constraint, err
+}
+
+// countWords returns the number of hyphen-separated words in value.
+func countWords(value string) int {
+	return strings.Count(value, "-") + 1
+}
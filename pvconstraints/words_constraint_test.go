@@ -0,0 +1,100 @@
+package pvconstraints_test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars/pvconstraints"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+
+	_ "github.com/mikeschinkel/go-pathvars/dtclassifiers"
+)
+
+var _ pvtypes.Constraint = (*pvconstraints.WordsConstraint)(nil)
+
+func TestWordsConstraintParsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantRule string
+		wantErr  bool
+	}{
+		{"five", "5", "5", false},
+		{"one", "1", "1", false},
+		{"padded", " 3 ", "3", false},
+		{"zero", "0", "", true},
+		{"negative", "-2", "", true},
+		{"empty", "", "", true},
+		{"range", "1..5", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint, err := pvconstraints.ParseWordsConstraint(tt.spec)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseWordsConstraint() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseWordsConstraint() unexpected error: %v", err)
+			}
+
+			if constraint.Type() != pvtypes.WordsConstraintType {
+				t.Errorf("Type() = %v, want %v", constraint.Type(), pvtypes.WordsConstraintType)
+			}
+
+			if constraint.Rule() != tt.wantRule {
+				t.Errorf("Rule() = %q, want %q", constraint.Rule(), tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestWordsConstraintValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		max       int
+		testValue string
+		wantValid bool
+	}{
+		{"single-word", 5, "hello", true},
+		{"below-limit", 5, "how-to-write-go", true},
+		{"at-limit", 5, "how-to-write-go-code", true},
+		{"over-limit", 5, "how-to-write-idiomatic-go-code", false},
+		{"one-allowed", 1, "hello", true},
+		{"one-exceeded", 1, "hello-world", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constraint := pvconstraints.NewWordsConstraint(tt.max)
+
+			err := constraint.Validate(tt.testValue)
+
+			if tt.wantValid && err != nil {
+				t.Errorf("Validate(%q) expected valid but got error: %v", tt.testValue, err)
+			}
+
+			if !tt.wantValid && err == nil {
+				t.Errorf("Validate(%q) expected invalid but got no error", tt.testValue)
+			}
+		})
+	}
+}
+
+func TestWordsConstraintExample(t *testing.T) {
+	for _, limit := range []int{1, 2, 5} {
+		constraint := pvconstraints.NewWordsConstraint(limit)
+		example, ok := constraint.Example(nil).(string)
+		if !ok {
+			t.Fatalf("Example() returned %T, want string", constraint.Example(nil))
+		}
+		err := constraint.Validate(example)
+		if err != nil {
+			t.Errorf("words[%d] Example() %q does not validate: %v", limit, example, err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package pvtypes
+
+import (
+	"context"
+	"sync"
+)
+
+// AsyncValidator validates a parameter value that needs a lookup, such as
+// whether a tenant slug exists, after the value has passed its type and
+// constraint validation. It should honor ctx, which carries the request's
+// cancellation and any ParameterArgs.AsyncTimeout. A non-nil error rejects the
+// value as a client fault unless it is a context error or reports
+// ServerFaultSource, as a *ParameterError or through "fault_source" metadata,
+// to say the lookup itself failed, e.g. because a database is down. Neither is
+// cached.
+type AsyncValidator func(ctx context.Context, value string) error
+
+// ValidationCache remembers AsyncValidator results by value so repeated
+// requests for the same value skip the lookup. A nil err records a valid
+// value. Implementations must be safe for concurrent use.
+type ValidationCache interface {
+	Get(value string) (err error, found bool)
+	Set(value string, err error)
+}
+
+var _ ValidationCache = (*MemoryValidationCache)(nil)
+
+// MemoryValidationCache is an in-memory ValidationCache holding at most a
+// fixed number of values. When full it is cleared rather than tracking usage,
+// which bounds memory when clients send many distinct values.
+type MemoryValidationCache struct {
+	mu         sync.RWMutex
+	results    map[string]error
+	maxEntries int
+}
+
+// NewMemoryValidationCache returns a MemoryValidationCache holding at most
+// maxEntries values, or 1024 when maxEntries is not positive.
+func NewMemoryValidationCache(maxEntries int) *MemoryValidationCache {
+	if maxEntries <= 0 {
+		maxEntries = 1024
+	}
+	return &MemoryValidationCache{
+		results:    make(map[string]error),
+		maxEntries: maxEntries,
+	}
+}
+
+func (c *MemoryValidationCache) Get(value string) (err error, found bool) {
+	c.mu.RLock()
+	err, found = c.results[value]
+	c.mu.RUnlock()
+	return err, found
+}
+
+func (c *MemoryValidationCache) Set(value string, err error) {
+	c.mu.Lock()
+	if len(c.results) >= c.maxEntries {
+		clear(c.results)
+	}
+	c.results[value] = err
+	c.mu.Unlock()
+}
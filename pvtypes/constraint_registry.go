@@ -2,6 +2,8 @@ package pvtypes
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 )
 
@@ -10,38 +12,64 @@ type ConstraintsMap map[ConstraintMapKey]Constraint
 
 var constraintsMap = make(ConstraintsMap)
 
+// DataTypeAliasMap maps each alias slug to the canonical slug of its data
+// type, e.g. "int" to "integer".
 type DataTypeAliasMap = map[PVDataTypeSlug]PVDataTypeSlug
 
 var dataTypeAliasMap = make(DataTypeAliasMap)
 
-func RegisterDataTypeAlias(dataType PVDataType, alias PVDataTypeSlug) {
-	// Make sure data types and constraints have their init() funcs run
-	dtn := dataType.Slug()
-	dataTypeAliasMap[dtn] = alias
-	// Also register the alias in dataTypeMap so FindDataType can find it
-	dataTypeMap[alias] = dataType
+// RegisterDataTypeAlias registers alias as an alternate slug for the data type
+// named by canonical, e.g. "num" for "int", so ParsePVDataType() and templates
+// such as {v:num} resolve it. canonical may itself be an alias. It fails if
+// alias is not an identifier, canonical is unknown, or alias already names a
+// data type or alias.
+func RegisterDataTypeAlias(alias, canonical PVDataTypeSlug) (err error) {
+	var dt, existing PVDataType
 
-	// Now let's check to see if constraints we are aliasing have already been registered.
-	// If they have, let's alias them as well.
-	c4dt := make([]Constraint, 0)
-	for key, c := range constraintsMap {
-		dt, a, found := strings.Cut(string(key), "_")
-		if !found {
-			continue
-		}
-		if PVDataTypeSlug(a) == alias {
-			goto end
-		}
-		if PVDataTypeSlug(dt) == dtn {
-			c4dt = append(c4dt, c)
-		}
+	alias = PVDataTypeSlug(strings.ToLower(string(alias)))
+	_, err = ParseIdentifier(string(alias))
+	if err != nil {
+		err = NewErr(ErrInvalidDataTypeAlias, "alias", alias, "data_type", canonical, err)
+		goto end
 	}
-	// Alias constrains that were previously registered
-	for _, c := range c4dt {
-		constraintsMap[GetConstraintMapKey(c.Type(), alias)] = c
+	dt = FindDataType(canonical)
+	if dt == UnspecifiedDataType {
+		err = NewErr(
+			ErrInvalidDataTypeAlias,
+			ErrUnsupportedDataType,
+			"alias", alias,
+			"data_type", canonical,
+		)
+		goto end
+	}
+	existing = FindDataType(alias)
+	if existing != UnspecifiedDataType {
+		err = NewErr(
+			ErrInvalidDataTypeAlias,
+			ErrDataTypeAliasExists,
+			"alias", alias,
+			"data_type", canonical,
+			"existing_data_type", existing.Slug(),
+		)
+		goto end
+	}
+
+	dataTypeAliasMap[alias] = dt.Slug()
+	dataTypeMap[alias] = dt
+
+	// Constraints registered after this are aliased by RegisterConstraint()
+	for _, c := range slices.Collect(maps.Values(constraintsMap)) {
+		if slices.Contains(c.ValidDataTypes(), dt) {
+			constraintsMap[c.MapKey(alias)] = c
+		}
 	}
 end:
-	return
+	return err
+}
+
+// GetDataTypeAliasMap returns a copy of the registered data type aliases.
+func GetDataTypeAliasMap() DataTypeAliasMap {
+	return maps.Clone(dataTypeAliasMap)
 }
 
 func RegisterConstraint(c Constraint) {
@@ -49,9 +77,10 @@ func RegisterConstraint(c Constraint) {
 	for _, dt := range c.ValidDataTypes() {
 		name := dt.Slug()
 		constraintsMap[c.MapKey(name)] = c
-		alias, ok := dataTypeAliasMap[name]
-		if ok {
-			constraintsMap[c.MapKey(alias)] = c
+		for alias, canonical := range dataTypeAliasMap {
+			if canonical == name {
+				constraintsMap[c.MapKey(alias)] = c
+			}
 		}
 	}
 }
@@ -5,6 +5,7 @@ package pvtypes
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 )
 
@@ -22,6 +23,9 @@ const (
 	// LengthConstraintType validates that string parameter values fall within specified length ranges.
 	LengthConstraintType ConstraintType = "length"
 
+	// ByteLengthConstraintType validates that string parameter values fall within specified UTF-8 byte length ranges.
+	ByteLengthConstraintType ConstraintType = "bytelength"
+
 	// NotEmptyConstraintType validates that parameter values are not empty strings.
 	NotEmptyConstraintType ConstraintType = "notempty"
 
@@ -30,8 +34,44 @@ const (
 
 	// RegexConstraintType validates parameter values against regular expression patterns.
 	RegexConstraintType ConstraintType = "regex"
+
+	// MultipleOfConstraintType validates that numeric parameter values are an exact multiple of a step.
+	MultipleOfConstraintType ConstraintType = "multipleof"
+
+	// LuhnConstraintType validates that digit strings carry a valid Luhn check digit, e.g. card numbers and IMEIs.
+	LuhnConstraintType ConstraintType = "luhn"
+
+	// CaseConstraintType validates that string parameter values are entirely lowercase or uppercase.
+	CaseConstraintType ConstraintType = "case"
+
+	// DecodedLengthConstraintType validates the byte length of base64url parameter values after decoding.
+	DecodedLengthConstraintType ConstraintType = "decodedlen"
+
+	// IntDigitsConstraintType limits the digits before the decimal point of decimal parameter values.
+	IntDigitsConstraintType ConstraintType = "intdigits"
+
+	// ScaleConstraintType limits the digits after the decimal point of decimal parameter values.
+	ScaleConstraintType ConstraintType = "scale"
+
+	// WordsConstraintType limits the number of hyphen-separated words in slug parameter values.
+	WordsConstraintType ConstraintType = "words"
 )
 
+// RegexpConstraint is implemented by the registered regex constraint so that
+// parameters can be given a pre-compiled *regexp.Regexp via ParameterArgs.Regex.
+type RegexpConstraint interface {
+	Constraint
+	WithRegexp(re *regexp.Regexp) Constraint
+}
+
+// CanonicalConstraint is implemented by constraints that accept more than one
+// spelling of a value, such as an integer enum accepting "02" for member "2",
+// so the spelling from the template can be stored in place of the input.
+type CanonicalConstraint interface {
+	Constraint
+	Canonical(value string) (canonical string, ok bool)
+}
+
 type Constraints []Constraint
 
 func (c Constraints) String() (s string) {
@@ -107,6 +147,7 @@ type Constraint interface {
 //   - enum[val1,val2,val3]
 //   - For dates: format[iso8601], format[yyyy-mm-dd], etc.
 //   - Multiple constraints: regex[^[0-9]+$],length[3..10]
+//   - Negated constraints: !regex[[0-9]+], !enum[admin,root]
 func ParseConstraints(spec string, dataType PVDataType) (constraints []Constraint, err error) {
 	var ctm ConstraintsMap
 	var ct ConstraintType
@@ -117,6 +158,7 @@ func ParseConstraints(spec string, dataType PVDataType) (constraints []Constrain
 	var mode byte
 	var ok bool
 	var regexStart, regexEnd int
+	var negate bool
 
 	typeName := dataType.Slug()
 	const (
@@ -146,6 +188,12 @@ func ParseConstraints(spec string, dataType PVDataType) (constraints []Constrain
 		//goland:noinspection GoDfaConstantCondition
 		switch mode {
 		case typeMode:
+			if ch == NegationPrefix && pos-1 == constraintStart {
+				// Leading '!' inverts the constraint that follows
+				negate = true
+				constraintStart = pos
+				continue
+			}
 			if ch == '[' {
 				// Found start of constraint value
 				valueStart = pos
@@ -197,7 +245,7 @@ func ParseConstraints(spec string, dataType PVDataType) (constraints []Constrain
 						),
 					)
 				} else {
-					constraints = append(constraints, constraint)
+					constraints = append(constraints, negateIf(constraint, negate))
 				}
 
 				if ch == ',' {
@@ -206,6 +254,7 @@ func ParseConstraints(spec string, dataType PVDataType) (constraints []Constrain
 						pos++
 					}
 					constraintStart = pos
+					negate = false
 					continue
 				}
 				break
@@ -244,7 +293,7 @@ func ParseConstraints(spec string, dataType PVDataType) (constraints []Constrain
 						),
 					)
 				} else {
-					constraints = append(constraints, constraint)
+					constraints = append(constraints, negateIf(constraint, negate))
 				}
 				mode = typeMode
 				// Skip past any whitespace and comma to next constraint
@@ -257,6 +306,7 @@ func ParseConstraints(spec string, dataType PVDataType) (constraints []Constrain
 						pos++
 					}
 					constraintStart = pos
+					negate = false
 				}
 				continue
 			}
@@ -295,7 +345,7 @@ func ParseConstraints(spec string, dataType PVDataType) (constraints []Constrain
 							),
 						)
 					} else {
-						constraints = append(constraints, constraint)
+						constraints = append(constraints, negateIf(constraint, negate))
 					}
 					mode = typeMode
 					// Skip past any whitespace and comma to next constraint
@@ -308,6 +358,7 @@ func ParseConstraints(spec string, dataType PVDataType) (constraints []Constrain
 							pos++
 						}
 						constraintStart = pos
+						negate = false
 					}
 					continue
 				}
@@ -14,9 +14,16 @@ type DataTypeClassifier interface {
 	DefaultValue() *string
 }
 
+// DataTypeErrorSuggester is optionally implemented by a DataTypeClassifier to
+// replace the generic type error suggestion with a type-specific one.
+type DataTypeErrorSuggester interface {
+	ErrorSuggestion(param *Parameter, value, example string) string
+}
+
 type BaseDataTypeClassifier struct {
-	owner        DataTypeClassifier
-	MultiSegment bool
+	owner          DataTypeClassifier
+	MultiSegment   bool
+	AllowNonFinite bool
 }
 
 func (c BaseDataTypeClassifier) IndefiniteArticle() string {
@@ -32,6 +39,9 @@ func (c BaseDataTypeClassifier) DefaultValue() *string {
 
 type DataTypeClassifierArgs struct {
 	MultiSegment bool
+
+	// AllowNonFinite lets numeric classifiers accept NaN, Inf and -Inf.
+	AllowNonFinite bool
 }
 
 func NewBaseDataTypeClassifier(owner DataTypeClassifier, args *DataTypeClassifierArgs) *BaseDataTypeClassifier {
@@ -39,8 +49,9 @@ func NewBaseDataTypeClassifier(owner DataTypeClassifier, args *DataTypeClassifie
 		args = &DataTypeClassifierArgs{}
 	}
 	return &BaseDataTypeClassifier{
-		owner:        owner,
-		MultiSegment: args.MultiSegment,
+		owner:          owner,
+		MultiSegment:   args.MultiSegment,
+		AllowNonFinite: args.AllowNonFinite,
 	}
 }
 
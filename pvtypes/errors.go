@@ -47,6 +47,18 @@ var (
 	// ErrParameterLocationNotSpecified indicates that parameter location was not specified.
 	ErrParameterLocationNotSpecified = errors.New("parameter location not specified")
 
+	// ErrDefaultValueViolatesConstraints indicates that an optional parameter's default, e.g. the 500 in {limit?500:int:range[1..100]}, fails its own type or constraints.
+	ErrDefaultValueViolatesConstraints = errors.New("default value does not satisfy the parameter's type or constraints")
+
+	// ErrInvalidListDelimiter indicates that a list modifier such as the [,] in {fields[,]:string} is empty or unterminated.
+	ErrInvalidListDelimiter = errors.New("invalid list delimiter; expected a non-empty delimiter in brackets, e.g. 'fields[,]'")
+
+	// ErrListParameterNotInQuery indicates that a list modifier was used on a parameter outside the query, e.g. /users/{ids[,]}.
+	ErrListParameterNotInQuery = errors.New("list parameters are only supported in the query")
+
+	// ErrEmptyListElement indicates that a list value has an empty element, e.g. "id,,name".
+	ErrEmptyListElement = errors.New("list value has an empty element")
+
 	// ErrInvalidIntegerFormat indicates that value is not a valid integer.
 	ErrInvalidIntegerFormat = errors.New("invalid integer format")
 
@@ -56,6 +68,18 @@ var (
 	// ErrInvalidRealFormat indicates that value is not a valid real number.
 	ErrInvalidRealFormat = errors.New("invalid real number format")
 
+	// ErrNonFiniteNumber indicates that a real or decimal value is NaN, Inf or -Inf.
+	ErrNonFiniteNumber = errors.New("number is not finite")
+
+	// ErrInvalidLatitudeFormat indicates that value is not a valid latitude.
+	ErrInvalidLatitudeFormat = errors.New("invalid latitude format")
+
+	// ErrInvalidLongitudeFormat indicates that value is not a valid longitude.
+	ErrInvalidLongitudeFormat = errors.New("invalid longitude format")
+
+	// ErrCoordinateOutOfRange indicates that a latitude or longitude is outside its valid range of degrees.
+	ErrCoordinateOutOfRange = errors.New("coordinate out of range")
+
 	// ErrUnsupportedDataType indicates that the data type is not supported.
 	ErrUnsupportedDataType = errors.New("unsupported data type")
 
@@ -77,6 +101,9 @@ var (
 	// ErrInvalidEmailFormat indicates that value is not a valid email format.
 	ErrInvalidEmailFormat = errors.New("invalid email format")
 
+	// ErrInvalidFlagFormat indicates that a flag value must be empty, 'true' or 'false'.
+	ErrInvalidFlagFormat = errors.New("flag value must be empty, 'true' or 'false'")
+
 	// Length Constraint Errors
 
 	// ErrInvalidLengthRangeMinGreaterThanMax indicates that minimum length is greater than maximum.
@@ -94,6 +121,12 @@ var (
 
 	// ErrInvalidConstraint indicates that a parameter constraint has invalid syntax.
 	ErrInvalidConstraint = errors.New("invalid constraint syntax")
+
+	// ErrNegatedConstraintSatisfied indicates that a value satisfied a constraint negated with '!'.
+	ErrNegatedConstraintSatisfied = errors.New("value satisfies negated constraint")
+
+	// ErrSimpleConstraintTakesNoRule indicates that a SimpleConstraint was written with a bracketed rule.
+	ErrSimpleConstraintTakesNoRule = errors.New("simple constraint does not accept a rule")
 )
 
 var (
@@ -117,6 +150,12 @@ var (
 var (
 	ErrDataTypeHasNoRegisteredClassifier = errors.New("data type has no registered classifier")
 	ErrDataTypeClassifiersNotRegistered  = errors.New("data type classifiers not registered")
+
+	// ErrInvalidDataTypeAlias indicates that RegisterDataTypeAlias() rejected an alias.
+	ErrInvalidDataTypeAlias = errors.New("invalid data type alias")
+
+	// ErrDataTypeAliasExists indicates that an alias is already a data type slug or a registered alias.
+	ErrDataTypeAliasExists = errors.New("data type alias already registered")
 )
 
 var ErrMustBeginWithLetterOrUnderscore = errors.New("must begin with letter or underscore")
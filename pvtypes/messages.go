@@ -0,0 +1,220 @@
+package pvtypes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultLanguage is the language of the built-in message catalog.
+const DefaultLanguage = "en"
+
+// Message catalog keys. Constraint-specific messages use the constraint type
+// as a prefix instead, e.g. "range.detail" or "length.suggestion", and fall
+// back to the generic constraint_error.* keys of the same language.
+const (
+	TypeErrorDetailMessage           = "type_error.detail"
+	TypeErrorSuggestionMessage       = "type_error.suggestion"
+	ConstraintErrorDetailMessage     = "constraint_error.detail"
+	ConstraintErrorSuggestionMessage = "constraint_error.suggestion"
+)
+
+// Message placeholders substituted when a catalog message is rendered.
+//
+//   - {parameter}    the parameter name
+//   - {value}        the value received
+//   - {type}         the data type slug, e.g. "integer"
+//   - {type_article} the data type with its indefinite article, e.g. "an integer"
+//   - {type_example} an example value of the data type
+//   - {example}      an example URL that would pass validation
+//   - {constraint}   the failing constraint, e.g. "range[1..10]"
+//   - {rule}         the failing constraint's rule, e.g. "1..10"
+type messageArgs struct {
+	parameter   string
+	value       string
+	dataType    string
+	typeArticle string
+	typeExample string
+	example     string
+	constraint  string
+	rule        string
+}
+
+var messageCatalogs = map[string]map[string]string{
+	DefaultLanguage: {
+		TypeErrorDetailMessage:           "Parameter '{parameter}' expected {type_article} type but got '{value}'",
+		TypeErrorSuggestionMessage:       "Use {type_article} for '{parameter}' like {type_example}, for example: {example}",
+		ConstraintErrorSuggestionMessage: "Ensure parameter '{parameter}' satisfies the constraint: {constraint}, for example: {example}",
+	},
+}
+
+// SetMessages registers catalog entries for lang, e.g. "fr" or "pt-BR", adding
+// to or replacing any entries already registered for it. Like constraint
+// registration it is intended to be called during initialization.
+func SetMessages(lang string, catalog map[string]string) {
+	lang = strings.ToLower(lang)
+	messages, ok := messageCatalogs[lang]
+	if !ok {
+		messages = make(map[string]string, len(catalog))
+		messageCatalogs[lang] = messages
+	}
+	for key, msg := range catalog {
+		messages[key] = msg
+	}
+}
+
+// lookupMessage finds key in the catalog for lang, falling back from a
+// regional tag such as "fr-CA" to its base language "fr".
+func lookupMessage(lang, key string) (msg string, ok bool) {
+	lang = strings.ToLower(lang)
+	msg, ok = messageCatalogs[lang][key]
+	if ok {
+		goto end
+	}
+	if base, _, found := strings.Cut(lang, "-"); found {
+		msg, ok = messageCatalogs[base][key]
+	}
+end:
+	return msg, ok
+}
+
+// placeholder returns the value for a placeholder name such as "parameter".
+func (a messageArgs) placeholder(name string) (value string, ok bool) {
+	ok = true
+	switch name {
+	case "parameter":
+		value = a.parameter
+	case "value":
+		value = a.value
+	case "type":
+		value = a.dataType
+	case "type_article":
+		value = a.typeArticle
+	case "type_example":
+		value = a.typeExample
+	case "example":
+		value = a.example
+	case "constraint":
+		value = a.constraint
+	case "rule":
+		value = a.rule
+	default:
+		ok = false
+	}
+	return value, ok
+}
+
+// render expands the placeholders in msg in a single pass. Unknown
+// placeholders are left as-is, and substituted values are never re-expanded.
+func (a messageArgs) render(msg string) string {
+	var sb strings.Builder
+	sb.Grow(len(msg) + len(a.parameter) + len(a.value) + len(a.example))
+	for {
+		open := strings.IndexByte(msg, '{')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(msg[open:], '}')
+		if end < 0 {
+			break
+		}
+		end += open
+		value, ok := a.placeholder(msg[open+1 : end])
+		if !ok {
+			sb.WriteString(msg[:open+1])
+			msg = msg[open+1:]
+			continue
+		}
+		sb.WriteString(msg[:open])
+		sb.WriteString(value)
+		msg = msg[end+1:]
+	}
+	sb.WriteString(msg)
+	return sb.String()
+}
+
+// messageArgs returns the placeholder values describing p for an error
+// caused by value, with constraint fields filled in when c is non-nil.
+func (p Parameter) messageArgs(err error, c Constraint, value, example string) (args messageArgs) {
+	args = messageArgs{
+		parameter:   string(p.Name),
+		value:       value,
+		dataType:    string(p.dataType.Slug()),
+		typeArticle: p.dataType.WithIndefiniteArticle(),
+		typeExample: fmt.Sprintf("%v", p.Example(err, &ExampleArgs{SuggestionType: DataTypeSuggestion})),
+		example:     example,
+	}
+	if c != nil {
+		args.constraint = c.String()
+		args.rule = c.Rule()
+	}
+	return args
+}
+
+// renderMessage renders key from the default catalog. The built-in keys are
+// always present, but a blank message is returned if one has been removed.
+func (p Parameter) renderMessage(key string, err error, value, example string) string {
+	msg, _ := lookupMessage(DefaultLanguage, key)
+	return p.messageArgs(err, nil, value, example).render(msg)
+}
+
+// failedConstraint returns the constraint of p reported by err, if any.
+func (p Parameter) failedConstraint(err error) (c Constraint) {
+	pe, ok := FindErr[*ParameterError](err)
+	if !ok || pe.ConstraintType == "" {
+		goto end
+	}
+	for _, pc := range p.constraints {
+		if pc.String() == pe.ConstraintType {
+			c = pc
+			goto end
+		}
+	}
+end:
+	return c
+}
+
+// localizedMessage renders the catalog message for lang describing err.
+// suffix is "detail" or "suggestion". It returns false when lang's catalog has
+// no applicable message, or p has an ErrorMessage, in which case the English
+// text should be kept.
+func (p Parameter) localizedMessage(lang, suffix string, err error, value, example string) (msg string, ok bool) {
+	if p.errorMessage != "" {
+		// An operator-supplied message wins over every catalog
+		return "", false
+	}
+	c := p.failedConstraint(err)
+	_, negated := c.(*NegatedConstraint)
+	switch {
+	case c == nil:
+		msg, ok = lookupMessage(lang, "type_error."+suffix)
+	case negated:
+		// Type-specific messages describe the positive form, so they don't apply
+		if strings.EqualFold(lang, DefaultLanguage) {
+			break
+		}
+		msg, ok = lookupMessage(lang, "constraint_error."+suffix)
+	default:
+		msg, ok = lookupMessage(lang, string(c.Type())+"."+suffix)
+		if ok || strings.EqualFold(lang, DefaultLanguage) {
+			// English constraint messages come from the constraints themselves
+			break
+		}
+		msg, ok = lookupMessage(lang, "constraint_error."+suffix)
+	}
+	if ok {
+		msg = p.messageArgs(err, c, value, example).render(msg)
+	}
+	return msg, ok
+}
+
+// LocalizedErrorDetail renders ErrorDetail() for err from the catalog
+// registered for lang, returning false if lang has no applicable message.
+func (p Parameter) LocalizedErrorDetail(lang string, err error, value string) (string, bool) {
+	return p.localizedMessage(lang, "detail", err, value, "")
+}
+
+// LocalizedErrorSuggestion renders ErrorSuggestion() for err from the catalog
+// registered for lang, returning false if lang has no applicable message.
+func (p Parameter) LocalizedErrorSuggestion(lang string, err error, value, example string) (string, bool) {
+	return p.localizedMessage(lang, "suggestion", err, value, example)
+}
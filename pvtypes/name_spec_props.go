@@ -18,6 +18,11 @@ type NameSpecProps struct {
 	// DefaultValue contains the default value for optional parameters.
 	DefaultValue *string
 
+	// ListDelimiter is set by a list modifier such as the [,] in
+	// {fields[,]:string}, and splits the value into a []string whose
+	// elements are each validated against the type and constraints.
+	ListDelimiter string
+
 	RawValue string
 
 	DataType *PVDataType
@@ -26,6 +31,11 @@ type NameSpecProps struct {
 func (p NameSpecProps) String() string {
 	sb := strings.Builder{}
 	sb.WriteString(string(p.Name))
+	if p.ListDelimiter != "" {
+		sb.WriteByte('[')
+		sb.WriteString(p.ListDelimiter)
+		sb.WriteByte(']')
+	}
 	if p.MultiSegment {
 		sb.WriteString("*")
 	}
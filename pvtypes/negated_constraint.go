@@ -0,0 +1,124 @@
+package pvtypes
+
+import (
+	"fmt"
+)
+
+// NegationPrefix marks a constraint whose result is inverted, e.g.
+// "!regex[[0-9]+]" requires a value that is NOT all digits.
+const NegationPrefix = '!'
+
+var _ Constraint = (*NegatedConstraint)(nil)
+
+// NegatedConstraint wraps a constraint and inverts its result: values the
+// wrapped constraint accepts are rejected and vice versa. Type() and Rule()
+// are those of the wrapped constraint; String() adds the NegationPrefix.
+type NegatedConstraint struct {
+	constraint Constraint
+}
+
+func NewNegatedConstraint(c Constraint) *NegatedConstraint {
+	return &NegatedConstraint{constraint: c}
+}
+
+// Constraint returns the wrapped constraint.
+func (c *NegatedConstraint) Constraint() Constraint {
+	return c.constraint
+}
+
+func (c *NegatedConstraint) Validate(value string) (err error) {
+	if c.constraint.Validate(value) == nil {
+		err = NewErr(
+			ErrNegatedConstraintSatisfied,
+			"constraint", c.String(),
+			"value", value,
+		)
+	}
+	return err
+}
+
+func (c *NegatedConstraint) String() string {
+	return string(NegationPrefix) + c.constraint.String()
+}
+
+func (c *NegatedConstraint) Rule() string {
+	return c.constraint.Rule()
+}
+
+func (c *NegatedConstraint) Type() ConstraintType {
+	return c.constraint.Type()
+}
+
+func (c *NegatedConstraint) Parse(value string, dataType PVDataType) (constraint Constraint, err error) {
+	constraint, err = c.constraint.Parse(value, dataType)
+	if err != nil {
+		goto end
+	}
+	constraint = NewNegatedConstraint(constraint)
+end:
+	return constraint, err
+}
+
+func (c *NegatedConstraint) ValidDataTypes() []PVDataType {
+	return c.constraint.ValidDataTypes()
+}
+
+func (c *NegatedConstraint) MapKey(dt PVDataTypeSlug) ConstraintMapKey {
+	return c.constraint.MapKey(dt)
+}
+
+// ValidatesType returns false since rejecting a format says nothing about
+// whether the value is of the parameter's data type.
+func (c *NegatedConstraint) ValidatesType() bool {
+	return false
+}
+
+// Example returns nil because an example of the wrapped constraint is
+// exactly what a negated constraint rejects.
+func (c *NegatedConstraint) Example(err error) any {
+	return nil
+}
+
+func (c *NegatedConstraint) ErrorDetail(param *Parameter, value string) string {
+	return fmt.Sprintf("Parameter '%s' with value '%s' failed constraint validation: value must NOT satisfy '%s'",
+		param.Name,
+		value,
+		c.constraint.String(),
+	)
+}
+
+func (c *NegatedConstraint) ErrorSuggestion(param *Parameter, value, example string) string {
+	if c.constraint.Type() == RegexConstraintType {
+		return fmt.Sprintf("Ensure parameter '%s' does NOT match the pattern %s, for example: %s",
+			param.Name,
+			c.constraint.Rule(),
+			example,
+		)
+	}
+	return fmt.Sprintf("Ensure parameter '%s' does NOT satisfy the constraint: %s, for example: %s",
+		param.Name,
+		c.constraint.String(),
+		example,
+	)
+}
+
+// SetOwner is a no-op; the wrapped constraint keeps itself as its owner so
+// that its own String() and CreateError() are unaffected by the negation.
+func (c *NegatedConstraint) SetOwner(Constraint) {}
+
+func (c *NegatedConstraint) CreateError(value string) *ConstraintError {
+	return &ConstraintError{
+		Err:           ErrConstraintValidationFailed,
+		Constraint:    c.String(),
+		FaultSource:   ClientFaultSource,
+		ReceivedValue: value,
+	}
+}
+
+// negateIf wraps c in a NegatedConstraint when negate is true.
+func negateIf(c Constraint, negate bool) Constraint {
+	if negate {
+		c = NewNegatedConstraint(c)
+	}
+	return c
+}
@@ -6,7 +6,11 @@ package pvtypes
 import (
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
 )
 
 // Parameter represents a path or query parameter with its type, constraints, and configuration.
@@ -27,6 +31,30 @@ type Parameter struct {
 	// original stores the original parameter specification string for reference.
 	original string
 
+	// regex is the pre-compiled pattern supplied via ParameterArgs.Regex, if any.
+	regex *regexp.Regexp
+
+	// defaultFunc computes a default per request, supplied via ParameterArgs.DefaultFunc.
+	defaultFunc func() string
+
+	// separator splits multi-segment values for decomposition, supplied via ParameterArgs.Separator.
+	separator string
+
+	// description is human-readable documentation supplied via ParameterArgs.Description.
+	description string
+
+	// errorMessage replaces generated error details and suggestions, supplied via ParameterArgs.ErrorMessage.
+	errorMessage string
+
+	// allowNonFinite lets real and decimal values be NaN or ±Inf, supplied via ParameterArgs.AllowNonFinite.
+	allowNonFinite bool
+
+	// asyncValidator, validationCache and asyncTimeout configure a lookup-based
+	// check, supplied via ParameterArgs.AsyncValidator and related fields.
+	asyncValidator  AsyncValidator
+	validationCache ValidationCache
+	asyncTimeout    time.Duration
+
 	nameProps
 }
 
@@ -57,18 +85,163 @@ func (p Parameter) WithPosition(pos int) Parameter {
 	return p
 }
 
+// Original returns the specification the parameter was parsed from, e.g.
+// "{id:int:range[1..100]}", or "" if it was constructed programmatically.
+func (p Parameter) Original() string {
+	return p.original
+}
+
+// Regexp returns the pre-compiled pattern supplied via ParameterArgs.Regex, or nil.
+func (p Parameter) Regexp() *regexp.Regexp {
+	return p.regex
+}
+
+// WithRegexp returns a copy of p with an added regex constraint built from the
+// pre-compiled re, bypassing regex[...] template parsing. The pattern is
+// anchored for full-value matching unless it is already anchored with ^ and $.
+// Regexp() returns re even when no registered constraint implements
+// RegexpConstraint to apply it, so Router.AddRoute() can reject the parameter
+// rather than ignore the pattern.
+func (p Parameter) WithRegexp(re *regexp.Regexp) Parameter {
+	var rc RegexpConstraint
+	var ok bool
+
+	p.regex = re
+	c, err := GetConstraint(RegexConstraintType, StringType)
+	if err != nil {
+		goto end
+	}
+	rc, ok = c.(RegexpConstraint)
+	if !ok {
+		goto end
+	}
+	p.constraints = append(slices.Clip(p.constraints), rc.WithRegexp(re))
+end:
+	return p
+}
+
+// DefaultFunc returns the function supplied via ParameterArgs.DefaultFunc, or nil.
+func (p Parameter) DefaultFunc() func() string {
+	return p.defaultFunc
+}
+
+// WithDefaultFunc returns a copy of p that calls fn for a default value each
+// time the parameter is omitted and has no static DefaultValue.
+func (p Parameter) WithDefaultFunc(fn func() string) Parameter {
+	p.defaultFunc = fn
+	return p
+}
+
+// MultiSegmentSeparator is the default separator used to decompose the value
+// of a multi-segment parameter into name_1, name_2, etc.
+const MultiSegmentSeparator = "/"
+
+// Separator returns the separator used to decompose a multi-segment value,
+// MultiSegmentSeparator unless one was supplied via ParameterArgs.Separator.
+func (p Parameter) Separator() string {
+	if p.separator == "" {
+		return MultiSegmentSeparator
+	}
+	return p.separator
+}
+
+// WithSeparator returns a copy of p whose multi-segment value is decomposed
+// on sep rather than MultiSegmentSeparator, e.g. "." for "a.b.c".
+func (p Parameter) WithSeparator(sep string) Parameter {
+	p.separator = sep
+	return p
+}
+
+// Description returns the human-readable text supplied via
+// ParameterArgs.Description, or "" when the parameter is undocumented.
+func (p Parameter) Description() string {
+	return p.description
+}
+
+// WithDescription returns a copy of p documented by desc, e.g. for generated
+// API reference or CLI help.
+func (p Parameter) WithDescription(desc string) Parameter {
+	p.description = desc
+	return p
+}
+
+// ErrorMessage returns the message supplied via ParameterArgs.ErrorMessage,
+// or "" when validation failures use the generated detail and suggestion.
+func (p Parameter) ErrorMessage() string {
+	return p.errorMessage
+}
+
+// WithErrorMessage returns a copy of p whose validation failures report msg,
+// e.g. "Use your 8-digit employee number", as both detail and suggestion.
+func (p Parameter) WithErrorMessage(msg string) Parameter {
+	p.errorMessage = msg
+	return p
+}
+
+// AllowNonFinite reports whether real and decimal values may be NaN or ±Inf,
+// as supplied via ParameterArgs.AllowNonFinite.
+func (p Parameter) AllowNonFinite() bool {
+	return p.allowNonFinite
+}
+
+// WithAllowNonFinite returns a copy of p whose real and decimal values may be
+// NaN, Inf or -Inf when allow is true.
+func (p Parameter) WithAllowNonFinite(allow bool) Parameter {
+	p.allowNonFinite = allow
+	return p
+}
+
+// AsyncValidator returns the validator supplied via ParameterArgs.AsyncValidator, or nil.
+func (p Parameter) AsyncValidator() AsyncValidator {
+	return p.asyncValidator
+}
+
+// ValidationCache returns the cache supplied via ParameterArgs.ValidationCache, or nil.
+func (p Parameter) ValidationCache() ValidationCache {
+	return p.validationCache
+}
+
+// AsyncTimeout returns the limit supplied via ParameterArgs.AsyncTimeout, or 0 for none.
+func (p Parameter) AsyncTimeout() time.Duration {
+	return p.asyncTimeout
+}
+
+// WithAsyncValidator returns a copy of p checked by fn once its value passes
+// validation, with results cached in cache when it is not nil and each call
+// limited to timeout when it is positive.
+func (p Parameter) WithAsyncValidator(fn AsyncValidator, cache ValidationCache, timeout time.Duration) Parameter {
+	p.asyncValidator = fn
+	p.validationCache = cache
+	p.asyncTimeout = timeout
+	return p
+}
+
 type nameProps = NameSpecProps
 
 // NewParameter creates a new Parameter instance with the specified configuration.
-func NewParameter(args ParameterArgs) Parameter {
-	return Parameter{
-		location:    args.Location,
-		dataType:    args.DataType,
-		constraints: args.Constraints,
-		position:    args.Position,
-		original:    args.Original,
-		nameProps:   args.NameProps,
+func NewParameter(args ParameterArgs) (p Parameter) {
+	p = Parameter{
+		location:     args.Location,
+		dataType:     args.DataType,
+		constraints:  args.Constraints,
+		position:     args.Position,
+		original:     args.Original,
+		nameProps:    args.NameProps,
+		defaultFunc:  args.DefaultFunc,
+		separator:    args.Separator,
+		description:  args.Description,
+		errorMessage: args.ErrorMessage,
+
+		allowNonFinite: args.AllowNonFinite,
+
+		asyncValidator:  args.AsyncValidator,
+		validationCache: args.ValidationCache,
+		asyncTimeout:    args.AsyncTimeout,
+	}
+	if args.Regex != nil {
+		p = p.WithRegexp(args.Regex)
 	}
+	return p
 }
 
 // ParameterArgs contains arguments for creating a Parameter instance.
@@ -91,6 +264,47 @@ type ParameterArgs struct {
 
 	// Original stores the original parameter specification.
 	Original string
+
+	// Regex is an optional pre-compiled pattern the value must fully match.
+	// Use it for patterns whose brackets would collide with regex[...] syntax
+	// or to share one compiled pattern across many routes.
+	Regex *regexp.Regexp
+
+	// DefaultFunc computes the value of an omitted optional parameter that has
+	// no static default, e.g. today's date. It is called once per request.
+	DefaultFunc func() string
+
+	// Separator splits a multi-segment value into name_1, name_2, etc. for
+	// decomposition, e.g. "." for a dotted path. Defaults to MultiSegmentSeparator.
+	// Dates always decompose on "/" into name_year, name_month and name_day.
+	Separator string
+
+	// Description documents the parameter for generated API reference or CLI
+	// help. It is kept out of template syntax so a template stays parseable.
+	Description string
+
+	// ErrorMessage, when set, replaces the generated detail and suggestion of
+	// every type and constraint failure of the parameter with a domain message
+	// such as "Use your 8-digit employee number". It takes precedence over
+	// localized message catalogs.
+	ErrorMessage string
+
+	// AllowNonFinite lets a real or decimal value be NaN, Inf or -Inf, which
+	// are otherwise rejected even though strconv.ParseFloat() accepts them.
+	AllowNonFinite bool
+
+	// AsyncValidator checks the value with a lookup, e.g. that a tenant slug
+	// exists, after type and constraint validation succeed. Router.Match()
+	// passes it the request's context so cancellation is honored.
+	AsyncValidator AsyncValidator
+
+	// ValidationCache, when set, remembers AsyncValidator results by value,
+	// e.g. NewMemoryValidationCache(1000). Context errors are never cached.
+	ValidationCache ValidationCache
+
+	// AsyncTimeout, when positive, bounds each AsyncValidator call; a call
+	// that exceeds it fails as a server fault.
+	AsyncTimeout time.Duration
 }
 
 func isBraceEnclosed(s string) (enclosed bool) {
@@ -180,6 +394,14 @@ func ParseParameter(spec string, location LocationType) (p Parameter, err error)
 		// don't see how it could be possible, but maybe Goland knows something I don't?
 		panic(fmt.Sprintf("NameSpecProps are nil when err is also nil; spec=%s", spec))
 	}
+	if props.ListDelimiter != "" && location != QueryLocation {
+		err = NewErr(
+			ErrInvalidParameter,
+			ErrListParameterNotInQuery,
+			"parameter_name", props.Name,
+		)
+		goto end
+	}
 	switch {
 	case len(parts) > 1:
 		// Pattern: {name:type} or {name:type:constraint} -> explicit type provided
@@ -216,9 +438,18 @@ func ParseParameter(spec string, location LocationType) (p Parameter, err error)
 		original:    spec,
 	}
 
-	// Validate default value if provided
+	// Validate default value if provided so a bad default fails when the
+	// route is added rather than on the first request that omits it
 	if p.DefaultValue != nil {
 		err = p.Validate(*p.DefaultValue)
+		if err != nil {
+			err = NewErr(
+				ErrDefaultValueViolatesConstraints,
+				"parameter_name", p.Name,
+				"default_value", *p.DefaultValue,
+				err,
+			)
+		}
 	}
 
 end:
@@ -272,6 +503,12 @@ func (p Parameter) Example(err error, args *ExampleArgs) (example any) {
 	if args == nil {
 		args = &ExampleArgs{}
 	}
+	if args.Rand != nil && err == nil {
+		example = p.randomExample(args.Rand)
+		if example != nil {
+			goto end
+		}
+	}
 	// If we have an error with a specific constraint, use that constraint's example
 	if errors.As(err, &pe) && pe.ConstraintType != "" {
 		for _, c := range p.constraints {
@@ -319,6 +556,47 @@ end:
 	return example
 }
 
+// maxRandomExampleAttempts bounds how many values each RandomExampler
+// generates before randomExample() moves on, e.g. when a random slug happens to
+// violate a length constraint.
+const maxRandomExampleAttempts = 10
+
+// randomExample returns a value generated from r that passes Validate(),
+// trying constraints that implement RandomExampler before the data type, or nil
+// when none generates a valid value.
+func (p Parameter) randomExample(r *rand.Rand) (example any) {
+	var generators []RandomExampler
+
+	for _, c := range p.constraints {
+		g, ok := c.(RandomExampler)
+		if ok {
+			generators = append(generators, g)
+		}
+	}
+	classifier, err := GetDataTypeClassifier(p.dataType)
+	if err == nil {
+		g, ok := classifier.(RandomExampler)
+		if ok {
+			generators = append(generators, g)
+		}
+	}
+
+	for _, g := range generators {
+		for range maxRandomExampleAttempts {
+			example = g.RandomExample(r)
+			if example == nil {
+				break
+			}
+			if p.Validate(fmt.Sprintf("%v", example)) == nil {
+				goto end
+			}
+		}
+	}
+	example = nil
+end:
+	return example
+}
+
 func (p Parameter) ValidateForDataType(value string) (err error) {
 	var newer, v DataTypeClassifier
 	if p.Optional && value == "" {
@@ -329,7 +607,8 @@ func (p Parameter) ValidateForDataType(value string) (err error) {
 		goto end
 	}
 	v = newer.MakeNew(&DataTypeClassifierArgs{
-		MultiSegment: p.MultiSegment,
+		MultiSegment:   p.MultiSegment,
+		AllowNonFinite: p.allowNonFinite,
 	})
 	err = v.Validate(value)
 end:
@@ -355,7 +634,120 @@ end:
 	return validates
 }
 
+// Canonical returns the spelling of value given by the first of this parameter's
+// constraints that implements CanonicalConstraint and recognizes it, or value
+// unchanged when none does. It should only be called for values that validated.
+func (p Parameter) Canonical(value string) string {
+	if p.IsList() {
+		elements := p.SplitList(value)
+		for i, element := range elements {
+			elements[i] = p.canonicalElement(element)
+		}
+		return strings.Join(elements, p.ListDelimiter)
+	}
+	return p.canonicalElement(value)
+}
+
+// canonicalElement returns the canonical spelling of a single value.
+func (p Parameter) canonicalElement(value string) string {
+	for _, c := range p.constraints {
+		cc, ok := c.(CanonicalConstraint)
+		if !ok {
+			continue
+		}
+		canonical, ok := cc.Canonical(value)
+		if ok {
+			return canonical
+		}
+	}
+	return value
+}
+
 func (p Parameter) Validate(value string) (err error) {
+	if p.IsList() {
+		err = p.validateList(value)
+	} else {
+		err = p.validateElement(value)
+	}
+	if err != nil {
+		err = WithErr(err, ErrParameterValidationFailed)
+	}
+	return err
+}
+
+// IsList reports whether the parameter was declared with a list modifier
+// such as the [,] in {fields[,]:string}.
+func (p Parameter) IsList() bool {
+	return p.ListDelimiter != ""
+}
+
+// GoType returns the name of the Go type MatchResult.Typed() yields for the
+// parameter's values, e.g. "int64" for {id:int}, "time.Time" for a date and
+// "[]string" for a list, for use by code generators.
+func (p Parameter) GoType() string {
+	if p.IsList() {
+		return "[]string"
+	}
+	switch p.dataType {
+	case IntegerType:
+		return "int64"
+	case DecimalType, RealType, LatitudeType, LongitudeType:
+		return "float64"
+	case BooleanType, FlagType:
+		return "bool"
+	case DateType:
+		return "time.Time"
+	}
+	return "string"
+}
+
+// SplitList splits a list parameter's value on its ListDelimiter, returning
+// an empty slice for an empty value.
+func (p Parameter) SplitList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, p.ListDelimiter)
+}
+
+// FormatValue returns value as its template string, rejoining a list
+// parameter's []string on its ListDelimiter and using fmt's %v verb otherwise.
+func (p Parameter) FormatValue(value any) string {
+	elements, ok := value.([]string)
+	if ok && p.IsList() {
+		return strings.Join(elements, p.ListDelimiter)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// validateList validates each element of a list value against the
+// parameter's type and constraints. An empty list is valid.
+func (p Parameter) validateList(value string) (err error) {
+	var errs []error
+
+	for i, element := range p.SplitList(value) {
+		if element == "" {
+			errs = append(errs, NewErr(
+				ErrEmptyListElement,
+				"parameter_name", p.Name,
+				"list_index", i,
+				"value", value,
+			))
+			continue
+		}
+		err = p.validateElement(element)
+		if err != nil {
+			errs = append(errs, WithErr(err,
+				"list_index", i,
+			))
+		}
+	}
+	return CombineErrs(errs)
+}
+
+// validateElement validates a single value against the parameter's type and
+// constraints.
+func (p Parameter) validateElement(value string) (err error) {
 	// Only validate type upfront if no constraint handles type validation
 	if !p.ConstraintValidatesType() {
 		err = p.ValidateForDataType(value)
@@ -365,9 +757,6 @@ func (p Parameter) Validate(value string) (err error) {
 	}
 	err = p.ValidateConstraints(value)
 end:
-	if err != nil {
-		err = WithErr(err, ErrParameterValidationFailed)
-	}
 	return err
 }
 
@@ -417,11 +806,7 @@ end:
 }
 
 func (p Parameter) ErrorDetail(value string) string {
-	return fmt.Sprintf("Parameter '%s' expected %s type but got '%s'",
-		p.Name,
-		p.dataType.WithIndefiniteArticle(),
-		value,
-	)
+	return p.renderMessage(TypeErrorDetailMessage, nil, value, "")
 }
 
 // ErrorSuggestion generates the appropriate error suggestion based on the error type.
@@ -429,6 +814,9 @@ func (p Parameter) ErrorDetail(value string) string {
 // For type validation errors, it returns a standard type suggestion.
 func (p Parameter) ErrorSuggestion(err error, value, example string) string {
 	var pe *ParameterError
+	if p.errorMessage != "" {
+		return p.errorMessage
+	}
 	// Check if this is a ParameterError with a constraint violation
 	if errors.As(err, &pe) && pe.ConstraintType != "" {
 		// This is a constraint violation - find the matching constraint and use its suggestion
@@ -438,16 +826,19 @@ func (p Parameter) ErrorSuggestion(err error, value, example string) string {
 			}
 		}
 		// Fallback if constraint not found (shouldn't happen)
-		return fmt.Sprintf("Ensure parameter '%s' satisfies the constraint: %s, for example: %s",
-			p.Name, pe.ConstraintType, example)
+		args := p.messageArgs(err, nil, value, example)
+		args.constraint = pe.ConstraintType
+		msg, _ := lookupMessage(DefaultLanguage, ConstraintErrorSuggestionMessage)
+		return args.render(msg)
+	}
+	// Type validation error or other error - use the type's own suggestion if it has one
+	classifier, cErr := GetDataTypeClassifier(p.dataType)
+	if cErr == nil {
+		if s, ok := classifier.(DataTypeErrorSuggester); ok {
+			return s.ErrorSuggestion(&p, value, example)
+		}
 	}
-	// Type validation error or other error - use standard type suggestion
-	return fmt.Sprintf("Use %s for '%s' like %v, for example: %s",
-		p.dataType.WithIndefiniteArticle(),
-		p.Name,
-		p.Example(err, &ExampleArgs{SuggestionType: DataTypeSuggestion}),
-		example,
-	)
+	return p.renderMessage(TypeErrorSuggestionMessage, err, value, example)
 }
 
 // createDataTypeViolationError creates a ParameterError for type validation failures.
@@ -455,6 +846,7 @@ func (p Parameter) createDataTypeViolationError(value string) error {
 	pe := newParameterError(&p, value, ErrParameterDataTypeValidationFailed)
 	pe.FaultSource = ClientFaultSource
 	pe.Detail = p.ErrorDetail(value)
+	p.applyErrorMessage(pe)
 	return pe
 }
 
@@ -465,5 +857,16 @@ func (p Parameter) createConstraintViolationError(c Constraint, value string) er
 	pe.Detail = c.ErrorDetail(&p, value)
 	pe.ConstraintType = c.String()
 	pe.Err = c.CreateError(value)
+	p.applyErrorMessage(pe)
 	return pe
 }
+
+// applyErrorMessage replaces pe's generated detail and suggestion with the
+// parameter's ErrorMessage, if it has one.
+func (p Parameter) applyErrorMessage(pe *ParameterError) {
+	if p.errorMessage == "" {
+		return
+	}
+	pe.Detail = p.errorMessage
+	pe.suggestion = p.errorMessage
+}
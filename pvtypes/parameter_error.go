@@ -14,7 +14,18 @@ type ParameterError struct {
 	ExpectedType   string
 	Detail         string
 	ConstraintType string
-	errString      string
+	Location       LocationType
+
+	// SegmentIndex is the 0-based index of the failing path segment within the
+	// request path, e.g. 3 for "42x" in "/users/7/posts/42x", so tooling can
+	// underline it. For a query parameter it is the parameter's position among
+	// the template's query parameters. It is -1 when unknown.
+	SegmentIndex int
+
+	errString string
+
+	// suggestion overrides the generated suggestion, e.g. with ParameterArgs.ErrorMessage.
+	suggestion string
 }
 
 func newParameterError(p *Parameter, value string, err error) *ParameterError {
@@ -23,6 +34,8 @@ func newParameterError(p *Parameter, value string, err error) *ParameterError {
 		ReceivedValue: value,
 		Parameter:     string(p.Name),
 		ExpectedType:  string(p.dataType.Slug()),
+		Location:      p.location,
+		SegmentIndex:  -1,
 	}
 }
 
@@ -124,6 +137,12 @@ func (e *ParameterError) GetConstraintType() string {
 	return ""
 }
 
+// SetDetail replaces the detail message, e.g. with a localized one.
+func (e *ParameterError) SetDetail(detail string) {
+	e.Detail = detail
+	e.errString = ""
+}
+
 // GetDetail returns the detail message based on error type
 func (e *ParameterError) GetDetail() string {
 	if e.Detail != "" {
@@ -137,6 +156,9 @@ func (e *ParameterError) GetDetail() string {
 
 // GetSuggestion returns the suggestion based on error type
 func (e *ParameterError) GetSuggestion() string {
+	if e.suggestion != "" {
+		return e.suggestion
+	}
 	if ce := e.extractConstraintError(); ce != nil {
 		return e.buildConstraintSuggestion(ce)
 	}
@@ -56,6 +56,16 @@ const (
 
 	// EmailType represents email address values.
 	EmailType
+
+	// FlagType represents presence-only query flags like ?verbose where
+	// presence means true and absence means false.
+	FlagType
+
+	// LatitudeType represents a latitude in decimal degrees between -90 and 90.
+	LatitudeType
+
+	// LongitudeType represents a longitude in decimal degrees between -180 and 180.
+	LongitudeType
 )
 
 // PVDataTypeSlug represents the string name of a parameter data type.
@@ -107,6 +117,15 @@ const (
 
 	// EmailTypeSlug is the string representation of EmailType.
 	EmailTypeSlug PVDataTypeSlug = "email"
+
+	// FlagTypeSlug is the string representation of FlagType.
+	FlagTypeSlug PVDataTypeSlug = "flag"
+
+	// LatitudeTypeSlug is the string representation of LatitudeType.
+	LatitudeTypeSlug PVDataTypeSlug = "latitude"
+
+	// LongitudeTypeSlug is the string representation of LongitudeType.
+	LongitudeTypeSlug PVDataTypeSlug = "longitude"
 )
 
 func (dt PVDataType) WithIndefiniteArticle() (wia string) {
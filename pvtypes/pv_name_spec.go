@@ -15,6 +15,8 @@ import (
 //	name?John		in use: {name?John:string} 	// Optional w/default of John
 //	name*?John	in use: {name*?John:string} // Optional w/default of John, can be multi-segment
 //	name?*John	in use: {name?*John:string} // Optional w/default of John, can be multi-segment (alternate)
+//	name[,]			in use: {name[,]:string} 		// Comma-separated list
+//	name[,]?a,b	in use: {name[,]?a,b:string} // Optional list w/default of a and b
 type PVNameSpec string
 
 var nameSpecCharsRegexp = regexp.MustCompile(`([?*]{1,2})(.*)$`)
@@ -31,11 +33,14 @@ const (
 // - name* -> multi-segment required parameter
 // - name*? -> multi-segment optional parameter, no default
 // - name*?default -> multi-segment optional parameter with default
+// - name[,] -> list parameter split on the delimiter in brackets, which may
+// precede any of the above markers
 func ParseNameSpecProps(ns string) (props *NameSpecProps, err error) {
 	var dt PVDataType
 	var name Identifier
 	var chars string
 	var matches []string
+	var rest string
 
 	if ns == "" {
 		err = WithErr(err,
@@ -66,8 +71,23 @@ func ParseNameSpecProps(ns string) (props *NameSpecProps, err error) {
 	if dt != UnspecifiedDataType {
 		props.DataType = &dt
 	}
+	rest = ns[len(name):]
+	if strings.HasPrefix(rest, "[") {
+		delimiter, after, found := strings.Cut(rest[1:], "]")
+		if !found || delimiter == "" {
+			err = NewErr(
+				ErrInvalidNameSpec,
+				ErrInvalidListDelimiter,
+				"namespec", ns,
+			)
+			props = nil
+			goto end
+		}
+		props.ListDelimiter = delimiter
+		rest = after
+	}
 	// Implement error handling for PVNameSpec
-	matches = nameSpecCharsRegexp.FindStringSubmatch(ns)
+	matches = nameSpecCharsRegexp.FindStringSubmatch(rest)
 	if matches == nil {
 		goto end
 	}
@@ -74,6 +74,26 @@ func TestParsePVNameSpec(t *testing.T) {
 			wantSpec: "name*?Default Value",
 			wantErr:  false,
 		},
+		{
+			name:     "List",
+			nameSpec: "name[,]",
+			wantErr:  false,
+		},
+		{
+			name:     "Optional List with Default",
+			nameSpec: "name[|]?a|b",
+			wantErr:  false,
+		},
+		{
+			name:     "List with empty delimiter",
+			nameSpec: "name[]",
+			wantErr:  true,
+		},
+		{
+			name:     "List with unterminated delimiter",
+			nameSpec: "name[,",
+			wantErr:  true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,65 @@
+package pvtypes
+
+import (
+	"strings"
+)
+
+var _ Constraint = (*SimpleConstraint)(nil)
+
+// SimpleConstraint adapts a plain validation func into a Constraint so that a
+// one-off constraint can be registered without implementing the full
+// interface. It takes no rule, so it is written bare in templates, e.g.
+// "{n:int:even}", and uses the BaseConstraint defaults for error messages.
+type SimpleConstraint struct {
+	BaseConstraint
+	name      ConstraintType
+	dataTypes []PVDataType
+	validate  func(string) error
+}
+
+// NewSimpleConstraint creates a constraint named name that applies to
+// dataTypes and accepts a value when validate returns nil. Pass the result
+// to RegisterConstraint to make it available in templates.
+func NewSimpleConstraint(name ConstraintType, dataTypes []PVDataType, validate func(string) error) *SimpleConstraint {
+	c := &SimpleConstraint{
+		name:      name,
+		dataTypes: dataTypes,
+		validate:  validate,
+	}
+	c.BaseConstraint = NewBaseConstraint(c)
+	return c
+}
+
+func (c *SimpleConstraint) ValidDataTypes() []PVDataType {
+	return c.dataTypes
+}
+
+func (c *SimpleConstraint) Type() ConstraintType {
+	return c.name
+}
+
+func (c *SimpleConstraint) Rule() string {
+	return ""
+}
+
+func (c *SimpleConstraint) String() string {
+	return string(c.name)
+}
+
+func (c *SimpleConstraint) Validate(value string) error {
+	return c.validate(value)
+}
+
+func (c *SimpleConstraint) Parse(value string, dataType PVDataType) (constraint Constraint, err error) {
+	if strings.TrimSpace(value) != "" {
+		err = NewErr(
+			ErrSimpleConstraintTakesNoRule,
+			"constraint", c.name,
+			"rule", value,
+		)
+		goto end
+	}
+	constraint = NewSimpleConstraint(c.name, c.dataTypes, c.validate)
+end:
+	return constraint, err
+}
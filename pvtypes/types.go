@@ -1,5 +1,9 @@
 package pvtypes
 
+import (
+	"math/rand/v2"
+)
+
 type Selector string
 type Identifier string
 type Location string
@@ -31,6 +35,19 @@ type ExampleArgs struct {
 	// When true, type errors use data type examples (e.g., v1 UUID) instead of
 	// constraint examples (e.g., v4 UUID from format[v4])
 	SuggestionType SuggestionType
+
+	// Rand, when set, generates varied examples that still satisfy each
+	// parameter's type and constraints, e.g. a random in-range int, instead of
+	// the canned ones. The same seed yields the same examples, for reproducible
+	// docs and tests, e.g. rand.New(rand.NewPCG(42, 0)).
+	Rand *rand.Rand
+}
+
+// RandomExampler is optionally implemented by a Constraint or
+// DataTypeClassifier to generate an example value from ExampleArgs.Rand, or nil
+// when it cannot.
+type RandomExampler interface {
+	RandomExample(r *rand.Rand) any
 }
 
 type SuggestionType int
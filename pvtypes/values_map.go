@@ -43,3 +43,21 @@ func (vm ValuesMap) GetValues(names []Identifier) (values ValuesMap, notFound []
 	notFound = slices.Collect(maps.Keys(notFoundMap))
 	return values, notFound
 }
+
+// Merge copies every value from other into vm, appending new keys in other's
+// order. Keys already present in vm keep their value and position unless
+// overwrite is true, in which case the value is replaced in place.
+func (vm ValuesMap) Merge(other ValuesMap, overwrite bool) {
+	if !other.Initialized() {
+		return
+	}
+	for name, value := range other.Iterator() {
+		if !overwrite {
+			_, exists := vm.Get(name)
+			if exists {
+				continue
+			}
+		}
+		vm.Set(name, value)
+	}
+}
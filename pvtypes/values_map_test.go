@@ -0,0 +1,67 @@
+package pvtypes
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValuesMapMerge(t *testing.T) {
+	newMatched := func() ValuesMap {
+		vm := NewValuesMap(2)
+		vm.Set("id", 42)
+		vm.Set("limit", 10)
+		return vm
+	}
+	newDefaults := func() ValuesMap {
+		vm := NewValuesMap(3)
+		vm.Set("tenant", "acme")
+		vm.Set("limit", 50)
+		vm.Set("sort", "name")
+		return vm
+	}
+
+	tests := []struct {
+		name      string
+		overwrite bool
+		wantKeys  []Identifier
+		wantLimit any
+	}{
+		{
+			name:      "without-overwrite",
+			overwrite: false,
+			wantKeys:  []Identifier{"id", "limit", "tenant", "sort"},
+			wantLimit: 10,
+		},
+		{
+			name:      "with-overwrite",
+			overwrite: true,
+			wantKeys:  []Identifier{"id", "limit", "tenant", "sort"},
+			wantLimit: 50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vm := newMatched()
+			vm.Merge(newDefaults(), tt.overwrite)
+
+			if got := vm.GetKeys(); !slices.Equal(got, tt.wantKeys) {
+				t.Errorf("GetKeys() = %v, want %v", got, tt.wantKeys)
+			}
+			if got, _ := vm.Get("limit"); got != tt.wantLimit {
+				t.Errorf("Get(\"limit\") = %v, want %v", got, tt.wantLimit)
+			}
+			if got, _ := vm.Get("tenant"); got != "acme" {
+				t.Errorf("Get(\"tenant\") = %v, want %v", got, "acme")
+			}
+		})
+	}
+
+	t.Run("uninitialized-other", func(t *testing.T) {
+		vm := newMatched()
+		vm.Merge(ValuesMap{}, true)
+		if vm.Len() != 2 {
+			t.Errorf("Len() = %d, want 2", vm.Len())
+		}
+	})
+}
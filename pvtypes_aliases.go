@@ -44,8 +44,11 @@ const (
 	DateType            = pvt.DateType
 	DecimalType         = pvt.DecimalType
 	EmailType           = pvt.EmailType
+	FlagType            = pvt.FlagType
 	IdentifierType      = pvt.IdentifierType
 	IntegerType         = pvt.IntegerType
+	LatitudeType        = pvt.LatitudeType
+	LongitudeType       = pvt.LongitudeType
 	RealType            = pvt.RealType
 	SlugType            = pvt.SlugType
 	StringType          = pvt.StringType
@@ -65,10 +68,13 @@ const (
 	DateTypeSlug         = pvt.DateTypeSlug
 	DecimalTypeSlug      = pvt.DecimalTypeSlug
 	EmailTypeSlug        = pvt.EmailTypeSlug
+	FlagTypeSlug         = pvt.FlagTypeSlug
 	IdentifierTypeSlug   = pvt.IdentifierTypeSlug
 	IntTypeSlug          = pvt.IntTypeSlug // Accepted alternate for "integer"
 	IntegerTypeSlug      = pvt.IntegerTypeSlug
 	InvalidTypeSlug      = pvt.InvalidTypeSlug
+	LatitudeTypeSlug     = pvt.LatitudeTypeSlug
+	LongitudeTypeSlug    = pvt.LongitudeTypeSlug
 	RealTypeSlug         = pvt.RealTypeSlug
 	SlugTypeSlug         = pvt.SlugTypeSlug
 	StringTypeSlug       = pvt.StringTypeSlug
@@ -100,6 +106,16 @@ func NewParameter(args ParameterArgs) Parameter {
 
 type ParameterArgs = pvt.ParameterArgs
 
+type AsyncValidator = pvt.AsyncValidator
+
+type ValidationCache = pvt.ValidationCache
+
+type MemoryValidationCache = pvt.MemoryValidationCache
+
+func NewMemoryValidationCache(maxEntries int) *MemoryValidationCache {
+	return pvt.NewMemoryValidationCache(maxEntries)
+}
+
 func ParseBraceEnclosed(s string) (_ string, err error) {
 	return pvt.ParseBraceEnclosed(s)
 }
@@ -141,18 +157,43 @@ const (
 type ConstraintType = pvt.ConstraintType
 
 const (
-	EnumConstraintType     = pvt.EnumConstraintType
-	FormatConstraintType   = pvt.FormatConstraintType
-	LengthConstraintType   = pvt.LengthConstraintType
-	NotEmptyConstraintType = pvt.NotEmptyConstraintType
-	RangeConstraintType    = pvt.RangeConstraintType
-	RegexConstraintType    = pvt.RegexConstraintType
+	ByteLengthConstraintType    = pvt.ByteLengthConstraintType
+	CaseConstraintType          = pvt.CaseConstraintType
+	DecodedLengthConstraintType = pvt.DecodedLengthConstraintType
+	EnumConstraintType          = pvt.EnumConstraintType
+	FormatConstraintType        = pvt.FormatConstraintType
+	IntDigitsConstraintType     = pvt.IntDigitsConstraintType
+	LengthConstraintType        = pvt.LengthConstraintType
+	LuhnConstraintType          = pvt.LuhnConstraintType
+	MultipleOfConstraintType    = pvt.MultipleOfConstraintType
+	NotEmptyConstraintType      = pvt.NotEmptyConstraintType
+	RangeConstraintType         = pvt.RangeConstraintType
+	RegexConstraintType         = pvt.RegexConstraintType
+	ScaleConstraintType         = pvt.ScaleConstraintType
+	WordsConstraintType         = pvt.WordsConstraintType
 )
 
 type Constraints = pvt.Constraints
 
 type Constraint = pvt.Constraint
 
+// NegatedConstraint inverts a constraint written with a leading '!'.
+type NegatedConstraint = pvt.NegatedConstraint
+
+// NewNegatedConstraint wraps c so that values it accepts are rejected and vice versa.
+func NewNegatedConstraint(c Constraint) *NegatedConstraint {
+	return pvt.NewNegatedConstraint(c)
+}
+
+// SimpleConstraint adapts a validation func into a registerable Constraint.
+type SimpleConstraint = pvt.SimpleConstraint
+
+// NewSimpleConstraint creates a bare constraint named name for dataTypes that
+// accepts values for which validate returns nil.
+func NewSimpleConstraint(name ConstraintType, dataTypes []PVDataType, validate func(string) error) *SimpleConstraint {
+	return pvt.NewSimpleConstraint(name, dataTypes, validate)
+}
+
 // ParseConstraints parses constraint specifications from a string.
 //
 // ParseBytes constraint specs like:
@@ -171,6 +212,19 @@ type ConstraintsMap = pvt.ConstraintsMap
 
 type DataTypeAliasMap = pvt.DataTypeAliasMap
 
+// RegisterDataTypeAlias registers alias as an alternate slug for the data type
+// named by canonical, e.g. "num" for "int". It fails with
+// pvtypes.ErrInvalidDataTypeAlias for an unknown canonical slug or an alias
+// that is already taken.
+func RegisterDataTypeAlias(alias, canonical PVDataTypeSlug) error {
+	return pvt.RegisterDataTypeAlias(alias, canonical)
+}
+
+// GetDataTypeAliasMap returns a copy of the registered data type aliases.
+func GetDataTypeAliasMap() DataTypeAliasMap {
+	return pvt.GetDataTypeAliasMap()
+}
+
 func RegisterConstraint(c Constraint) {
 	pvt.RegisterConstraint(c)
 }
@@ -186,3 +240,21 @@ func GetConstraintMapKey(ct ConstraintType, dtn PVDataTypeSlug) ConstraintMapKey
 func GetConstraint(ct ConstraintType, dt PVDataType) (c Constraint, err error) {
 	return pvt.GetConstraint(ct, dt)
 }
+
+const DefaultLanguage = pvt.DefaultLanguage
+
+// MultiSegmentSeparator is the default separator for decomposing multi-segment values.
+const MultiSegmentSeparator = pvt.MultiSegmentSeparator
+
+const (
+	TypeErrorDetailMessage           = pvt.TypeErrorDetailMessage
+	TypeErrorSuggestionMessage       = pvt.TypeErrorSuggestionMessage
+	ConstraintErrorDetailMessage     = pvt.ConstraintErrorDetailMessage
+	ConstraintErrorSuggestionMessage = pvt.ConstraintErrorSuggestionMessage
+)
+
+// SetMessages registers message catalog entries used to localize error
+// details and suggestions for lang. See pvtypes.SetMessages().
+func SetMessages(lang string, catalog map[string]string) {
+	pvt.SetMessages(lang, catalog)
+}
@@ -0,0 +1,61 @@
+package pathvars
+
+import (
+	"slices"
+)
+
+// requireWith returns an error for the first provided parameter, in template
+// order, whose RequiredWith dependencies the request did not also provide.
+// Defaults do not count as provided.
+func (r Route) requireWith(provided []Identifier) (err error) {
+	if len(r.RequiredWith) == 0 {
+		goto end
+	}
+	for p := range r.ParsedTemplate.params.Values() {
+		var missing []Identifier
+		requires, ok := r.RequiredWith[p.Name]
+		if !ok || !slices.Contains(provided, p.Name) {
+			continue
+		}
+		for _, name := range requires {
+			if !slices.Contains(provided, name) {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		err = NewErr(
+			ErrRequiredWithViolated,
+			"parameter_name", p.Name,
+			"requires", joinIdentifiers(requires),
+			"missing", joinIdentifiers(missing),
+			"endpoint", r.Endpoint(),
+			"fault_source", ClientFaultSource.Slug(),
+		)
+		goto end
+	}
+end:
+	return err
+}
+
+// checkRequiredWith verifies that every parameter named by deps is one of
+// pt's parameters, so a typo cannot make a dependency impossible to satisfy.
+func checkRequiredWith(pt *ParsedTemplate, deps map[Identifier][]Identifier) (err error) {
+	for name, requires := range deps {
+		for _, n := range append([]Identifier{name}, requires...) {
+			_, exists := pt.params.Get(n)
+			if !exists {
+				err = NewErr(
+					ErrInvalidRequiredWith,
+					ErrRequiredWithParameterNotFound,
+					"parameter", n,
+					"dependent", name,
+				)
+				goto end
+			}
+		}
+	}
+end:
+	return err
+}
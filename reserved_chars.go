@@ -0,0 +1,97 @@
+package pathvars
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// unencodedPathDelims are the RFC 3986 reserved characters that may never
+// appear raw within a path segment, so a client sending one almost certainly
+// forgot to percent-encode it.
+const unencodedPathDelims = "#?[]"
+
+// rawRequestPath returns the path as the client sent it, before decoding, so
+// "%23" can be told apart from a raw "#". When req is nil, path is returned
+// unchanged.
+func rawRequestPath(req *http.Request, path string) string {
+	if req == nil || req.URL == nil {
+		return path
+	}
+	if req.URL.RawPath != "" {
+		return req.URL.RawPath
+	}
+	return req.URL.EscapedPath()
+}
+
+// checkReservedChars returns a *ParameterError when RouterArgs.StrictReservedChars
+// is set and a segment of the raw request path contains one of
+// unencodedPathDelims, naming the parameter whose value the segment holds.
+func (r *Router) checkReservedChars(req *http.Request, route *Route, path string, attempt MatchAttempt) (err error) {
+	var parts []string
+	var index, spanned int
+	var char byte
+	var p Parameter
+
+	if !r.strictReservedChars {
+		goto end
+	}
+
+	parts = strings.Split(rawRequestPath(req, path), "/")
+	index = -1
+	for i, part := range parts {
+		at := strings.IndexAny(part, unencodedPathDelims)
+		if at >= 0 {
+			index, char = i, part[at]
+			break
+		}
+	}
+	if index < 0 {
+		goto end
+	}
+
+	// Segment 0 is the empty text before the leading slash
+	for i, segment := range route.ParsedTemplate.segments {
+		if !segment.IsParameter() {
+			continue
+		}
+		for _, segParam := range segment.Parameters {
+			first := i + 1 + spanned
+			if segParam.MultiSegment {
+				value, _ := attempt.ValuesMap.Get(segParam.Name)
+				spanned += strings.Count(segParam.FormatValue(value), "/")
+			}
+			if index >= first && index <= i+1+spanned {
+				p, _ = route.ParsedTemplate.params.Get(segParam.Name)
+			}
+		}
+	}
+
+	err = NewTemplateError(&ParameterError{
+		Err: NewErr(
+			ErrUnencodedReservedChar,
+			"character", string(char),
+			"segment", parts[index],
+		),
+		ReceivedValue: parts[index],
+		FaultSource:   ClientFaultSource,
+		Parameter:     string(p.Name),
+		ExpectedType:  string(p.DataTypeSlug()),
+		Detail: fmt.Sprintf("Path segment '%s' contains '%c', which must be percent-encoded as %%%02X",
+			parts[index],
+			char,
+			char,
+		),
+		Location:     PathLocation,
+		SegmentIndex: index - 1,
+	}, TemplateErrorArgs{
+		Endpoint:   route.ParsedTemplate.Original(),
+		Source:     path,
+		Location:   PathLocation,
+		Suggestion: fmt.Sprintf("Percent-encode '%c' as %%%02X", char, char),
+		Parameter:  p,
+	})
+
+end:
+	return err
+}
@@ -5,15 +5,29 @@ package pathvars
 
 import (
 	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
 )
 
 // Route represents a compiled HTTP endpoint with its method, template, and routing index.
 // Routes are created during router compilation and used for efficient request matching.
 type Route struct {
 	// Method specifies the HTTP method for this route (GET, POST, etc.).
-	// An empty string means the route matches any HTTP method.
+	// MethodAny ("*") means the route matches any HTTP method, as does an
+	// empty string which is treated as an alias for MethodAny.
 	Method HTTPMethod
 
+	// Host restricts the route to requests whose Host header matches it, either
+	// exactly ("api.example.com") or by wildcard subdomain ("*.example.com").
+	// Ports and letter case are ignored. An empty Host matches any host.
+	Host string
+
 	// ParsedTemplate contains the parsed path template with parameters and regex for matching.
 	ParsedTemplate *ParsedTemplate
 
@@ -25,12 +39,420 @@ type Route struct {
 	Cardinality Cardinality  // Expected number of result rows (one, many, etc.)
 	RowType     DBRowType    // Format for returning results (json, columns, etc.)
 	ColumnTypes []DBDataType // Expected data types for result columns
+
+	// RequireBody causes matching to fail with ErrRequestBodyRequired when the
+	// request has no body, e.g. for POST and PUT routes that expect JSON.
+	RequireBody bool
+
+	// ContentTypes lists the media types (e.g. "application/json") the request
+	// body may use. When non-empty and a body is present, matching fails with
+	// ErrUnsupportedContentType for any other Content-Type. Parameters such as
+	// "; charset=utf-8" are ignored and comparison is case-insensitive.
+	ContentTypes []string
+
+	// Produces lists the media types (e.g. "text/csv") the route can respond
+	// with. When non-empty, the route only matches requests whose Accept header
+	// admits one of them; MatchResult.NegotiatedContentType() reports the
+	// winner. A missing Accept header accepts the first entry.
+	Produces []string
+
+	// RequireAllQuery makes every declared query parameter mandatory, even
+	// optional ones with defaults, so defaults never apply to this route. Each
+	// missing parameter is reported as a *ParameterError.
+	RequireAllQuery bool
+
+	// IgnoreInvalidOptional treats an optional query parameter whose value
+	// fails validation, such as limit=invalid for {limit?20:int}, as if it
+	// were absent, so its default applies and it does not count as provided.
+	// By default the request fails with a *ParameterError.
+	IgnoreInvalidOptional bool
+
+	// ExactlyOne lists groups of parameters, such as {{"id", "email"}}, of
+	// which each request must provide precisely one. Defaults do not count as
+	// provided. Otherwise matching fails with ErrExactlyOneViolated naming the
+	// group's members.
+	ExactlyOne [][]Identifier
+
+	// RequiredWith maps a parameter to those it depends on, such as
+	// {"sort_dir": {"sort_by"}}, so a request providing sort_dir without
+	// sort_by fails with ErrRequiredWithViolated. Defaults do not count as
+	// provided, and a dependent parameter that is absent imposes nothing.
+	RequiredWith map[Identifier][]Identifier
+
+	// Handler serves requests matching this route when the Router itself is
+	// used as an http.Handler via ServeHTTP(). It is not encoded by
+	// Router.MarshalBinary().
+	Handler http.Handler
+
+	// CrossChecks validate relationships between values, such as DateRange(),
+	// after each value has passed its own validation. Matching fails with
+	// ErrCrossCheckFailed wrapping the first check's error.
+	CrossChecks []CrossCheck
+
+	// PathValidator, when set, validates the path's values as a whole after
+	// each segment has matched and validated, before any query checks.
+	// Matching fails with ErrPathValidationFailed wrapping its error.
+	PathValidator PathValidator
+
+	// Responses maps HTTP status codes to example response bodies, e.g.
+	// {200: User{ID: 42}, 404: Problem{...}}, so documentation generators can
+	// describe what the route returns. The router never interprets them, and
+	// like Handler they are not encoded by Router.MarshalBinary().
+	Responses map[int]any
+
+	// ClientCert, when set, restricts the route to requests carrying a
+	// verified TLS client certificate whose subject it accepts. Other
+	// requests move on to the next route, like a Host mismatch, and fail with
+	// ErrClientCertRejected when no route serves them.
+	ClientCert *ClientCertRequirement
+
+	// Priority orders the route among the router's routes: Match() tries
+	// routes by descending Priority, routes of equal Priority by descending
+	// specificity, and equally specific routes in the order they were added.
+	// The default of 0 leaves the order to specificity.
+	Priority int
 }
 
 func (r Route) Endpoint() string {
 	return fmt.Sprintf("%s %s", r.Method, r.ParsedTemplate)
 }
 
+// Args returns RouteArgs that recreate this route when passed to AddRoute()
+// with its Method and template, as Walk() and Mount() do. Its Parameters lists
+// every parameter of the parsed template, including those declared in the
+// template itself.
+func (r Route) Args() RouteArgs {
+	params := make([]Parameter, 0, r.ParsedTemplate.params.Len())
+	for param := range r.ParsedTemplate.params.Values() {
+		params = append(params, param)
+	}
+	return RouteArgs{
+		Parameters:   params,
+		Index:        r.Index,
+		Description:  r.Description,
+		Cardinality:  r.Cardinality,
+		RowType:      r.RowType,
+		ColumnTypes:  r.ColumnTypes,
+		RequireBody:  r.RequireBody,
+		ContentTypes: r.ContentTypes,
+		Produces:     r.Produces,
+
+		RequireAllQuery:       r.RequireAllQuery,
+		IgnoreInvalidOptional: r.IgnoreInvalidOptional,
+		ExactlyOne:            r.ExactlyOne,
+		RequiredWith:          r.RequiredWith,
+
+		Handler:       r.Handler,
+		CrossChecks:   r.CrossChecks,
+		PathValidator: r.PathValidator,
+
+		Host: r.Host,
+
+		Responses: r.Responses,
+
+		ClientCert: r.ClientCert,
+
+		Priority: r.Priority,
+	}
+}
+
+// MatchesMethod reports whether the route serves requests using method.
+func (r Route) MatchesMethod(method string) bool {
+	switch r.Method {
+	case MethodAny, "":
+		return true
+	}
+	return r.Method == HTTPMethod(method)
+}
+
+// MatchesHost reports whether host, typically http.Request.Host, satisfies the
+// route's Host. A "*.example.com" Host matches any subdomain of example.com
+// but not example.com itself.
+func (r Route) MatchesHost(host string) bool {
+	if r.Host == "" {
+		return true
+	}
+	host = normalizeHost(host)
+	suffix, wildcard := strings.CutPrefix(r.Host, "*.")
+	if wildcard {
+		return strings.HasSuffix(host, "."+suffix)
+	}
+	return host == r.Host
+}
+
+// normalizeHost lowercases host and removes any port and trailing dot.
+func normalizeHost(host string) string {
+	h, _, err := net.SplitHostPort(host)
+	if err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(host, ".")
+	return strings.ToLower(host)
+}
+
+// parseRouteHost validates and normalizes a host pattern for Route.Host.
+// Only a leading "*." wildcard is supported.
+func parseRouteHost(host string) (_ string, err error) {
+	host = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(host), "."))
+	rest := strings.TrimPrefix(host, "*.")
+	if host == "*" || strings.ContainsAny(rest, "*/:") {
+		err = NewErr(
+			ErrInvalidRouteHost,
+			"host", host,
+		)
+	}
+	return host, err
+}
+
+// crossCheck runs the route's CrossChecks against values.
+func (r Route) crossCheck(values pvtypes.ValuesMap) (err error) {
+	for _, check := range r.CrossChecks {
+		err = check.Check(r.ParsedTemplate, values)
+		if err != nil {
+			err = WithErr(err,
+				ErrCrossCheckFailed,
+				"endpoint", r.Endpoint(),
+			)
+			goto end
+		}
+	}
+end:
+	return err
+}
+
+// requireAllQuery returns an error for each declared query parameter missing
+// from the request when RequireAllQuery is set.
+func (r Route) requireAllQuery(attempt MatchAttempt, rawQuery string) (err error) {
+	var errs []error
+	var userProvided pvtypes.ValuesMap
+	var position int
+
+	if !r.RequireAllQuery {
+		goto end
+	}
+
+	userProvided = pvtypes.NewValuesMap(len(attempt.Provided))
+	for _, name := range attempt.Provided {
+		value, _ := attempt.ValuesMap.Get(name)
+		userProvided.Set(name, value)
+	}
+
+	position = -1
+	for p := range r.ParsedTemplate.params.Values() {
+		if p.Location() != QueryLocation {
+			continue
+		}
+		position++
+		if slices.Contains(attempt.Provided, p.Name) {
+			continue
+		}
+		example := r.ParsedTemplate.Example(&pvtypes.ExampleArgs{
+			ProblematicParam:   p,
+			UserProvidedParams: &userProvided,
+		})
+		errs = append(errs, NewTemplateError(&ParameterError{
+			Err: NewErr(
+				ErrRequiredParameterNotProvided,
+				"parameter_name", p.Name,
+				"require_all_query", true,
+			),
+			FaultSource:  ClientFaultSource,
+			Parameter:    string(p.Name),
+			ExpectedType: string(p.DataTypeSlug()),
+			Detail: fmt.Sprintf("Parameter '%s' was not provided; this endpoint requires every declared query parameter",
+				p.Name,
+			),
+			Location:     QueryLocation,
+			SegmentIndex: position,
+		}, TemplateErrorArgs{
+			Endpoint:   r.ParsedTemplate.Original(),
+			Example:    example,
+			Source:     rawQuery,
+			Location:   QueryLocation,
+			Suggestion: fmt.Sprintf("Provide query parameter '%s' explicitly, for example: %s", p.Name, example),
+			Parameter:  p,
+		}))
+	}
+	err = CombineErrs(errs)
+end:
+	return err
+}
+
+// validateBody checks the request against RequireBody and ContentTypes.
+func (r Route) validateBody(req *http.Request) (err error) {
+	var mediaType string
+
+	if !hasBody(req) {
+		if r.RequireBody {
+			err = NewErr(
+				ErrRequestBodyRequired,
+				"fault_source", ClientFaultSource.Slug(),
+			)
+		}
+		goto end
+	}
+
+	if len(r.ContentTypes) == 0 {
+		goto end
+	}
+
+	mediaType, _, err = mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		err = NewErr(
+			ErrInvalidContentType,
+			"content_type", req.Header.Get("Content-Type"),
+			"fault_source", ClientFaultSource.Slug(),
+			err,
+		)
+		goto end
+	}
+
+	if !slices.ContainsFunc(r.ContentTypes, func(ct string) bool {
+		return strings.EqualFold(ct, mediaType)
+	}) {
+		err = NewErr(
+			ErrUnsupportedContentType,
+			"content_type", mediaType,
+			"allowed_content_types", strings.Join(r.ContentTypes, ","),
+			"fault_source", ClientFaultSource.Slug(),
+		)
+		goto end
+	}
+
+end:
+	if err != nil {
+		err = WithErr(err, "endpoint", r.Endpoint())
+	}
+	return err
+}
+
+// negotiate picks the Produces entry with the highest quality in the request's
+// Accept header, preferring earlier entries on ties. Each entry is weighed by
+// the most specific matching media range, so "text/csv;q=0" excludes text/csv
+// even when "*/*" is also present. A nil req accepts the first entry.
+func (r Route) negotiate(req *http.Request) (contentType string, err error) {
+	var ranges []acceptRange
+	var best float64
+
+	if len(r.Produces) == 0 {
+		goto end
+	}
+
+	if req != nil {
+		ranges = parseAccept(req.Header.Values("Accept"))
+	}
+	if len(ranges) == 0 {
+		contentType = r.Produces[0]
+		goto end
+	}
+
+	for _, produced := range r.Produces {
+		q := acceptQuality(ranges, produced)
+		if q > best {
+			best = q
+			contentType = produced
+		}
+	}
+
+	if contentType == "" {
+		err = NewErr(
+			ErrNotAcceptable,
+			"accept", strings.Join(req.Header.Values("Accept"), ","),
+			"produces", strings.Join(r.Produces, ","),
+			"fault_source", ClientFaultSource.Slug(),
+		)
+		err = WithErr(err, "endpoint", r.Endpoint())
+	}
+
+end:
+	return contentType, err
+}
+
+// acceptRange is one media range of an Accept header, e.g. "text/*;q=0.5".
+type acceptRange struct {
+	typ     string
+	subtype string
+	quality float64
+}
+
+// specificity ranks how closely the range names a media type: 2 for
+// "type/subtype", 1 for "type/*" and 0 for "*/*".
+func (ar acceptRange) specificity() int {
+	switch {
+	case ar.typ == "*":
+		return 0
+	case ar.subtype == "*":
+		return 1
+	}
+	return 2
+}
+
+// matches reports whether the range admits the media type typ/subtype.
+func (ar acceptRange) matches(typ, subtype string) bool {
+	switch {
+	case ar.typ == "*":
+		return true
+	case ar.typ != typ:
+		return false
+	}
+	return ar.subtype == "*" || ar.subtype == subtype
+}
+
+// parseAccept parses Accept header values into media ranges, skipping
+// malformed entries. A missing q parameter means a quality of 1.
+func parseAccept(values []string) (ranges []acceptRange) {
+	for _, value := range values {
+		for _, entry := range strings.Split(value, ",") {
+			var params map[string]string
+			var mediaType string
+			var err error
+
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			mediaType, params, err = mime.ParseMediaType(entry)
+			if err != nil {
+				continue
+			}
+			typ, subtype, ok := strings.Cut(mediaType, "/")
+			if !ok {
+				continue
+			}
+			ar := acceptRange{typ: typ, subtype: subtype, quality: 1}
+			if q, ok := params["q"]; ok {
+				ar.quality, err = strconv.ParseFloat(q, 64)
+				if err != nil {
+					continue
+				}
+			}
+			ranges = append(ranges, ar)
+		}
+	}
+	return ranges
+}
+
+// acceptQuality returns the quality the most specific matching range assigns
+// to mediaType, or 0 when no range matches.
+func acceptQuality(ranges []acceptRange, mediaType string) (quality float64) {
+	specificity := -1
+	typ, subtype, _ := strings.Cut(strings.ToLower(mediaType), "/")
+	for _, ar := range ranges {
+		if !ar.matches(typ, subtype) || ar.specificity() <= specificity {
+			continue
+		}
+		specificity = ar.specificity()
+		quality = ar.quality
+	}
+	return quality
+}
+
+// hasBody reports whether req carries a request body. A ContentLength of -1
+// means unknown (e.g. chunked), which counts as having a body.
+func hasBody(req *http.Request) bool {
+	return req.Body != nil && req.Body != http.NoBody && req.ContentLength != 0
+}
+
 type Cardinality string
 type DBRowType string
 type DBDataType string
@@ -0,0 +1,178 @@
+package pathvars
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+)
+
+// RouteConfig is one route of a JSON route config loaded by
+// Router.LoadRoutesJSON(), e.g.
+//
+//	{"method": "GET", "template": "/users/{id:int}", "description": "Get user"}
+type RouteConfig struct {
+	Method      HTTPMethod `json:"method"`
+	Template    Template   `json:"template"`
+	Description string     `json:"description,omitempty"`
+	Host        string     `json:"host,omitempty"`
+	Priority    int        `json:"priority,omitempty"`
+
+	RequireBody     bool     `json:"require_body,omitempty"`
+	ContentTypes    []string `json:"content_types,omitempty"`
+	Produces        []string `json:"produces,omitempty"`
+	RequireAllQuery bool     `json:"require_all_query,omitempty"`
+
+	IgnoreInvalidOptional bool `json:"ignore_invalid_optional,omitempty"`
+
+	ExactlyOne   [][]Identifier              `json:"exactly_one,omitempty"`
+	RequiredWith map[Identifier][]Identifier `json:"required_with,omitempty"`
+}
+
+// args returns the RouteArgs the config describes.
+func (rc RouteConfig) args() *RouteArgs {
+	return &RouteArgs{
+		Description: rc.Description,
+		Host:        rc.Host,
+		Priority:    rc.Priority,
+
+		RequireBody:     rc.RequireBody,
+		ContentTypes:    rc.ContentTypes,
+		Produces:        rc.Produces,
+		RequireAllQuery: rc.RequireAllQuery,
+
+		IgnoreInvalidOptional: rc.IgnoreInvalidOptional,
+
+		ExactlyOne:   rc.ExactlyOne,
+		RequiredWith: rc.RequiredWith,
+	}
+}
+
+// ConfigError reports a route config entry that could not be loaded, with
+// the 1-based line of its template, or of the JSON syntax error, so the
+// offending line of a config file can be shown.
+type ConfigError struct {
+	// Line is the 1-based line number in the config source.
+	Line int
+
+	// Template is the raw template text of the entry, empty for JSON errors
+	// that occur before a template could be read.
+	Template string
+
+	// Err is the underlying error, e.g. from ParseTemplate().
+	Err error
+}
+
+func (e *ConfigError) Error() string {
+	if e.Template == "" {
+		return fmt.Sprintf("route config line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("route config line %d: template %q: %v", e.Line, e.Template, e.Err)
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// templateKeyRegex finds the "template" key of a route config entry.
+var templateKeyRegex = regexp.MustCompile(`"template"\s*:`)
+
+// LoadRoutesJSON adds the routes of a JSON array of RouteConfig objects read
+// from rd, in order. It fails with a *ConfigError carrying the line of the
+// first entry whose template does not parse, or of the first JSON syntax
+// error; on error no routes are added.
+func (r *Router) LoadRoutesJSON(rd io.Reader) (err error) {
+	var data []byte
+	var dec *json.Decoder
+	var token json.Token
+	var routes []*Route
+
+	data, err = io.ReadAll(rd)
+	if err != nil {
+		err = NewErr(ErrInvalidRouteConfig, err)
+		goto end
+	}
+
+	dec = json.NewDecoder(bytes.NewReader(data))
+	token, err = dec.Token()
+	if err != nil {
+		err = configJSONError(data, dec, err)
+		goto end
+	}
+	if token != json.Delim('[') {
+		err = &ConfigError{
+			Line: lineAt(data, int(dec.InputOffset())),
+			Err:  NewErr(ErrInvalidRouteConfig, ErrRouteConfigNotArray),
+		}
+		goto end
+	}
+
+	routes = slices.Clone(r.routes)
+	for dec.More() {
+		var rc RouteConfig
+
+		start := int(dec.InputOffset())
+		err = dec.Decode(&rc)
+		if err != nil {
+			err = configJSONError(data, dec, err)
+			goto end
+		}
+		err = r.AddRoute(rc.Method, rc.Template, rc.args())
+		if err != nil {
+			err = &ConfigError{
+				Line:     templateLine(data, start, int(dec.InputOffset())),
+				Template: string(rc.Template),
+				Err:      err,
+			}
+			goto end
+		}
+	}
+
+end:
+	if err != nil && routes != nil {
+		r.routes = routes
+	}
+	return err
+}
+
+// configJSONError wraps a decoding error in a ConfigError at the line where
+// decoding stopped.
+func configJSONError(data []byte, dec *json.Decoder, err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	offset := int(dec.InputOffset())
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = int(syntaxErr.Offset)
+	case errors.As(err, &typeErr):
+		offset = int(typeErr.Offset)
+	}
+	return &ConfigError{
+		Line: lineAt(data, offset),
+		Err:  NewErr(ErrInvalidRouteConfig, err),
+	}
+}
+
+// templateLine returns the line of the "template" key within the entry
+// spanning data[start:end], or of the entry itself when it has none.
+func templateLine(data []byte, start, end int) int {
+	loc := templateKeyRegex.FindIndex(data[start:end])
+	if loc == nil {
+		// Skip the separator and whitespace before the entry
+		for start < end && bytes.IndexByte([]byte(", \t\r\n"), data[start]) >= 0 {
+			start++
+		}
+		return lineAt(data, start)
+	}
+	return lineAt(data, start+loc[0])
+}
+
+// lineAt returns the 1-based line number of offset within data.
+func lineAt(data []byte, offset int) int {
+	offset = min(max(offset, 0), len(data))
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
@@ -0,0 +1,16 @@
+package pathvars
+
+// RoutesByFirstSegment groups the router's routes by their leading literal
+// segment as reported by ParsedTemplate.FirstLiteral(), e.g. "users" for
+// "/users/{id}", so callers can build their own dispatch or documentation
+// grouped by resource. Routes starting with a parameter, such as
+// "/{category}/items", and the root route "/" are grouped under "". Each
+// group lists its routes in the order Match() tries them.
+func (r *Router) RoutesByFirstSegment() map[string][]RouteInfo {
+	groups := make(map[string][]RouteInfo)
+	for _, route := range r.routes {
+		literal, _ := route.ParsedTemplate.FirstLiteral()
+		groups[literal] = append(groups[literal], RouteInfo{Route: route})
+	}
+	return groups
+}
@@ -39,6 +39,10 @@ package pathvars
 
 import (
 	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
 )
 
 // PathSpec represents a path specification string like "GET /users/{id}" or "/users/{id}".
@@ -50,18 +54,133 @@ type Method string
 // Path represents a URL path string like "/users/{id}".
 type Path string
 
+// MethodAny is the wildcard method for routes that match every HTTP method,
+// e.g. health checks. An empty method is accepted as an alias for MethodAny.
+const MethodAny HTTPMethod = "*"
+
 // Router holds routes and provides request matching functionality.
 // Routes are compiled as they are added via AddRoute().
 type Router struct {
-	routes    []*Route
-	maxParams int
+	routes       []*Route
+	maxParams    int
+	errorHandler func(http.ResponseWriter, *http.Request, error)
+	language     string
+	copyValues   bool
+
+	trimQueryValues  bool
+	matrixParameters bool
+
+	ignoreTrailingSlash bool
+	caseInsensitive     bool
+
+	allowedMethods []HTTPMethod
+	syntax         TemplateSyntax
+	maxValuesSize  int
+
+	strictReservedChars bool
+	describeMismatch    bool
 }
 
-// NewRouter creates a new router instance.
-func NewRouter() *Router {
-	return &Router{
-		routes: make([]*Route, 0),
+// RouterArgs holds optional configuration for NewRouter().
+type RouterArgs struct {
+	// ErrorHandler renders the response when ServeHTTP() or Handler() cannot
+	// serve a request because matching failed. Defaults to DefaultErrorHandler.
+	ErrorHandler func(http.ResponseWriter, *http.Request, error)
+
+	// Language selects the message catalog registered via SetMessages() used
+	// for error details and suggestions, e.g. "fr". A language set on the
+	// request context with WithLanguage() takes precedence.
+	Language string
+
+	// CopyValues clones each extracted string value into a fresh allocation.
+	// By default values are substrings of the request's path and query, so a
+	// long-lived MatchResult keeps the whole request string alive; set this
+	// when results are cached or batched to avoid retaining them.
+	CopyValues bool
+
+	// TrimQueryValues strips leading and trailing whitespace from query values
+	// before they are validated and stored, so "?sort= name " matches as
+	// "name". Path values are never trimmed.
+	TrimQueryValues bool
+
+	// MatrixParameters strips RFC 3986 matrix parameters, the ";key=value"
+	// pairs after a path segment, before matching, and stores each as a value
+	// named after its segment, so "/users/123;role=admin" matches
+	// "/users/{id}" with id "123" and "id.role" "admin". A literal segment
+	// uses its own text, as in "users.v" for "/users;v=2/{id}".
+	MatrixParameters bool
+
+	// IgnoreTrailingSlash lets a path with a trailing slash, such as
+	// "/users/", match a route written without one when no route matches it
+	// as is. MatchResult.CanonicalPath() reports the path without the slash
+	// so handlers can redirect to it.
+	IgnoreTrailingSlash bool
+
+	// CaseInsensitive lets the literal text of templates match in any case,
+	// so "/Users/Ada" matches "/users/{name}" with name "Ada"; parameter
+	// values keep their case. Routes are still tried in order, so an earlier
+	// route matching only when folded wins over a later exact one, and
+	// MatchResult.CanonicalPath() reports the matched template's spelling.
+	CaseInsensitive bool
+
+	// AllowedMethods, when non-empty, is a router-wide allow-list checked
+	// before any route: a request with any other method fails with
+	// ErrMethodNotAllowed, e.g. to keep a read-only gateway to GET and HEAD.
+	AllowedMethods []HTTPMethod
+
+	// Syntax selects the template syntax accepted by AddRoute(). Set it to
+	// ColonSyntax to accept Express/Gin-style "/users/:id" routes alongside
+	// the native brace syntax.
+	Syntax TemplateSyntax
+
+	// MaxValuesSize, when positive, caps the combined size in bytes of the
+	// values a request provides, so many large multi-segment values cannot
+	// tie up memory. It is checked against the raw path and query string,
+	// which hold every value, before any route is tried, so an oversized
+	// request fails with ErrValuesTooLarge, a client fault, without its
+	// values being extracted or validated.
+	MaxValuesSize int
+
+	// StrictReservedChars rejects requests whose raw path has a segment
+	// containing a reserved character that must be percent-encoded there,
+	// such as "#" or "[", with a *ParameterError wrapping
+	// ErrUnencodedReservedChar, a client fault. By default such characters
+	// are matched literally.
+	StrictReservedChars bool
+
+	// DescribeMismatch is a debugging aid: when no route matches, the error
+	// also wraps ErrPathMismatch explaining where the path first diverges
+	// from the closest route's template: ErrSegmentCountMismatch, e.g. for
+	// "/users/123/extra" against "/users/{id:int}", or
+	// ErrSegmentLiteralMismatch with the "segment_index" of the differing
+	// literal. It costs a second pass over the routes, so leave it off in
+	// production.
+	DescribeMismatch bool
+}
+
+// NewRouter creates a new router instance, optionally configured by args.
+func NewRouter(args ...*RouterArgs) *Router {
+	r := &Router{
+		routes:       make([]*Route, 0),
+		errorHandler: DefaultErrorHandler,
 	}
+	if len(args) != 0 && args[0] != nil {
+		if args[0].ErrorHandler != nil {
+			r.errorHandler = args[0].ErrorHandler
+		}
+		r.language = args[0].Language
+		r.copyValues = args[0].CopyValues
+		r.trimQueryValues = args[0].TrimQueryValues
+		r.matrixParameters = args[0].MatrixParameters
+		r.ignoreTrailingSlash = args[0].IgnoreTrailingSlash
+		r.caseInsensitive = args[0].CaseInsensitive
+		r.allowedMethods = args[0].AllowedMethods
+		r.maxValuesSize = args[0].MaxValuesSize
+		r.strictReservedChars = args[0].StrictReservedChars
+		r.describeMismatch = args[0].DescribeMismatch
+		r.syntax = args[0].Syntax
+	}
+	return r
 }
 
 type RouteArgs struct {
@@ -71,18 +190,63 @@ type RouteArgs struct {
 	Cardinality Cardinality  // Expected number of result rows (one, many, etc.)
 	RowType     DBRowType    // Format for returning results (json, columns, etc.)
 	ColumnTypes []DBDataType // Expected data types for result columns
+
+	RequireBody  bool     // Fail matching if the request has no body
+	ContentTypes []string // Allowed request body media types, e.g. "application/json"
+	Produces     []string // Response media types offered, negotiated against the Accept header
+
+	RequireAllQuery bool // Require every declared query parameter, ignoring defaults
+
+	IgnoreInvalidOptional bool // Treat an invalid optional query value as absent so its default applies
+
+	ExactlyOne [][]Identifier // Groups of which a request must provide precisely one, e.g. {{"id", "email"}}
+
+	RequiredWith map[Identifier][]Identifier // Parameters valid only alongside others, e.g. {"sort_dir": {"sort_by"}}
+
+	Handler http.Handler // Serves matched requests when the Router is used as an http.Handler
+
+	CrossChecks []CrossCheck // Checks spanning several values, e.g. DateRange("from", "to")
+
+	PathValidator PathValidator // Validates the path's values as a whole, e.g. that /{y}/{m}/{d} is a real date
+
+	Host string // Host header to match, e.g. "api.example.com" or "*.example.com"; empty matches any
+
+	Responses map[int]any // Example response body per HTTP status, for generated docs only
+
+	ClientCert *ClientCertRequirement // Verified TLS client certificate subject to require, for mTLS routing
+
+	Priority int // Higher priorities are tried first; equal priorities go by specificity, then registration order
 }
 
 // AddRoute adds a route to the router with the specified path specification and parameters.
 // The pathSpec can be in format "METHOD /path" (e.g., "GET /users/{id}") or just "/path"
 // for any method. Parameters define the expected path and query parameters for this route.
-func (r *Router) AddRoute(method HTTPMethod, path Template, args *RouteArgs) (err error) {
+// An Index of 0 is taken as unset and replaced by the number of routes already added.
+func (r *Router) AddRoute(method HTTPMethod, path Template, args *RouteArgs) error {
+	if args == nil {
+		args = &RouteArgs{}
+	}
+	if args.Index == 0 {
+		args.Index = len(r.routes)
+	}
+	return r.addRoute(method, path, args)
+}
+
+// addRoute adds a route like AddRoute() but uses args.Index as given, so
+// Mount() can add a route whose Index is 0.
+func (r *Router) addRoute(method HTTPMethod, path Template, args *RouteArgs) (err error) {
 	var pt *ParsedTemplate
 	var route *Route
 	var paramCount int
+	var host string
 
-	if args == nil {
-		args = &RouteArgs{}
+	host, err = parseRouteHost(args.Host)
+	if err != nil {
+		err = WithErr(err,
+			"method", method,
+			"path", path,
+		)
+		goto end
 	}
 
 	if path == "" {
@@ -94,7 +258,7 @@ func (r *Router) AddRoute(method HTTPMethod, path Template, args *RouteArgs) (er
 		path = "/" + path
 	}
 
-	pt, err = ParseTemplate(string(path))
+	pt, err = ParseTemplateWithSyntax(string(path), r.syntax)
 	if err != nil {
 		err = WithErr(
 			err,
@@ -118,14 +282,59 @@ func (r *Router) AddRoute(method HTTPMethod, path Template, args *RouteArgs) (er
 	if len(args.Parameters) != 0 {
 		for _, param := range args.Parameters {
 			// Only add if not already present (don't overwrite path parameters)
-			_, exists := pt.params.Get(param.Name)
+			existing, exists := pt.params.Get(param.Name)
 			if exists {
+				// A pre-compiled regex, default func or async validator still applies to a template-declared parameter
+				if param.Regexp() != nil {
+					existing = existing.WithRegexp(param.Regexp())
+				}
+				if param.DefaultFunc() != nil {
+					existing = existing.WithDefaultFunc(param.DefaultFunc())
+				}
+				if param.AsyncValidator() != nil {
+					existing = existing.WithAsyncValidator(param.AsyncValidator(), param.ValidationCache(), param.AsyncTimeout())
+				}
+				if param.Separator() != MultiSegmentSeparator {
+					existing = existing.WithSeparator(param.Separator())
+				}
+				if param.Description() != "" {
+					existing = existing.WithDescription(param.Description())
+				}
+				if param.ErrorMessage() != "" {
+					existing = existing.WithErrorMessage(param.ErrorMessage())
+				}
+				if param.AllowNonFinite() {
+					existing = existing.WithAllowNonFinite(true)
+				}
+				pt.params.Set(param.Name, existing)
 				continue
 			}
 			pt.params.Set(param.Name, param)
 		}
 	}
 
+	err = checkRegexpsApplied(pt)
+	if err == nil {
+		err = checkExactlyOne(pt, args.ExactlyOne)
+	}
+	if err == nil {
+		err = checkRequiredWith(pt, args.RequiredWith)
+	}
+	if err == nil {
+		err = checkCrossChecks(pt, args.CrossChecks)
+	}
+	if err != nil {
+		err = WithErr(err,
+			"method", method,
+			"path", path,
+		)
+		goto end
+	}
+
+	pt.trimQueryValues = r.trimQueryValues
+	pt.matrixParameters = r.matrixParameters
+	pt.ignoreInvalidOptional = args.IgnoreInvalidOptional
+
 	paramCount = pt.params.Len()
 	if paramCount != 0 {
 		// Track max params for optimization
@@ -134,78 +343,505 @@ func (r *Router) AddRoute(method HTTPMethod, path Template, args *RouteArgs) (er
 		}
 	}
 
-	if args.Index == 0 {
-		args.Index = len(r.routes)
-	}
-
 	route = &Route{
 		Method:         method,
+		Host:           host,
 		ParsedTemplate: pt,
 		Index:          args.Index,
 		Description:    args.Description,
 		Cardinality:    args.Cardinality,
 		RowType:        args.RowType,
 		ColumnTypes:    args.ColumnTypes,
+		RequireBody:    args.RequireBody,
+		ContentTypes:   args.ContentTypes,
+		Produces:       args.Produces,
+
+		RequireAllQuery:       args.RequireAllQuery,
+		IgnoreInvalidOptional: args.IgnoreInvalidOptional,
+		ExactlyOne:            args.ExactlyOne,
+		RequiredWith:          args.RequiredWith,
+
+		Handler:       args.Handler,
+		CrossChecks:   args.CrossChecks,
+		PathValidator: args.PathValidator,
+
+		Responses: args.Responses,
+
+		ClientCert: args.ClientCert,
+
+		Priority: args.Priority,
+	}
+
+	r.insertRoute(route)
+
+end:
+	return err
+}
+
+// checkRegexpsApplied returns ErrRegexpNotApplied for the first of pt's
+// parameters with a ParameterArgs.Regex that none of its constraints enforces,
+// which WithRegexp() leaves when the registered regex constraint cannot take a
+// pre-compiled pattern.
+func checkRegexpsApplied(pt *ParsedTemplate) (err error) {
+	for p := range pt.params.Values() {
+		re := p.Regexp()
+		if re == nil {
+			continue
+		}
+		applied := slices.ContainsFunc(p.Constraints(), func(c Constraint) bool {
+			return c.Type() == RegexConstraintType && c.Rule() == re.String()
+		})
+		if applied {
+			continue
+		}
+		err = NewErr(
+			ErrRegexpNotApplied,
+			"parameter_name", p.Name,
+			"regex", re.String(),
+		)
+		goto end
+	}
+end:
+	return err
+}
+
+// insertRoute adds route after every route with a higher Priority and before
+// the first route of equal Priority with a less specific template, or after
+// the last one when there is none, keeping r.routes in the order Match() tries
+// them. Route never moves ahead of an equal-Priority route that is more
+// specific than it, since compareSpecificity() only orders routes that may
+// overlap and moving past one could shadow it.
+func (r *Router) insertRoute(route *Route) {
+	i := len(r.routes)
+	for i > 0 && r.routes[i-1].Priority < route.Priority {
+		i--
+	}
+	for j := i - 1; j >= 0 && r.routes[j].Priority == route.Priority; j-- {
+		cmp := compareSpecificity(route.ParsedTemplate, r.routes[j].ParsedTemplate)
+		if cmp < 0 {
+			break
+		}
+		if cmp > 0 {
+			i = j
+		}
+	}
+	r.routes = slices.Insert(r.routes, i, route)
+}
+
+// AddHostRoute adds a route like AddRoute() that only matches requests whose
+// Host header is host, either exactly or, for a pattern like "*.example.com",
+// as any subdomain. Equally specific routes of equal Priority match in the
+// order they were added, so add host routes before a host-agnostic route for
+// the same path; a request for any other host then falls through to the
+// host-agnostic route.
+func (r *Router) AddHostRoute(host string, method HTTPMethod, path Template, args *RouteArgs) error {
+	hostArgs := RouteArgs{}
+	if args != nil {
+		hostArgs = *args
+	}
+	hostArgs.Host = host
+	return r.AddRoute(method, path, &hostArgs)
+}
+
+// Mount adds every route of sub to r under prefix, so a sub-router registering
+// "/users/{id}" mounted at "/admin" matches "/admin/users/{id}", and its "/"
+// route matches "/admin". The prefix may declare parameters of its own, e.g.
+// "/orgs/{org}", but not a query string.
+// Handlers and all other RouteArgs are preserved, and each route's Index is
+// offset by the number of routes r had before mounting so the indices of
+// parent and mounted routes do not collide. Mounted routes are ordered among
+// r's routes as if added one by one, matching after routes already added to r
+// of the same Priority and specificity. Routes are copied when Mount is
+// called, so routes added to sub afterwards are not seen by r; on error no
+// routes are added.
+func (r *Router) Mount(prefix Template, sub *Router) (err error) {
+	var offset int
+	var routes []*Route
+
+	if strings.Contains(string(prefix), "?") {
+		err = NewErr(
+			ErrInvalidMountPrefix,
+			"prefix", prefix,
+		)
+		goto end
+	}
+	if prefix != "" && prefix[0] != '/' {
+		prefix = "/" + prefix
 	}
+	prefix = Template(strings.TrimRight(string(prefix), "/"))
 
-	r.routes = append(r.routes, route)
+	offset = len(r.routes)
+	// Mounted routes may be inserted anywhere by Priority, so keep the
+	// original list to restore on error
+	routes = slices.Clone(r.routes)
+	// Add in Index order so routes of equal Priority and specificity keep the
+	// order they were added to sub in
+	for _, route := range slices.SortedStableFunc(slices.Values(sub.routes), compareRouteIndex) {
+		var args RouteArgs
+		var path Template
+
+		path = Template(route.ParsedTemplate.Original())
+		// A sub-router's root "/" maps to the prefix itself, not "prefix/"
+		if path == "/" || strings.HasPrefix(string(path), "/?") {
+			path = path[1:]
+		}
+		path = prefix + path
+
+		args = route.Args()
+		args.Index = offset + route.Index
+		err = r.addRoute(route.Method, path, &args)
+		if err != nil {
+			err = WithErr(err,
+				"mount_prefix", prefix,
+			)
+			r.routes = routes
+			goto end
+		}
+	}
 
 end:
 	return err
 }
 
+// compareRouteIndex orders routes by their Index.
+func compareRouteIndex(a, b *Route) int {
+	return a.Index - b.Index
+}
+
 // Match matches an HTTP request against the routes and returns
 // the first matching route along with extracted parameter values.
-// Routes match in the order they were added, giving users control
-// over matching priority.
-// Returns ErrNoMatch if no route matches the request.
+// Routes are tried by descending RouteArgs.Priority; among equal priorities
+// the more specific template is tried first, so a literal "/users/me" is
+// reached even when added after "/users/{id}", and templates that are equally
+// specific are tried in the order they were added. A higher Priority
+// overrides specificity.
+// A route whose Produces set does not intersect the request's Accept header
+// is skipped so a later route for the same path can serve the request; if no
+// route is acceptable the error wraps ErrNotAcceptable.
+// When RouterArgs.AllowedMethods is set, a request whose method is not listed
+// fails with ErrMethodNotAllowed before any route is tried.
+// Returns ErrNoMatch if no route matches the request, also wrapping
+// ErrMethodNotAllowed, with the routes' methods as "allowed_methods", when
+// routes match the path but none serve the request's method.
 func (r *Router) Match(req *http.Request) (result MatchResult, err error) {
+	return r.match(req, nil)
+}
 
+// match implements Match(), recording each route's async validation outcome
+// in asyncErrs when it is not nil.
+func (r *Router) match(req *http.Request, asyncErrs map[*Route]error) (result MatchResult, err error) {
 	u := req.URL
+	result, err = r.matchRoutes(req, req.Method, u.Path, u.RawQuery, asyncErrs)
+	return result, r.matchError(req, err, result, req.Method, u.Path, u.RawQuery)
+}
+
+// MatchPath matches a method and path, optionally followed by "?query", where
+// no *http.Request is available, e.g. when classifying paths from access logs.
+// Checks that need a request are skipped: RequireBody and ContentTypes are not
+// enforced, routes with Produces negotiate as if no Accept header was sent,
+// and routes with a Host or ClientCert match regardless of host and
+// certificate.
+// Returns ErrNoMatch if no route matches.
+func (r *Router) MatchPath(method HTTPMethod, path string) (result MatchResult, err error) {
+	path, rawQuery, _ := strings.Cut(path, "?")
+	result, err = r.matchRoutes(nil, string(method), path, rawQuery, nil)
+	return result, r.matchError(nil, err, result, string(method), path, rawQuery)
+}
+
+// MatchBatch matches many paths against the router using MatchPath()
+// semantics, returning one MatchResult per path in the same order. It is
+// intended for bulk classification where throughput matters more than error
+// detail: no errors are built, and a path that matches no route or fails
+// validation yields a zero MatchResult whose Route is nil.
+func (r *Router) MatchBatch(method HTTPMethod, paths []string) []MatchResult {
+	results := make([]MatchResult, len(paths))
+	for i, path := range paths {
+		path, rawQuery, _ := strings.Cut(path, "?")
+		result, err := r.matchRoutes(nil, string(method), path, rawQuery, nil)
+		if err != nil {
+			continue
+		}
+		results[i] = result
+	}
+	return results
+}
+
+// matchRoutes finds the first route matching method, path and rawQuery. req
+// is nil when matching without a request, which skips body validation and
+// negotiates content as if no Accept header was sent. When no route matches,
+// both result.Route and err are nil so callers that do not report errors,
+// such as MatchBatch(), avoid building them. When asyncErrs is not nil the
+// outcome of each route's async validation is recorded in it, so Explain()
+// can report it without repeating the lookups.
+func (r *Router) matchRoutes(req *http.Request, method, path, rawQuery string, asyncErrs map[*Route]error) (result MatchResult, err error) {
+	var notAcceptable, certRejected error
+
+	if !r.allowsMethod(method) {
+		err = NewErr(
+			ErrMethodNotAllowed,
+			"fault_source", ClientFaultSource.Slug(),
+			"allowed_methods", joinMethods(r.allowedMethods),
+		)
+		goto end
+	}
+
+	err = r.checkValuesSize(path, rawQuery)
+	if err != nil {
+		goto end
+	}
 
 	for _, route := range r.routes {
-		// Check method match (empty method means any)
-		if route.Method != "" && route.Method != HTTPMethod(req.Method) {
+		if !route.MatchesMethod(method) {
+			continue
+		}
+
+		if req != nil && !route.MatchesHost(req.Host) {
+			continue
+		}
+
+		// Cheap regex test first so non-matching routes cost no allocations
+		canonical, ok := r.matchingPath(route, path)
+		if !ok {
 			continue
 		}
 
+		if req != nil {
+			// Checked before values so unauthorized clients see no validation errors
+			certErr := route.checkClientCert(req)
+			if certErr != nil {
+				if certRejected == nil {
+					certRejected = certErr
+				}
+				continue
+			}
+		}
+
 		var attempt MatchAttempt
-		attempt, err = route.ParsedTemplate.Match(u.Path, u.RawQuery)
+		attempt, err = route.ParsedTemplate.Match(canonical, rawQuery)
 
 		// If path didn't match, try next route (ignore any errors)
 		//goland:noinspection GoDfaErrorMayBeNotNil
 		if attempt.ShouldContinue() {
+			err = nil
 			continue
 		}
 
+		var contentType string
+		var negErr error
+		contentType, negErr = route.negotiate(req)
+		if negErr != nil {
+			// Keep looking for a route that produces an acceptable type
+			if notAcceptable == nil {
+				notAcceptable = negErr
+			}
+			err = nil
+			continue
+		}
+
+		// Checked before validation errors so malformed requests are
+		// rejected as such
+		requestErr := r.checkReservedChars(req, route, canonical, attempt)
+		if requestErr != nil {
+			err = requestErr
+			goto end
+		}
+
 		// Path matched - if there's an error, it's a validation failure
 		if err != nil {
 			goto end
 		}
 
+		err = route.validatePath(attempt.ValuesMap)
+		if err != nil {
+			goto end
+		}
+
+		err = route.requireAllQuery(attempt, rawQuery)
+		if err != nil {
+			goto end
+		}
+
+		err = route.requireExactlyOne(attempt.Provided)
+		if err != nil {
+			goto end
+		}
+
+		err = route.requireWith(attempt.Provided)
+		if err != nil {
+			goto end
+		}
+
+		err = route.crossCheck(attempt.ValuesMap)
+		if err != nil {
+			goto end
+		}
+
+		if req != nil {
+			err = route.validateBody(req)
+			if err != nil {
+				goto end
+			}
+		}
+
+		err = route.validateAsync(requestContext(req), attempt.ValuesMap)
+		if asyncErrs != nil {
+			asyncErrs[route] = err
+		}
+		if err != nil {
+			goto end
+		}
+
+		if r.copyValues {
+			cloneValues(attempt.ValuesMap)
+			rawQuery = strings.Clone(rawQuery)
+			canonical = strings.Clone(canonical)
+		}
+
 		// Path matched and validation passed - success
 		result = MatchResult{
-			Index:     route.Index,
-			Route:     route,
-			valuesMap: attempt.ValuesMap,
+			Index:       route.Index,
+			Route:       route,
+			valuesMap:   attempt.ValuesMap,
+			rawQuery:    rawQuery,
+			provided:    attempt.Provided,
+			contentType: contentType,
+			clientCert:  verifiedClientCert(req),
+
+			canonicalPath: canonical,
+			normalized:    canonical != path,
 		}
 		goto end
 	}
 
-	err = NewErr(
-		ErrNoRouteMatched,
-		"fault_source", ClientFaultSource.Slug(),
-	)
+	err = notAcceptable
+	if certRejected != nil {
+		err = certRejected
+	}
+
+end:
+	return result, err
+}
+
+// allowsMethod reports whether method passes RouterArgs.AllowedMethods, which
+// allows every method when empty.
+func (r *Router) allowsMethod(method string) bool {
+	return len(r.allowedMethods) == 0 || slices.Contains(r.allowedMethods, HTTPMethod(method))
+}
+
+// methodMismatch returns ErrMethodNotAllowed listing the methods of the routes
+// whose host and path fit the request when none of them serves method, or nil
+// when no route fits or one does and failed for another reason.
+func (r *Router) methodMismatch(req *http.Request, method, path string) (err error) {
+	var methods []HTTPMethod
 
+	for _, route := range r.routes {
+		if req != nil && !route.MatchesHost(req.Host) {
+			continue
+		}
+		_, ok := r.matchingPath(route, path)
+		if !ok {
+			continue
+		}
+		if route.MatchesMethod(method) {
+			methods = nil
+			goto end
+		}
+		if !slices.Contains(methods, route.Method) {
+			methods = append(methods, route.Method)
+		}
+	}
+	if len(methods) == 0 {
+		goto end
+	}
+	err = NewErr(ErrMethodNotAllowed, "allowed_methods", joinMethods(methods))
 end:
+	return err
+}
+
+// joinMethods formats methods as the value of an HTTP Allow header.
+func joinMethods(methods []HTTPMethod) string {
+	names := make([]string, len(methods))
+	for i, method := range methods {
+		names[i] = string(method)
+	}
+	return strings.Join(names, ", ")
+}
+
+// cloneValues replaces each string value in vm with a copy that does not share
+// memory with the request it was extracted from.
+func cloneValues(vm pvtypes.ValuesMap) {
+	for name, value := range vm.Iterator() {
+		switch v := value.(type) {
+		case string:
+			vm.Set(name, strings.Clone(v))
+		case []string:
+			elements := make([]string, len(v))
+			for i, element := range v {
+				elements[i] = strings.Clone(element)
+			}
+			vm.Set(name, elements)
+		}
+	}
+}
+
+// matchError turns the outcome of matchRoutes() into the error returned by
+// Match() and MatchPath(), reporting ErrNoRouteMatched when nothing matched,
+// also wrapping ErrMethodNotAllowed when a route's path fit but its method did not.
+func (r *Router) matchError(req *http.Request, err error, result MatchResult, method, path, rawQuery string) error {
+	if err == nil && result.Route == nil {
+		err = r.methodMismatch(req, method, path)
+		if err == nil && r.describeMismatch {
+			err = r.closestMismatch(req, method, path)
+		}
+		if err != nil {
+			// Enriched rather than joined so ErrValue() finds the error's metadata
+			err = WithErr(err, ErrNoRouteMatched, "fault_source", ClientFaultSource.Slug())
+		} else {
+			err = NewErr(
+				ErrNoRouteMatched,
+				"fault_source", ClientFaultSource.Slug(),
+			)
+		}
+	}
 	if err != nil {
+		r.localizeErrors(req, err)
 		err = WithErr(err,
 			ErrNoMatch,
 			"route_count", len(r.routes),
-			"method", req.Method,
-			"path", u.Path,
-			"query_string", u.RawQuery,
+			"method", method,
+			"path", path,
+			"query_string", rawQuery,
 		)
 	}
+	return err
+}
+
+// MatchInto matches an HTTP request like Match() and, on success, also calls
+// req.SetPathValue() for each extracted value so handlers written against the
+// Go 1.22+ http.ServeMux API can keep reading values via req.PathValue(name).
+// Values are stringified as ToStringMap() does since ServeMux path values are
+// strings, so a list value is joined with its delimiter, e.g. "1,2".
+func (r *Router) MatchInto(req *http.Request) (result MatchResult, err error) {
+	result, err = r.Match(req)
+	if err != nil {
+		goto end
+	}
+	for name, value := range result.ValuesMap().Iterator() {
+		req.SetPathValue(string(name), result.formatValue(name, value))
+	}
+end:
 	return result, err
 }
+
+// Walk calls fn for every route in the order Match() tries them, i.e. by
+// descending Priority, then specificity, then registration order, passing its method,
+// parsed template and equivalent RouteArgs, and stops early when fn returns
+// false. It is intended for generating documentation or for applying
+// cross-cutting registration such as auth or metrics to each route.
+func (r *Router) Walk(fn func(method HTTPMethod, template *ParsedTemplate, args RouteArgs) bool) {
+	for _, route := range r.routes {
+		if !fn(route.Method, route.ParsedTemplate, route.Args()) {
+			break
+		}
+	}
+}
@@ -0,0 +1,421 @@
+package pathvars
+
+import (
+	"bytes"
+	"encoding/gob"
+	"regexp"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// routerEncodingVersion identifies the layout written by Router.MarshalBinary().
+// Bump it whenever encodedRouter or its nested types change incompatibly.
+const routerEncodingVersion = 1
+
+var _ interface {
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary([]byte) error
+} = (*Router)(nil)
+
+// encodedRouter is the gob-encoded form of a Router's route table.
+type encodedRouter struct {
+	Version int
+	Routes  []encodedRoute
+}
+
+// encodedRoute is the gob-encoded form of a Route. Template parameters are
+// restored by re-parsing Template, while Parameters holds only those supplied
+// via RouteArgs.Parameters plus any pre-compiled regex overrides.
+type encodedRoute struct {
+	Method       string
+	Template     string
+	Regex        string
+	Index        int
+	Description  string
+	Cardinality  string
+	RowType      string
+	ColumnTypes  []string
+	RequireBody  bool
+	ContentTypes []string
+	Produces     []string
+	Parameters   []encodedParameter
+
+	RequireAllQuery       bool
+	IgnoreInvalidOptional bool
+	ExactlyOne            [][]Identifier
+	RequiredWith          map[Identifier][]Identifier
+	Host                  string
+	ClientCert            *ClientCertRequirement
+	Priority              int
+}
+
+// encodedParameter is the gob-encoded form of a Parameter. When Declared is true
+// the parameter comes from the template and only Regex, Separator,
+// Description, ErrorMessage and AllowNonFinite need to be restored.
+// Otherwise a non-empty Spec is re-parsed, and failing that the parameter is
+// rebuilt from NameSpec, DataType and Constraints.
+type encodedParameter struct {
+	Name           string
+	Declared       bool
+	Spec           string
+	NameSpec       string
+	Location       string
+	DataType       string
+	Position       int
+	Constraints    []encodedConstraint
+	Regex          string
+	Separator      string
+	Description    string
+	ErrorMessage   string
+	AllowNonFinite bool
+}
+
+// encodedConstraint is the gob-encoded form of a Constraint, re-parsed from its
+// Rule() via the constraint registry.
+type encodedConstraint struct {
+	Type    string
+	Rule    string
+	Negated bool
+}
+
+// MarshalBinary encodes the router's compiled route table so that it can be
+// cached and later restored with UnmarshalBinary(). Route matching regexes are
+// stored as source strings and recompiled on load. The ErrorHandler and route
+// Handlers are not encoded since functions cannot be serialized, and for the
+// same reason routes with a ParameterArgs.DefaultFunc or AsyncValidator fail
+// with ErrParameterNotEncodable and routes with CrossChecks or a
+// PathValidator fail with ErrRouteNotEncodable.
+// Route Responses are documentation only and are also left out, as their
+// example values may be of any type.
+func (r *Router) MarshalBinary() (data []byte, err error) {
+	var buf bytes.Buffer
+
+	er := encodedRouter{
+		Version: routerEncodingVersion,
+		Routes:  make([]encodedRoute, len(r.routes)),
+	}
+	for i, route := range r.routes {
+		er.Routes[i], err = encodeRoute(route)
+		if err != nil {
+			goto end
+		}
+	}
+
+	err = gob.NewEncoder(&buf).Encode(er)
+	if err != nil {
+		err = NewErr(ErrInvalidRouterEncoding, err)
+		goto end
+	}
+	data = buf.Bytes()
+
+end:
+	return data, err
+}
+
+// UnmarshalBinary replaces the router's routes with those encoded by
+// MarshalBinary(). The router's ErrorHandler is left unchanged, or set to
+// DefaultErrorHandler when unset.
+func (r *Router) UnmarshalBinary(data []byte) (err error) {
+	var er encodedRouter
+	var routes []*Route
+	var maxParams int
+
+	err = gob.NewDecoder(bytes.NewReader(data)).Decode(&er)
+	if err != nil {
+		err = NewErr(ErrInvalidRouterEncoding, err)
+		goto end
+	}
+
+	if er.Version != routerEncodingVersion {
+		err = NewErr(
+			ErrUnsupportedRouterEncodingVersion,
+			"version", er.Version,
+			"supported_version", routerEncodingVersion,
+		)
+		goto end
+	}
+
+	routes = make([]*Route, len(er.Routes))
+	for i, encoded := range er.Routes {
+		routes[i], err = decodeRoute(encoded)
+		if err != nil {
+			goto end
+		}
+		routes[i].ParsedTemplate.trimQueryValues = r.trimQueryValues
+		routes[i].ParsedTemplate.matrixParameters = r.matrixParameters
+		routes[i].ParsedTemplate.ignoreInvalidOptional = routes[i].IgnoreInvalidOptional
+		maxParams = max(maxParams, routes[i].ParsedTemplate.params.Len())
+	}
+
+	r.routes = routes
+	r.maxParams = maxParams
+	if r.errorHandler == nil {
+		r.errorHandler = DefaultErrorHandler
+	}
+
+end:
+	if err != nil {
+		err = WithErr(err, ErrInvalidRouterEncoding)
+	}
+	return err
+}
+
+func encodeRoute(route *Route) (er encodedRoute, err error) {
+	var declared *pvtypes.OrderedMap[Identifier, Parameter]
+
+	pt := route.ParsedTemplate
+	if len(route.CrossChecks) != 0 || route.PathValidator != nil {
+		err = NewErr(ErrRouteNotEncodable, "endpoint", route.Endpoint())
+		goto end
+	}
+	_, declared, err = parseSegments(pt.original)
+	if err != nil {
+		goto end
+	}
+
+	er = encodedRoute{
+		Method:       string(route.Method),
+		Template:     pt.original,
+		Regex:        pt.regexSource,
+		Index:        route.Index,
+		Description:  route.Description,
+		Cardinality:  string(route.Cardinality),
+		RowType:      string(route.RowType),
+		ColumnTypes:  stringsOf(route.ColumnTypes),
+		RequireBody:  route.RequireBody,
+		ContentTypes: route.ContentTypes,
+		Produces:     route.Produces,
+
+		RequireAllQuery:       route.RequireAllQuery,
+		IgnoreInvalidOptional: route.IgnoreInvalidOptional,
+		ExactlyOne:            route.ExactlyOne,
+		RequiredWith:          route.RequiredWith,
+		Host:                  route.Host,
+		ClientCert:            route.ClientCert,
+		Priority:              route.Priority,
+	}
+
+	for name, p := range pt.params.Iterator() {
+		if p.DefaultFunc() != nil || p.AsyncValidator() != nil {
+			err = NewErr(ErrParameterNotEncodable, "parameter", name)
+			goto end
+		}
+		_, isDeclared := declared.Get(name)
+		if isDeclared && p.Regexp() == nil && p.Separator() == MultiSegmentSeparator && p.Description() == "" && p.ErrorMessage() == "" && !p.AllowNonFinite() {
+			continue
+		}
+		er.Parameters = append(er.Parameters, encodeParameter(p, isDeclared))
+	}
+
+end:
+	if err != nil {
+		err = WithErr(err, "endpoint", route.Endpoint())
+	}
+	return er, err
+}
+
+func encodeParameter(p Parameter, declared bool) (ep encodedParameter) {
+	ep = encodedParameter{
+		Name:     string(p.Name),
+		Declared: declared,
+		Location: string(p.Location()),
+		Position: p.Position(),
+	}
+	if p.Regexp() != nil {
+		ep.Regex = p.Regexp().String()
+	}
+	if p.Separator() != MultiSegmentSeparator {
+		ep.Separator = p.Separator()
+	}
+	ep.Description = p.Description()
+	ep.ErrorMessage = p.ErrorMessage()
+	ep.AllowNonFinite = p.AllowNonFinite()
+	if declared {
+		goto end
+	}
+	ep.Spec = p.Original()
+	if ep.Spec != "" {
+		goto end
+	}
+	ep.NameSpec = pvtypes.NameSpecProps{
+		Name:         p.Name,
+		MultiSegment: p.MultiSegment,
+		Optional:     p.Optional,
+		DefaultValue: p.DefaultValue,
+	}.String()
+	ep.DataType = string(p.DataTypeSlug())
+	for i, c := range p.Constraints() {
+		if p.Regexp() != nil && i == len(p.Constraints())-1 {
+			// WithRegexp() appended this one; it is restored from Regex instead
+			break
+		}
+		_, negated := c.(*pvtypes.NegatedConstraint)
+		ep.Constraints = append(ep.Constraints, encodedConstraint{
+			Type:    string(c.Type()),
+			Rule:    c.Rule(),
+			Negated: negated,
+		})
+	}
+end:
+	return ep
+}
+
+func decodeRoute(er encodedRoute) (route *Route, err error) {
+	var segments []Segment
+	var params *pvtypes.OrderedMap[Identifier, Parameter]
+	var regex *regexp.Regexp
+	var matcher *segmentMatcher
+
+	segments, params, err = parseSegments(er.Template)
+	if err != nil {
+		goto end
+	}
+
+	regex, matcher, err = compilePathMatcher(er.Regex, segments, params)
+	if err != nil {
+		goto end
+	}
+
+	for _, ep := range er.Parameters {
+		var p Parameter
+		p, err = decodeParameter(ep, params)
+		if err != nil {
+			goto end
+		}
+		params.Set(p.Name, p)
+	}
+
+	route = &Route{
+		Method: HTTPMethod(er.Method),
+		ParsedTemplate: &ParsedTemplate{
+			original:    er.Template,
+			segments:    segments,
+			params:      params,
+			regexSource: er.Regex,
+			regex:       regex,
+			matcher:     matcher,
+		},
+		Index:        er.Index,
+		Description:  er.Description,
+		Cardinality:  Cardinality(er.Cardinality),
+		RowType:      DBRowType(er.RowType),
+		ColumnTypes:  DBDataTypes(er.ColumnTypes),
+		RequireBody:  er.RequireBody,
+		ContentTypes: er.ContentTypes,
+		Produces:     er.Produces,
+
+		RequireAllQuery:       er.RequireAllQuery,
+		IgnoreInvalidOptional: er.IgnoreInvalidOptional,
+		ExactlyOne:            er.ExactlyOne,
+		RequiredWith:          er.RequiredWith,
+		Host:                  er.Host,
+		ClientCert:            er.ClientCert,
+		Priority:              er.Priority,
+	}
+
+end:
+	if err != nil {
+		err = WithErr(err,
+			"method", er.Method,
+			"template", er.Template,
+		)
+	}
+	return route, err
+}
+
+func decodeParameter(ep encodedParameter, declared *pvtypes.OrderedMap[Identifier, Parameter]) (p Parameter, err error) {
+	var re *regexp.Regexp
+	var props *pvtypes.NameSpecProps
+	var dataType PVDataType
+	var constraints []Constraint
+	var exists bool
+
+	if ep.Regex != "" {
+		re, err = regexp.Compile(ep.Regex)
+		if err != nil {
+			goto end
+		}
+	}
+
+	switch {
+	case ep.Declared:
+		p, exists = declared.Get(Identifier(ep.Name))
+		if !exists {
+			err = NewErr(ErrParameterNotFoundInValuesMap)
+			goto end
+		}
+		if re != nil {
+			p = p.WithRegexp(re)
+		}
+		goto end
+	case ep.Spec != "":
+		p, err = ParseParameter(ep.Spec, LocationType(ep.Location))
+		if err != nil {
+			goto end
+		}
+		p = p.WithPosition(ep.Position)
+		if re != nil {
+			p = p.WithRegexp(re)
+		}
+		goto end
+	}
+
+	props, err = pvtypes.ParseNameSpecProps(ep.NameSpec)
+	if err != nil {
+		goto end
+	}
+	dataType, err = pvtypes.ParsePVDataType(ep.DataType)
+	if err != nil {
+		goto end
+	}
+	for _, ec := range ep.Constraints {
+		var c Constraint
+		c, err = pvtypes.GetConstraint(ConstraintType(ec.Type), dataType)
+		if err != nil {
+			goto end
+		}
+		c, err = c.Parse(ec.Rule, dataType)
+		if err != nil {
+			goto end
+		}
+		if ec.Negated {
+			c = pvtypes.NewNegatedConstraint(c)
+		}
+		constraints = append(constraints, c)
+	}
+	p = NewParameter(ParameterArgs{
+		NameProps:   *props,
+		Location:    LocationType(ep.Location),
+		DataType:    dataType,
+		Constraints: constraints,
+		Position:    ep.Position,
+		Regex:       re,
+	})
+
+end:
+	if err == nil && ep.Separator != "" {
+		p = p.WithSeparator(ep.Separator)
+	}
+	if err == nil && ep.Description != "" {
+		p = p.WithDescription(ep.Description)
+	}
+	if err == nil && ep.ErrorMessage != "" {
+		p = p.WithErrorMessage(ep.ErrorMessage)
+	}
+	if err == nil && ep.AllowNonFinite {
+		p = p.WithAllowNonFinite(true)
+	}
+	if err != nil {
+		err = WithErr(err, "parameter", ep.Name)
+	}
+	return p, err
+}
+
+// stringsOf converts a slice of a string-derived type into a []string.
+func stringsOf[S ~string](s []S) (ss []string) {
+	ss = make([]string, len(s))
+	for i, v := range s {
+		ss[i] = string(v)
+	}
+	return ss
+}
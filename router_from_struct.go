@@ -0,0 +1,126 @@
+package pathvars
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// HandlerRoute pairs a route with the handler that serves it, for use with
+// RouterFromStruct().
+type HandlerRoute struct {
+	Method   HTTPMethod
+	Template Template
+
+	// HandlerFunc serves the route. When nil, MethodName names an exported
+	// method of the struct passed to RouterFromStruct() to use instead.
+	HandlerFunc http.HandlerFunc
+
+	// MethodName is the struct method serving the route when HandlerFunc is
+	// nil. The method must have the signature func(http.ResponseWriter, *http.Request).
+	MethodName string
+
+	// Args optionally supplies further RouteArgs; its Handler is ignored.
+	Args *RouteArgs
+}
+
+// HandlerRouteProvider is implemented by handler collections that describe
+// their own routes. Since Go has no method annotations, this list stands in
+// for tagging each handler method with its route.
+type HandlerRouteProvider interface {
+	HandlerRoutes() []HandlerRoute
+}
+
+// RouterFromStruct builds a Router from v, which must be a []HandlerRoute or
+// implement HandlerRouteProvider, registering each route in order with its
+// handler as RouteArgs.Handler. The returned Router serves the collection as
+// an http.Handler.
+//
+//	func (a *API) HandlerRoutes() []pathvars.HandlerRoute {
+//		return []pathvars.HandlerRoute{
+//			{Method: "GET", Template: "/users/{id:int}", HandlerFunc: a.GetUser},
+//			{Method: "DELETE", Template: "/users/{id:int}", MethodName: "DeleteUser"},
+//		}
+//	}
+func RouterFromStruct(v any, args ...*RouterArgs) (r *Router, err error) {
+	var routes []HandlerRoute
+
+	switch t := v.(type) {
+	case []HandlerRoute:
+		routes = t
+	case HandlerRouteProvider:
+		routes = t.HandlerRoutes()
+	default:
+		err = NewErr(
+			ErrNoHandlerRoutes,
+			"type", fmt.Sprintf("%T", v),
+		)
+		goto end
+	}
+
+	r = NewRouter(args...)
+	for _, hr := range routes {
+		var handler http.HandlerFunc
+		var routeArgs RouteArgs
+
+		handler, err = hr.handler(v)
+		if err != nil {
+			goto end
+		}
+		if hr.Args != nil {
+			routeArgs = *hr.Args
+		}
+		routeArgs.Handler = handler
+		err = r.AddRoute(hr.Method, hr.Template, &routeArgs)
+		if err != nil {
+			goto end
+		}
+	}
+
+end:
+	if err != nil {
+		r = nil
+	}
+	return r, err
+}
+
+// handler returns hr.HandlerFunc, or else the method of v named by MethodName.
+func (hr HandlerRoute) handler(v any) (handler http.HandlerFunc, err error) {
+	var method reflect.Value
+	var ok bool
+
+	if hr.HandlerFunc != nil {
+		handler = hr.HandlerFunc
+		goto end
+	}
+
+	if hr.MethodName == "" {
+		err = NewErr(ErrInvalidHandlerRoute, "method", hr.Method, "template", hr.Template)
+		goto end
+	}
+
+	method = reflect.ValueOf(v).MethodByName(hr.MethodName)
+	if !method.IsValid() {
+		err = NewErr(
+			ErrInvalidHandlerRoute,
+			"method_name", hr.MethodName,
+			"type", fmt.Sprintf("%T", v),
+			"template", hr.Template,
+		)
+		goto end
+	}
+
+	handler, ok = method.Interface().(func(http.ResponseWriter, *http.Request))
+	if !ok {
+		err = NewErr(
+			ErrInvalidHandlerRoute,
+			"method_name", hr.MethodName,
+			"signature", method.Type().String(),
+			"template", hr.Template,
+		)
+		goto end
+	}
+
+end:
+	return handler, err
+}
@@ -8,12 +8,15 @@ import (
 )
 
 // Segment represents a part of the path template, either a literal string
-// or a parameter placeholder like {id:int}. Segments are used during
-// template parsing and regex generation.
+// or one or more parameter placeholders like {id:int} or {id:int}.{ext}.
+// Segments are used during template parsing and regex generation.
 type Segment struct {
-	Raw         string
-	Prefix      string
-	Suffix      string
+	Raw    string
+	Prefix string
+	Suffix string
+	// Infixes holds the literal text between consecutive parameters, e.g.
+	// "." for {id}.{ext}, so len(Infixes) is len(Parameters)-1.
+	Infixes     []string
 	Parameters  []Parameter
 	isParameter bool
 }
@@ -25,15 +28,14 @@ func NewSegment() Segment {
 }
 
 func (s *Segment) Parse(raw string) (err error) {
-	var spec string
-	var p Parameter
+	var literals, specs []string
 
 	s.Raw = raw
 	s.isParameter = strings.Contains(s.Raw, "{")
 	if !s.isParameter {
 		goto end
 	}
-	s.Prefix, spec, s.Suffix, err = ExtractParameterSpec(s.Raw)
+	literals, specs, err = splitSegmentSpecs(s.Raw)
 	if err != nil {
 		err = NewErr(
 			ErrFailedToExtractParameterSpec,
@@ -41,18 +43,24 @@ func (s *Segment) Parse(raw string) (err error) {
 		)
 		goto end
 	}
+	s.Prefix = literals[0]
+	s.Suffix = literals[len(literals)-1]
+	s.Infixes = literals[1 : len(literals)-1]
 
-	p, err = ParseParameter(spec, PathLocation)
-	if err != nil {
-		err = NewErr(
-			ErrFailedToParseParameter,
-			"position", len(s.Parameters),
-			err,
-		)
-		goto end
+	s.Parameters = make([]Parameter, 0, len(specs))
+	for _, spec := range specs {
+		var p Parameter
+		p, err = ParseParameter(spec, PathLocation)
+		if err != nil {
+			err = NewErr(
+				ErrFailedToParseParameter,
+				"position", len(s.Parameters),
+				err,
+			)
+			goto end
+		}
+		s.Parameters = append(s.Parameters, p)
 	}
-	// We currently only support one parameter per segment
-	s.Parameters = []Parameter{p}
 
 end:
 	if err != nil {
@@ -66,7 +74,7 @@ end:
 
 // ExtractParameterSpec extracts the parameter name from a segment like {id:int} or {date*:date:format}.
 // Returns just the parameter name without type specifications or multi-segment markers.
-// TODO: Add support for multiple variables per segment
+// Segments with several parameters, such as {id}.{ext}, are split by Segment.Parse() instead.
 func ExtractParameterSpec(segment string) (prefix, spec, suffix string, err error) {
 	var begin, end int
 
@@ -110,6 +118,74 @@ end:
 	return prefix, spec, suffix, err
 }
 
+// LiteralBefore returns the literal text that precedes the i-th parameter of
+// the segment: the Prefix for the first parameter and an Infix for the rest.
+func (s *Segment) LiteralBefore(i int) string {
+	if i == 0 {
+		return s.Prefix
+	}
+	return s.Infixes[i-1]
+}
+
+// splitSegmentSpecs splits a raw segment like "v{major}.{minor}" into its
+// brace-enclosed parameter specs and the literals around them, so that
+// literals has one more entry than specs: the prefix, the text between each
+// pair of specs, and the suffix. Braces nested within a spec, e.g. in
+// regex[a{2}], do not start a new spec. Adjacent specs are rejected because
+// nothing would mark where one value ends and the next begins.
+func splitSegmentSpecs(segment string) (literals, specs []string, err error) {
+	var depth, begin, literalBegin int
+
+	for i := range len(segment) {
+		switch segment[i] {
+		case '{':
+			if depth == 0 {
+				literals = append(literals, segment[literalBegin:i])
+				begin = i
+			}
+			depth++
+		case '}':
+			if depth == 0 {
+				err = NewErr(
+					ErrInvalidParameterSyntax,
+					ErrMalformedBraces,
+				)
+				goto end
+			}
+			depth--
+			if depth == 0 {
+				specs = append(specs, segment[begin:i+1])
+				literalBegin = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		err = NewErr(
+			ErrInvalidParameterSyntax,
+			ErrUnmatchedOpeningBrace,
+		)
+		goto end
+	}
+	literals = append(literals, segment[literalBegin:])
+
+	for i := 1; i < len(literals)-1; i++ {
+		if literals[i] == "" {
+			err = NewErr(
+				ErrInvalidParameterSyntax,
+				ErrAdjacentSegmentParameters,
+				"position", i,
+			)
+			goto end
+		}
+	}
+
+end:
+	if err != nil {
+		err = WithErr(err, "url_segment", segment)
+	}
+	return literals, specs, err
+}
+
 // IsLiteral returns true if this segment is a literal string (not a parameter).
 // Literal segments are used as-is in URL paths without any substitution.
 func (s *Segment) IsLiteral() bool {
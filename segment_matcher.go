@@ -0,0 +1,122 @@
+package pathvars
+
+import (
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// segmentMatcher matches a path against a template without the regexp
+// package. It handles the common case of templates whose segments are all
+// literals or hold a single, single-segment parameter, optionally with a
+// literal prefix and/or suffix such as "v{version}" or "{id}.json". Those
+// captures are exactly the "([^/]+)" groups of the equivalent regex, so a
+// plain walk over the path's slash-separated parts gives the same result.
+// Templates with multi-segment parameters or several parameters in one
+// segment still need backtracking and keep using the regex.
+type segmentMatcher struct {
+	segments []Segment
+	// captures is the number of parameter segments, i.e. regex groups.
+	captures int
+}
+
+// newSegmentMatcher returns a matcher for segments, or nil when the template
+// needs the regex to match.
+func newSegmentMatcher(segments []Segment, params *pvtypes.OrderedMap[Identifier, Parameter]) (m *segmentMatcher) {
+	var captures int
+
+	for _, segment := range segments {
+		if !segment.IsParameter() {
+			continue
+		}
+		if len(segment.Parameters) != 1 {
+			goto end
+		}
+		param, exists := params.Get(segment.Parameters[0].Name)
+		if exists && param.MultiSegment {
+			goto end
+		}
+		captures++
+	}
+	m = &segmentMatcher{
+		segments: segments,
+		captures: captures,
+	}
+end:
+	return m
+}
+
+// match returns the same slice regexp.FindStringSubmatch would for the
+// template's regex: the whole path followed by one value per parameter
+// segment, or nil when the path does not match.
+func (m *segmentMatcher) match(path string) (matches []string) {
+	matches = make([]string, 1, m.captures+1)
+	matches, ok := m.walk(path, matches)
+	if !ok {
+		return nil
+	}
+	matches[0] = path
+	return matches
+}
+
+// matchString reports whether path matches, like regexp.MatchString, without
+// allocating the captured values.
+func (m *segmentMatcher) matchString(path string) bool {
+	_, ok := m.walk(path, nil)
+	return ok
+}
+
+// walk checks path against each segment in turn, appending the captured
+// values to matches unless matches is nil.
+func (m *segmentMatcher) walk(path string, matches []string) (_ []string, ok bool) {
+	var part string
+	var trimmed bool
+
+	rest := path
+	for _, segment := range m.segments {
+		if !strings.HasPrefix(rest, "/") {
+			goto end
+		}
+		part, rest = cutSegment(rest[1:])
+		if !segment.IsParameter() {
+			if part != segment.Raw {
+				goto end
+			}
+			continue
+		}
+		part, trimmed = trimSegmentAffixes(part, segment.Prefix, segment.Suffix)
+		if !trimmed {
+			goto end
+		}
+		if matches != nil {
+			matches = append(matches, part)
+		}
+	}
+	ok = rest == ""
+end:
+	return matches, ok
+}
+
+// cutSegment splits path at its first slash, keeping the slash in rest.
+func cutSegment(path string) (part, rest string) {
+	i := strings.IndexByte(path, '/')
+	if i < 0 {
+		return path, ""
+	}
+	return path[:i], path[i:]
+}
+
+// trimSegmentAffixes removes prefix and suffix from part, reporting false
+// unless at least one character remains between them, as "([^/]+)" requires.
+func trimSegmentAffixes(part, prefix, suffix string) (value string, ok bool) {
+	if len(part) <= len(prefix)+len(suffix) {
+		goto end
+	}
+	if !strings.HasPrefix(part, prefix) || !strings.HasSuffix(part, suffix) {
+		goto end
+	}
+	value = part[len(prefix) : len(part)-len(suffix)]
+	ok = true
+end:
+	return value, ok
+}
@@ -0,0 +1,53 @@
+package pathvars
+
+import (
+	"slices"
+	"strings"
+)
+
+// Segment ranks used by compareSpecificity(), most specific first.
+const (
+	spanningSegmentRank  = iota // {path*}
+	parameterSegmentRank        // {id}
+	mixedSegmentRank            // {id}.json or v{version}
+	literalSegmentRank          // users
+)
+
+// compareSpecificity compares the paths of a and b segment by segment,
+// returning a positive number when a is more specific, negative when b is and
+// zero when they rank the same or can never match the same path, e.g.
+// "/users/me" over "/users/{id}" and "/files/{dir}/{name}" over
+// "/files/{path*}". When one path's ranks are a prefix of the other's, the
+// longer path is the more specific.
+func compareSpecificity(a, b *ParsedTemplate) int {
+	if !a.mayOverlap(b) {
+		return 0
+	}
+	return slices.Compare(a.segmentRanks(), b.segmentRanks())
+}
+
+// mayOverlap reports whether pt and other could match the same path: they
+// have as many segments, or either has a multi-segment parameter.
+func (pt *ParsedTemplate) mayOverlap(other *ParsedTemplate) bool {
+	return len(pt.segments) == len(other.segments) ||
+		slices.ContainsFunc(pt.segments, pt.spansSegments) ||
+		slices.ContainsFunc(other.segments, other.spansSegments)
+}
+
+// segmentRanks returns the specificity rank of each of pt's path segments.
+func (pt *ParsedTemplate) segmentRanks() []int {
+	ranks := make([]int, len(pt.segments))
+	for i, segment := range pt.segments {
+		switch {
+		case !segment.IsParameter():
+			ranks[i] = literalSegmentRank
+		case pt.spansSegments(segment):
+			ranks[i] = spanningSegmentRank
+		case segment.Prefix != "" || segment.Suffix != "" || strings.Join(segment.Infixes, "") != "":
+			ranks[i] = mixedSegmentRank
+		default:
+			ranks[i] = parameterSegmentRank
+		}
+	}
+	return ranks
+}
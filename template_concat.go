@@ -0,0 +1,138 @@
+package pathvars
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// Concat returns a new template that appends tail's path segments and query
+// parameters to pt's, e.g. "/api/v1" and "/users/{id:int}" combine into
+// "/api/v1/users/{id:int}". Parameter positions and the path matcher are
+// recomputed for the combined template; path parameters keep preceding
+// query parameters, and pt's parameters precede tail's. pt is unchanged. A
+// parameter name declared by both fails with ErrDuplicateParameterName, and a
+// tail with a method prefix fails with ErrConflictingTemplateMethods when pt
+// has a different one.
+func (pt *ParsedTemplate) Concat(tail Template) (combined *ParsedTemplate, err error) {
+	var tailPT *ParsedTemplate
+	var segments []Segment
+	var params *pvtypes.OrderedMap[Identifier, Parameter]
+	var method HTTPMethod
+	var template string
+
+	tailPT, err = ParseTemplate(string(tail))
+	if err != nil {
+		goto end
+	}
+
+	method = pt.method
+	if method == "" {
+		method = tailPT.method
+	}
+	if tailPT.method != "" && tailPT.method != method {
+		err = NewErr(
+			ErrInvalidTemplate,
+			ErrConflictingTemplateMethods,
+			"method", pt.method,
+			"tail_method", tailPT.method,
+		)
+		goto end
+	}
+
+	for name := range tailPT.params.Keys() {
+		_, exists := pt.params.Get(name)
+		if exists {
+			err = NewErr(
+				ErrInvalidTemplate,
+				ErrDuplicateParameterName,
+				"parameter_name", name,
+			)
+			goto end
+		}
+	}
+
+	params = concatParameters(pt.params, tailPT.params)
+	segments = slices.Concat(pt.segments, tailPT.segments)
+	template, err = concatTemplates(pt.original, tailPT.original)
+	if err != nil {
+		goto end
+	}
+
+	combined, err = buildParsedTemplate(template, segments, params)
+	if err != nil {
+		goto end
+	}
+	combined.method = method
+	combined.trimQueryValues = pt.trimQueryValues
+	combined.matrixParameters = pt.matrixParameters
+	combined.ignoreInvalidOptional = pt.ignoreInvalidOptional
+
+end:
+	if err != nil {
+		err = WithErr(err,
+			"base_template", pt.original,
+			"tail_template", tail,
+		)
+	}
+	return combined, err
+}
+
+// concatParameters combines base and tail parameters, path parameters first,
+// renumbering their positions in that order.
+func concatParameters(base, tail *pvtypes.OrderedMap[Identifier, Parameter]) (params *pvtypes.OrderedMap[Identifier, Parameter]) {
+	var position int
+
+	params = pvtypes.NewOrderedMap[Identifier, Parameter](base.Len() + tail.Len())
+	for _, location := range []LocationType{PathLocation, QueryLocation} {
+		for _, m := range []*pvtypes.OrderedMap[Identifier, Parameter]{base, tail} {
+			for name, p := range m.Iterator() {
+				if p.Location() != location {
+					continue
+				}
+				params.Set(name, p.WithPosition(position))
+				position++
+			}
+		}
+	}
+	return params
+}
+
+// concatTemplates joins the path and query parts of two template strings,
+// dropping the method prefix of each; Concat() keeps the method separately.
+func concatTemplates(base, tail string) (template string, err error) {
+	var basePath, baseQuery, tailPath, tailQuery string
+	var sb strings.Builder
+
+	_, base = splitTemplateMethod(base)
+	basePath, baseQuery, err = splitPathAndQuery(base)
+	if err != nil {
+		goto end
+	}
+	_, tail = splitTemplateMethod(tail)
+	tailPath, tailQuery, err = splitPathAndQuery(tail)
+	if err != nil {
+		goto end
+	}
+
+	sb.WriteString(strings.TrimRight(basePath, "/"))
+	if tailPath != "/" || sb.Len() == 0 {
+		if !strings.HasPrefix(tailPath, "/") {
+			sb.WriteByte('/')
+		}
+		sb.WriteString(tailPath)
+	}
+	if baseQuery != "" || tailQuery != "" {
+		sb.WriteByte('?')
+		sb.WriteString(baseQuery)
+		if baseQuery != "" && tailQuery != "" {
+			sb.WriteByte('&')
+		}
+		sb.WriteString(tailQuery)
+	}
+	template = sb.String()
+
+end:
+	return template, err
+}
@@ -0,0 +1,244 @@
+package pathvars
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// TemplateDiagnostic pinpoints why a template failed to parse. It is joined
+// to the errors returned by ParseTemplate() and AddRoute(), so callers such
+// as editors and linters can retrieve it with FindErr[*TemplateDiagnostic]()
+// instead of picking through the error chain.
+type TemplateDiagnostic struct {
+	// Template is the template as passed to ParseTemplate().
+	Template string
+
+	// Location is PathLocation or QueryLocation, or UnspecifiedLocationType
+	// when the failure is not within one part, e.g. ErrTemplateTooComplex.
+	Location LocationType
+
+	// SegmentIndex is the index of the failing path segment as returned by
+	// Segments(), or -1 for query and whole-template failures.
+	SegmentIndex int
+
+	// Position is the byte offset into Template of the failing brace or
+	// parameter spec, or -1 when the failure has no single position.
+	Position int
+
+	// ParameterSpec is the failing spec, e.g. "{id:bogus}", or "" when the
+	// failure is not within a parameter.
+	ParameterSpec string
+
+	// Message describes the failure, e.g. "unmatched '{' at position 7 in
+	// path segment 1".
+	Message string
+}
+
+// Error returns Message.
+func (d *TemplateDiagnostic) Error() string {
+	return d.Message
+}
+
+// templateSpan is a path segment or query parameter spec of a template.
+type templateSpan struct {
+	raw      string
+	start    int
+	location LocationType
+	index    int
+}
+
+// diagnoseTemplate re-scans a template that failed to parse with err and
+// returns a diagnostic locating the first fault.
+func diagnoseTemplate(template string, err error) (d *TemplateDiagnostic) {
+	var spans []templateSpan
+	var unmatchedClose, unclosedOpen int
+	var names map[Identifier]bool
+
+	d = &TemplateDiagnostic{
+		Template:     template,
+		SegmentIndex: -1,
+		Position:     -1,
+	}
+
+	spans, unmatchedClose, unclosedOpen = splitTemplateSpans(template)
+	switch {
+	case unmatchedClose >= 0:
+		d.locate(spans, unmatchedClose)
+		d.Message = fmt.Sprintf("unmatched '}' at position %d%s", d.Position, d.where())
+		goto end
+	case unclosedOpen >= 0:
+		d.locate(spans, unclosedOpen)
+		d.ParameterSpec = template[unclosedOpen:]
+		d.Message = fmt.Sprintf("unmatched '{' at position %d%s", d.Position, d.where())
+		goto end
+	}
+
+	names = make(map[Identifier]bool)
+	for _, span := range spans {
+		if d.diagnoseSpan(span, names) {
+			goto end
+		}
+	}
+
+	d.Message = fmt.Sprintf("invalid template: %s", firstErrLine(err))
+end:
+	return d
+}
+
+// splitTemplateSpans splits template into its path segments and query specs,
+// skipping any method prefix and empty segments, and reports the position of
+// the first unmatched '}' and of an outermost '{' left unclosed, or -1.
+func splitTemplateSpans(template string) (spans []templateSpan, unmatchedClose, unclosedOpen int) {
+	var depth, begin, segmentIndex int
+
+	location := PathLocation
+	unmatchedClose, unclosedOpen = -1, -1
+	_, path := splitTemplateMethod(template)
+	begin = len(template) - len(path)
+
+	addSpan := func(end int) {
+		if end > begin && template[begin:end] != "/" {
+			raw := strings.TrimPrefix(template[begin:end], "/")
+			span := templateSpan{raw: raw, start: end - len(raw), location: location, index: -1}
+			if location == PathLocation {
+				span.index = segmentIndex
+				segmentIndex++
+			}
+			spans = append(spans, span)
+		}
+		begin = end
+	}
+
+	for i := begin; i < len(template); i++ {
+		switch char := template[i]; {
+		case char == '{':
+			if depth == 0 {
+				unclosedOpen = i
+			}
+			depth++
+		case char == '}':
+			if depth == 0 {
+				if unmatchedClose < 0 {
+					unmatchedClose = i
+				}
+				continue
+			}
+			depth--
+			if depth == 0 {
+				unclosedOpen = -1
+			}
+		case depth > 0:
+		case char == '/' && location == PathLocation:
+			addSpan(i)
+		case char == '?' && location == PathLocation:
+			addSpan(i)
+			location = QueryLocation
+			begin = i + 1
+		case char == '&' && location == QueryLocation:
+			addSpan(i)
+			begin = i + 1
+		}
+	}
+	addSpan(len(template))
+	return spans, unmatchedClose, unclosedOpen
+}
+
+// locate sets Location, SegmentIndex and Position from the span of spans
+// containing position.
+func (d *TemplateDiagnostic) locate(spans []templateSpan, position int) {
+	d.Position = position
+	for _, span := range spans {
+		if position >= span.start && position < span.start+len(span.raw) {
+			d.Location = span.location
+			d.SegmentIndex = span.index
+			break
+		}
+	}
+}
+
+// where describes the diagnostic's location for Message.
+func (d *TemplateDiagnostic) where() string {
+	switch {
+	case d.SegmentIndex >= 0:
+		return fmt.Sprintf(" in path segment %d", d.SegmentIndex)
+	case d.Location == QueryLocation:
+		return " in query"
+	}
+	return ""
+}
+
+// diagnoseSpan fills in d and returns true if span holds the first invalid
+// or duplicate parameter spec. names collects the names seen so far.
+func (d *TemplateDiagnostic) diagnoseSpan(span templateSpan, names map[Identifier]bool) bool {
+	var literals, specs []string
+	var offset int
+	var err error
+
+	if !strings.Contains(span.raw, "{") {
+		return false
+	}
+	d.Location = span.location
+	d.SegmentIndex = span.index
+	d.Position = span.start
+
+	literals, specs, err = splitSegmentSpecs(span.raw)
+	if err != nil {
+		d.Message = fmt.Sprintf("invalid segment %q at position %d%s: %s", span.raw, d.Position, d.where(), sentinelMessage(err))
+		return true
+	}
+	for i, spec := range specs {
+		var p Parameter
+
+		offset += len(literals[i])
+		d.Position = span.start + offset
+		d.ParameterSpec = spec
+		offset += len(spec)
+
+		p, err = ParseParameter(spec, span.location)
+		if err != nil {
+			d.Message = fmt.Sprintf("invalid parameter %s at position %d%s: %s", spec, d.Position, d.where(), sentinelMessage(err))
+			return true
+		}
+		if names[p.Name] {
+			d.Message = fmt.Sprintf("duplicate parameter %s at position %d%s", spec, d.Position, d.where())
+			return true
+		}
+		names[p.Name] = true
+	}
+	d.Position = span.start
+	d.ParameterSpec = ""
+	return false
+}
+
+// sentinelMessage returns the most specific sentinel of err, e.g. "unsupported
+// data type", falling back to the first line of its message.
+func sentinelMessage(err error) string {
+	sentinels := pvtypes.Errors(err)
+	if len(sentinels) == 0 {
+		sentinels = Errors(err)
+	}
+	if len(sentinels) == 0 {
+		return firstErrLine(err)
+	}
+	return sentinels[len(sentinels)-1].Error()
+}
+
+// firstErrLine returns the first line of err's message without its metadata.
+func firstErrLine(err error) string {
+	msg, _, _ := strings.Cut(err.Error(), "\n")
+	msg, _, _ = strings.Cut(msg, "; meta:")
+	return msg
+}
+
+// withTemplateDiagnostic joins a diagnostic for template to err, unless err
+// already carries one.
+func withTemplateDiagnostic(template string, err error) error {
+	var d *TemplateDiagnostic
+	if err == nil || errors.As(err, &d) {
+		return err
+	}
+	return WithErr(err, diagnoseTemplate(template, err))
+}
@@ -2,6 +2,7 @@ package pathvars
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -12,6 +13,7 @@ type TemplateError struct {
 	Source     string
 	Suggestion string
 	Location   LocationType
+	parameter  Parameter
 	errString  string
 }
 
@@ -105,10 +107,28 @@ func NewTemplateError(err error, args TemplateErrorArgs) *TemplateError {
 		Source:     args.Source,
 		Location:   args.Location,
 		Suggestion: args.Suggestion,
+		parameter:  args.Parameter,
 		Err:        err,
 	}
 }
 
+// localize replaces the English detail and suggestion with messages from the
+// catalog registered for lang, leaving either one unchanged if none applies.
+func (e *TemplateError) localize(lang string) {
+	pe := e.extractParameterError()
+	if pe == nil {
+		return
+	}
+	value := pe.GetReceivedValue()
+	if detail, ok := e.parameter.LocalizedErrorDetail(lang, e.Err, value); ok {
+		pe.SetDetail(detail)
+	}
+	if suggestion, ok := e.parameter.LocalizedErrorSuggestion(lang, e.Err, value, e.Example); ok {
+		e.Suggestion = suggestion
+	}
+	e.errString = ""
+}
+
 func (e *TemplateError) Error() string {
 	var meta []any
 	var format string
@@ -165,3 +185,83 @@ func (e *TemplateError) Is(target error) (ok bool) {
 end:
 	return ok
 }
+
+// TemplateErrors walks an error tree (including CombineErrs() and errors.Join()
+// trees) and returns every *TemplateError found, in left-to-right order.
+func TemplateErrors(err error) []*TemplateError {
+	return findAllErrs[*TemplateError](err)
+}
+
+// GroupErrors walks an error tree such as one returned by Router.Match() and
+// buckets every *ParameterError by the location of its parameter, e.g. to
+// report path errors separately from query errors.
+func GroupErrors(err error) (groups map[LocationType][]*ParameterError) {
+	groups = make(map[LocationType][]*ParameterError)
+	for _, pe := range findAllErrs[*ParameterError](err) {
+		groups[pe.Location] = append(groups[pe.Location], pe)
+	}
+	return groups
+}
+
+// MissingParameters walks an error tree such as one returned by Router.Match()
+// and returns the name of every required parameter reported as not provided
+// via ErrRequiredParameterNotProvided, in left-to-right order and without
+// duplicates, so a handler can respond "missing: email, min_score" in one
+// message rather than one error at a time.
+func MissingParameters(err error) (names []Identifier) {
+	var walk func(error)
+	walk = func(err error) {
+		if err == nil {
+			return
+		}
+		//goland:noinspection GoTypeAssertionOnErrors
+		e, ok := err.(entry)
+		if ok && slices.Contains(e.errors, ErrRequiredParameterNotProvided) {
+			name, found := ErrValue[Identifier](e, "parameter_name")
+			if !found {
+				name, found = ErrValue[Identifier](e, "parameter")
+			}
+			if found && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+			return
+		}
+		switch e := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, child := range e.Unwrap() {
+				walk(child)
+			}
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		}
+	}
+	walk(err)
+	return names
+}
+
+// findAllErrs walks an error tree left-to-right and returns every error of
+// type T, without descending into the errors it finds.
+func findAllErrs[T error](err error) (found []T) {
+	var walk func(error)
+	walk = func(err error) {
+		if err == nil {
+			return
+		}
+		//goland:noinspection GoTypeAssertionOnErrors
+		t, ok := err.(T)
+		if ok {
+			found = append(found, t)
+			return
+		}
+		switch e := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, child := range e.Unwrap() {
+				walk(child)
+			}
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		}
+	}
+	walk(err)
+	return found
+}
@@ -0,0 +1,137 @@
+package pathvars
+
+import (
+	"strings"
+)
+
+// TemplateSyntax selects how ParseTemplateWithSyntax() recognizes path
+// parameters.
+type TemplateSyntax int
+
+const (
+	// BraceSyntax is the native syntax, e.g. "/users/{id:int}".
+	BraceSyntax TemplateSyntax = iota
+
+	// ColonSyntax additionally accepts Express/Gin-style parameters that fill
+	// a whole path segment, e.g. "/users/:id". They are translated to "{id}"
+	// before parsing, so brace parameters, with their types and constraints,
+	// still work alongside them as in "/users/:id/posts/{post_id:int}". A '?'
+	// after a colon parameter starts the query when followed by '{', as in
+	// "/users/:id?{limit:int}". Express's optional ":id?" is rejected with
+	// ErrOptionalColonParameter, since in Express "/posts/:id?" also matches
+	// "/posts" but path parameters here always need their segment.
+	ColonSyntax
+)
+
+// ParseTemplateWithSyntax parses template like ParseTemplate(), first
+// translating it from syntax to the native brace syntax. Original() of the
+// result returns the translated template, so it can be re-parsed by
+// ParseTemplate().
+func ParseTemplateWithSyntax(template string, syntax TemplateSyntax) (t *ParsedTemplate, err error) {
+	if syntax == ColonSyntax {
+		template, err = translateColonSyntax(template)
+		if err != nil {
+			goto end
+		}
+	}
+	t, err = ParseTemplate(template)
+end:
+	return t, err
+}
+
+// translateColonSyntax rewrites each path segment of the form ":name" to
+// "{name}", leaving any method prefix, brace parameters and the query part
+// unchanged.
+func translateColonSyntax(template string) (translated string, err error) {
+	var sb strings.Builder
+	var braceDepth int
+	var i int
+
+	_, path := splitTemplateMethod(template)
+	sb.WriteString(template[:len(template)-len(path)])
+
+	for i < len(path) {
+		char := path[i]
+		switch {
+		case char == '{':
+			braceDepth++
+		case char == '}' && braceDepth > 0:
+			braceDepth--
+		case char == '?' && braceDepth == 0:
+			// The query part takes only brace parameters
+			sb.WriteString(path[i:])
+			goto done
+		case char == ':' && braceDepth == 0 && i > 0 && path[i-1] == '/':
+			var n int
+			n, err = writeColonParameter(&sb, path[i:])
+			if err != nil {
+				err = WithErr(err,
+					"position", i,
+				)
+				goto end
+			}
+			i += n
+			continue
+		}
+		sb.WriteByte(char)
+		i++
+	}
+done:
+	translated = sb.String()
+end:
+	if err != nil {
+		err = WithErr(err,
+			"template", template,
+		)
+	}
+	return translated, err
+}
+
+// writeColonParameter writes the brace form of the ":name" segment at the
+// start of s and returns how many bytes of s it consumed.
+func writeColonParameter(sb *strings.Builder, s string) (n int, err error) {
+	var segment string
+
+	n = 1
+	for n < len(s) && isIdentifierByte(s[n]) {
+		n++
+	}
+	name := s[1:n]
+	if n < len(s) && s[n] == '?' && !strings.HasPrefix(s[n+1:], "{") {
+		err = NewErr(
+			ErrInvalidTemplate,
+			ErrOptionalColonParameter,
+			"segment", s[:n+1],
+		)
+		goto end
+	}
+	if n < len(s) && s[n] != '/' && s[n] != '?' {
+		segment, _, _ = strings.Cut(s, "/")
+		err = NewErr(
+			ErrInvalidTemplate,
+			ErrInvalidColonParameter,
+			"segment", segment,
+		)
+		goto end
+	}
+	_, err = ParseIdentifier(name)
+	if err != nil {
+		err = NewErr(
+			ErrInvalidTemplate,
+			ErrInvalidColonParameter,
+			"segment", s[:n],
+			err,
+		)
+		goto end
+	}
+	sb.WriteByte('{')
+	sb.WriteString(name)
+	sb.WriteByte('}')
+end:
+	return n, err
+}
+
+// isIdentifierByte reports whether c may appear in a parameter name.
+func isIdentifierByte(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
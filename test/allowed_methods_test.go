@@ -0,0 +1,98 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func newReadOnlyRouter(t *testing.T) *pathvars.Router {
+	t.Helper()
+	router := pathvars.NewRouter(&pathvars.RouterArgs{
+		AllowedMethods: []pathvars.HTTPMethod{"GET", "HEAD"},
+	})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	for _, method := range []pathvars.HTTPMethod{"GET", "POST"} {
+		err := router.AddRoute(method, "/users/{id:int}", &pathvars.RouteArgs{Handler: handler})
+		if err != nil {
+			t.Fatalf("Failed to add %s route: %v", method, err)
+		}
+	}
+	return router
+}
+
+func TestAllowedMethods(t *testing.T) {
+	router := newReadOnlyRouter(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantErr    error
+		wantStatus int
+	}{
+		{name: "get-matches", method: "GET", path: "/users/42", wantStatus: http.StatusOK},
+		{name: "get-validates", method: "GET", path: "/users/abc", wantErr: pathvars.ErrNoMatch, wantStatus: http.StatusBadRequest},
+		{name: "get-unknown-path", method: "GET", path: "/orders/42", wantErr: pathvars.ErrNoRouteMatched, wantStatus: http.StatusNotFound},
+		{name: "post-rejected-despite-route", method: "POST", path: "/users/42", wantErr: pathvars.ErrMethodNotAllowed, wantStatus: http.StatusMethodNotAllowed},
+		{name: "delete-rejected-without-route", method: "DELETE", path: "/orders/42", wantErr: pathvars.ErrMethodNotAllowed, wantStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest(tt.method, tt.path, nil))
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Match() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != pathvars.ErrMethodNotAllowed && errors.Is(err, pathvars.ErrMethodNotAllowed) {
+				t.Errorf("Match() error = %v, want no ErrMethodNotAllowed for an allowed method", err)
+			}
+			if got := pathvars.StatusForError(err); got != tt.wantStatus {
+				t.Errorf("StatusForError() = %d, want %d", got, tt.wantStatus)
+			}
+
+			// MatchPath() applies the same allow-list
+			_, err = router.MatchPath(pathvars.HTTPMethod(tt.method), tt.path)
+			if errors.Is(err, pathvars.ErrMethodNotAllowed) != (tt.wantErr == pathvars.ErrMethodNotAllowed) {
+				t.Errorf("MatchPath() error = %v, want ErrMethodNotAllowed %v", err, tt.wantErr == pathvars.ErrMethodNotAllowed)
+			}
+		})
+	}
+}
+
+func TestAllowedMethodsServeHTTP(t *testing.T) {
+	router := newReadOnlyRouter(t)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("POST", "/users/42", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+	if got := rec.Header().Get("Allow"); got != "GET, HEAD" {
+		t.Errorf("Allow = %q, want %q", got, "GET, HEAD")
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", "/users/42", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestAllowedMethodsUnset(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("POST", "/users", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	_, err = router.Match(httptest.NewRequest("POST", "/users", nil))
+	if err != nil {
+		t.Errorf("Match() unexpected error without AllowedMethods: %v", err)
+	}
+}
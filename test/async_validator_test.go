@@ -0,0 +1,172 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+var errUnknownTenant = errors.New("unknown tenant")
+
+// newTenantRouter returns a router whose {tenant} slug is checked by
+// validator, with results cached in cache when it is not nil.
+func newTenantRouter(t *testing.T, validator pathvars.AsyncValidator, cache pathvars.ValidationCache, timeout time.Duration) *pathvars.Router {
+	t.Helper()
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/tenants/{tenant:slug}/users", &pathvars.RouteArgs{
+		Parameters: []pathvars.Parameter{
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps:       pathvars.NameSpecProps{Name: "tenant"},
+				Location:        pathvars.PathLocation,
+				DataType:        pathvars.SlugType,
+				AsyncValidator:  validator,
+				ValidationCache: cache,
+				AsyncTimeout:    timeout,
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	return router
+}
+
+func TestAsyncValidator(t *testing.T) {
+	var calls atomic.Int32
+	validator := func(ctx context.Context, value string) error {
+		calls.Add(1)
+		if value != "acme" {
+			return errUnknownTenant
+		}
+		return nil
+	}
+	router := newTenantRouter(t, validator, pathvars.NewMemoryValidationCache(10), 0)
+
+	_, err := router.Match(httptest.NewRequest("GET", "/tenants/acme/users", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error for known tenant: %v", err)
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/tenants/globex/users", nil))
+	if !errors.Is(err, pathvars.ErrAsyncValidationFailed) || !errors.Is(err, errUnknownTenant) {
+		t.Fatalf("Match() error = %v, want ErrAsyncValidationFailed wrapping errUnknownTenant", err)
+	}
+	if status := pathvars.StatusForError(err); status != http.StatusBadRequest {
+		t.Errorf("StatusForError() = %d, want %d", status, http.StatusBadRequest)
+	}
+
+	// Both results are cached, so repeat requests make no lookups
+	for _, path := range []string{"/tenants/acme/users", "/tenants/globex/users"} {
+		_, _ = router.Match(httptest.NewRequest("GET", path, nil))
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("validator called %d times, want 2 with caching", got)
+	}
+
+	// Values failing slug validation never reach the validator
+	_, err = router.Match(httptest.NewRequest("GET", "/tenants/Not_A_Slug/users", nil))
+	if err == nil || errors.Is(err, pathvars.ErrAsyncValidationFailed) {
+		t.Errorf("Match() error = %v, want a slug validation error", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("validator called %d times, want 2 after an invalid slug", got)
+	}
+}
+
+func TestAsyncValidatorCancellation(t *testing.T) {
+	// Ignores ctx on purpose; the router must stop waiting anyway
+	blocking := func(ctx context.Context, value string) error {
+		time.Sleep(time.Second)
+		return nil
+	}
+	cache := pathvars.NewMemoryValidationCache(10)
+
+	t.Run("request-canceled", func(t *testing.T) {
+		router := newTenantRouter(t, blocking, cache, 0)
+		ctx, cancel := context.WithCancel(context.Background())
+		req := httptest.NewRequest("GET", "/tenants/acme/users", nil).WithContext(ctx)
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		start := time.Now()
+		_, err := router.Match(req)
+		if !errors.Is(err, pathvars.ErrAsyncValidationCanceled) {
+			t.Fatalf("Match() error = %v, want ErrAsyncValidationCanceled", err)
+		}
+		if time.Since(start) > 500*time.Millisecond {
+			t.Error("Match() waited for the validator despite cancellation")
+		}
+		if status := pathvars.StatusForError(err); status != http.StatusBadRequest {
+			t.Errorf("StatusForError() = %d, want %d", status, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		router := newTenantRouter(t, blocking, cache, 10*time.Millisecond)
+		_, err := router.Match(httptest.NewRequest("GET", "/tenants/acme/users", nil))
+		if !errors.Is(err, pathvars.ErrAsyncValidationTimeout) {
+			t.Fatalf("Match() error = %v, want ErrAsyncValidationTimeout", err)
+		}
+		if status := pathvars.StatusForError(err); status != http.StatusInternalServerError {
+			t.Errorf("StatusForError() = %d, want %d", status, http.StatusInternalServerError)
+		}
+	})
+
+	// Context failures say nothing about the value, so they are not cached
+	if _, found := cache.Get("acme"); found {
+		t.Error("ValidationCache recorded a result for a canceled validation")
+	}
+}
+
+func TestAsyncValidatorServerFault(t *testing.T) {
+	errDatabaseDown := errors.New("database down")
+	var calls atomic.Int32
+	var down atomic.Bool
+	down.Store(true)
+	validator := func(ctx context.Context, value string) error {
+		calls.Add(1)
+		if down.Load() {
+			return pathvars.NewErr(errDatabaseDown, "fault_source", pathvars.ServerFaultSource.Slug())
+		}
+		return nil
+	}
+	cache := pathvars.NewMemoryValidationCache(10)
+	router := newTenantRouter(t, validator, cache, 0)
+
+	_, err := router.Match(httptest.NewRequest("GET", "/tenants/acme/users", nil))
+	if !errors.Is(err, pathvars.ErrAsyncValidationUnavailable) || !errors.Is(err, errDatabaseDown) {
+		t.Fatalf("Match() error = %v, want ErrAsyncValidationUnavailable wrapping errDatabaseDown", err)
+	}
+	if errors.Is(err, pathvars.ErrAsyncValidationFailed) {
+		t.Errorf("Match() error = %v, want the value not rejected", err)
+	}
+	if status := pathvars.StatusForError(err); status != http.StatusInternalServerError {
+		t.Errorf("StatusForError() = %d, want %d", status, http.StatusInternalServerError)
+	}
+	if _, found := cache.Get("acme"); found {
+		t.Error("ValidationCache recorded a result for a backend failure")
+	}
+
+	// Once the backend recovers the value is looked up again and accepted
+	down.Store(false)
+	_, err = router.Match(httptest.NewRequest("GET", "/tenants/acme/users", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error after recovery: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("validator called %d times, want 2", got)
+	}
+}
+
+func TestAsyncValidatorNotEncodable(t *testing.T) {
+	router := newTenantRouter(t, func(context.Context, string) error { return nil }, nil, 0)
+	_, err := router.MarshalBinary()
+	if !errors.Is(err, pathvars.ErrParameterNotEncodable) {
+		t.Errorf("MarshalBinary() error = %v, want ErrParameterNotEncodable", err)
+	}
+}
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestCanonicalPath(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{
+		IgnoreTrailingSlash: true,
+		CaseInsensitive:     true,
+	})
+	for _, template := range []string{
+		"/users",
+		"/users/{name}",
+		"/v{version:int}/Reports/{id:int}.json",
+		"/files/{path*}/Raw",
+	} {
+		err := router.AddRoute("GET", pathvars.Template(template), nil)
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", template, err)
+		}
+	}
+
+	tests := []struct {
+		path        string
+		wantPath    string
+		wantChanged bool
+	}{
+		{"/users", "/users", false},
+		{"/Users/", "/users", true},
+		{"/users/", "/users", true},
+		{"/USERS", "/users", true},
+		{"/Users/Ada", "/users/Ada", true},
+		{"/users/Ada", "/users/Ada", false},
+		{"/V2/reports/7.JSON", "/v2/Reports/7.json", true},
+		{"/FILES/a/B/raw/", "/files/a/B/Raw", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			path, changed := result.CanonicalPath()
+			if path != tt.wantPath || changed != tt.wantChanged {
+				t.Errorf("CanonicalPath() = (%q, %v), want (%q, %v)", path, changed, tt.wantPath, tt.wantChanged)
+			}
+		})
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/Users/Ada", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	name, _ := result.ValuesMap().Get("name")
+	if name != "Ada" {
+		t.Errorf("name = %v, want %q", name, "Ada")
+	}
+}
+
+func TestCanonicalPathKeepsRouteOrder(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{
+		IgnoreTrailingSlash: true,
+		CaseInsensitive:     true,
+	})
+	for _, route := range []struct {
+		template    string
+		description string
+	}{
+		{"/about", "folded"},
+		{"/About/", "exact"},
+	} {
+		err := router.AddRoute("GET", pathvars.Template(route.template), &pathvars.RouteArgs{
+			Description: route.description,
+		})
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", route.template, err)
+		}
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/About/", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	// Routes are still tried in order, so the earlier folded match wins
+	if result.Route.Description != "folded" {
+		t.Errorf("Match() route = %q, want %q", result.Route.Description, "folded")
+	}
+	path, changed := result.CanonicalPath()
+	if path != "/about" || !changed {
+		t.Errorf("CanonicalPath() = (%q, %v), want (%q, true)", path, changed, "/about")
+	}
+}
+
+func TestCanonicalPathDisabled(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	for _, path := range []string{"/Users", "/users/"} {
+		_, err = router.Match(httptest.NewRequest("GET", path, nil))
+		if err == nil {
+			t.Errorf("Match(%q) expected no match without normalization", path)
+		}
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/users", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	path, changed := result.CanonicalPath()
+	if path != "/users" || changed {
+		t.Errorf("CanonicalPath() = (%q, %v), want (%q, false)", path, changed, "/users")
+	}
+}
@@ -0,0 +1,154 @@
+package test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+// requestWithClientCert returns a request whose TLS state carries a verified
+// client certificate for the given subject, or no TLS state when cn is "".
+func requestWithClientCert(path, cn string, orgs ...string) *http.Request {
+	req := httptest.NewRequest("GET", path, nil)
+	if cn == "" {
+		return req
+	}
+	req.TLS = &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{
+			Subject: pkix.Name{CommonName: cn, Organization: orgs},
+		}}},
+	}
+	return req
+}
+
+func TestClientCertRoutes(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/ledger/{id:int}", &pathvars.RouteArgs{
+		Description: "billing",
+		ClientCert:  &pathvars.ClientCertRequirement{CommonNames: []string{"billing-svc"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/reports/{id:int}", &pathvars.RouteArgs{
+		Description: "internal",
+		ClientCert:  &pathvars.ClientCertRequirement{Organizations: []string{"Example Corp"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  *http.Request
+		want string
+	}{
+		{"matching common name", requestWithClientCert("/ledger/42", "billing-svc"), "billing"},
+		{"matching organization", requestWithClientCert("/reports/7", "audit-svc", "Other", "Example Corp"), "internal"},
+		{"wrong common name", requestWithClientCert("/ledger/42", "audit-svc"), ""},
+		{"wrong organization", requestWithClientCert("/reports/7", "audit-svc", "Other"), ""},
+		{"no client certificate", requestWithClientCert("/ledger/42", ""), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(tt.req)
+			if tt.want == "" {
+				if !errors.Is(err, pathvars.ErrClientCertRejected) {
+					t.Fatalf("Match() error = %v, want ErrClientCertRejected", err)
+				}
+				if status := pathvars.StatusForError(err); status != http.StatusForbidden {
+					t.Errorf("StatusForError() = %d, want %d", status, http.StatusForbidden)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			if result.Route.Description != tt.want {
+				t.Errorf("Match() route = %q, want %q", result.Route.Description, tt.want)
+			}
+			if result.ClientCert() != tt.req.TLS.VerifiedChains[0][0] {
+				t.Error("ClientCert() did not return the verified leaf certificate")
+			}
+		})
+	}
+}
+
+func TestClientCertMissing(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/ledger", &pathvars.RouteArgs{
+		ClientCert: &pathvars.ClientCertRequirement{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	req := requestWithClientCert("/ledger", "")
+	// Presented but unverified certificates must not satisfy the route
+	req.TLS = &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "billing-svc"}}},
+	}
+	_, err = router.Match(req)
+	if !errors.Is(err, pathvars.ErrClientCertMissing) {
+		t.Errorf("Match() error = %v, want ErrClientCertMissing", err)
+	}
+
+	result, err := router.Match(requestWithClientCert("/ledger", "anyone"))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if result.ClientCert().Subject.CommonName != "anyone" {
+		t.Errorf("ClientCert() common name = %q, want %q", result.ClientCert().Subject.CommonName, "anyone")
+	}
+}
+
+func TestClientCertFallsThrough(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/status", &pathvars.RouteArgs{
+		Description: "detailed",
+		ClientCert:  &pathvars.ClientCertRequirement{CommonNames: []string{"ops-svc"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/status", &pathvars.RouteArgs{Description: "public"})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		cn   string
+		want string
+	}{
+		{"ops-svc", "detailed"},
+		{"billing-svc", "public"},
+		{"", "public"},
+	}
+	for _, tt := range tests {
+		result, err := router.Match(requestWithClientCert("/status", tt.cn))
+		if err != nil {
+			t.Fatalf("Match(cn=%q) unexpected error: %v", tt.cn, err)
+		}
+		if result.Route.Description != tt.want {
+			t.Errorf("Match(cn=%q) route = %q, want %q", tt.cn, result.Route.Description, tt.want)
+		}
+	}
+
+	if len(router.UnreachableRoutes()) != 0 {
+		t.Error("UnreachableRoutes() reported the public route shadowed by a ClientCert route")
+	}
+
+	result, err := router.MatchPath("GET", "/status")
+	if err != nil {
+		t.Fatalf("MatchPath() unexpected error: %v", err)
+	}
+	if result.ClientCert() != nil {
+		t.Error("MatchPath() ClientCert() expected nil")
+	}
+}
@@ -0,0 +1,144 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestColonSyntaxRouter(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{Syntax: pathvars.ColonSyntax})
+	routes := []pathvars.Template{
+		"/users/:id",
+		"/users/:id/posts/{post_id:int}",
+		"/teams/{team:int}/members",
+		"/search/:term?{limit?10:int}",
+	}
+	for _, route := range routes {
+		if err := router.AddRoute("GET", route, nil); err != nil {
+			t.Fatalf("AddRoute(%q) unexpected error: %v", route, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		template string
+		want     map[pathvars.Identifier]string
+	}{
+		{
+			name:     "colon-parameter",
+			path:     "/users/123",
+			template: "/users/{id}",
+			want:     map[pathvars.Identifier]string{"id": "123"},
+		},
+		{
+			name:     "colon-and-brace-parameters",
+			path:     "/users/123/posts/7",
+			template: "/users/{id}/posts/{post_id:int}",
+			want:     map[pathvars.Identifier]string{"id": "123", "post_id": "7"},
+		},
+		{
+			name:     "brace-parameter",
+			path:     "/teams/5/members",
+			template: "/teams/{team:int}/members",
+			want:     map[pathvars.Identifier]string{"team": "5"},
+		},
+		{
+			name:     "colon-parameter-before-query",
+			path:     "/search/go?limit=5",
+			template: "/search/{term}?{limit?10:int}",
+			want:     map[pathvars.Identifier]string{"term": "go", "limit": "5"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if err != nil {
+				t.Fatalf("Match(%q) unexpected error: %v", tt.path, err)
+			}
+			if got := result.Route.ParsedTemplate.Original(); got != tt.template {
+				t.Errorf("Original() = %q, want %q", got, tt.template)
+			}
+			for name, want := range tt.want {
+				if got, _ := result.GetValue(name); got != want {
+					t.Errorf("GetValue(%q) = %v, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestColonSyntaxOptionalSegment(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{Syntax: pathvars.ColonSyntax})
+
+	// Express would also match "/posts", which a path parameter cannot
+	err := router.AddRoute("GET", "/posts/:id?", nil)
+	if !errors.Is(err, pathvars.ErrOptionalColonParameter) {
+		t.Fatalf("AddRoute(/posts/:id?) error = %v, want ErrOptionalColonParameter", err)
+	}
+
+	for _, route := range []pathvars.Template{"/posts", "/posts/:id"} {
+		if err := router.AddRoute("GET", route, nil); err != nil {
+			t.Fatalf("AddRoute(%q) unexpected error: %v", route, err)
+		}
+	}
+	tests := []struct {
+		path     string
+		template string
+	}{
+		{"/posts", "/posts"},
+		{"/posts/5", "/posts/{id}"},
+	}
+	for _, tt := range tests {
+		result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+		if err != nil {
+			t.Fatalf("Match(%q) unexpected error: %v", tt.path, err)
+		}
+		if got := result.Route.ParsedTemplate.Original(); got != tt.template {
+			t.Errorf("Match(%q) Original() = %q, want %q", tt.path, got, tt.template)
+		}
+	}
+}
+
+func TestParseTemplateWithSyntax(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		syntax   pathvars.TemplateSyntax
+		want     string
+		wantErr  error
+	}{
+		{name: "method-prefix", template: "GET /users/:id", syntax: pathvars.ColonSyntax, want: "GET /users/{id}"},
+		{name: "colon-inside-braces", template: "/at/{when:date}", syntax: pathvars.ColonSyntax, want: "/at/{when:date}"},
+		{name: "colon-mid-segment", template: "/a/b:c", syntax: pathvars.ColonSyntax, want: "/a/b:c"},
+		{name: "brace-syntax-keeps-colon", template: "/users/:id", syntax: pathvars.BraceSyntax, want: "/users/:id"},
+		{name: "missing-name", template: "/users/:", syntax: pathvars.ColonSyntax, wantErr: pathvars.ErrInvalidColonParameter},
+		{name: "invalid-name", template: "/users/:1st", syntax: pathvars.ColonSyntax, wantErr: pathvars.ErrInvalidColonParameter},
+		{name: "trailing-literal", template: "/files/:name.json", syntax: pathvars.ColonSyntax, wantErr: pathvars.ErrInvalidColonParameter},
+		{name: "optional-last-segment", template: "/posts/:id?", syntax: pathvars.ColonSyntax, wantErr: pathvars.ErrOptionalColonParameter},
+		{name: "optional-mid-segment", template: "/files/:name?/meta", syntax: pathvars.ColonSyntax, wantErr: pathvars.ErrOptionalColonParameter},
+		{name: "optional-before-query", template: "/tags/:tag??{limit?10:int}", syntax: pathvars.ColonSyntax, wantErr: pathvars.ErrOptionalColonParameter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt, err := pathvars.ParseTemplateWithSyntax(tt.template, tt.syntax)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseTemplateWithSyntax(%q) error = %v, want %v", tt.template, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTemplateWithSyntax(%q) unexpected error: %v", tt.template, err)
+			}
+			if got := pt.Original(); got != tt.want {
+				t.Errorf("Original() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
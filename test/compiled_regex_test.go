@@ -0,0 +1,90 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestCompiledRegexParameter(t *testing.T) {
+	// Nested brackets and POSIX classes would collide with regex[...] delimiters inline
+	codeRegex := regexp.MustCompile(`[[:upper:]]{2}\[[0-9]{2}\]`)
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/codes/{code}?{tag?:string}", &pathvars.RouteArgs{
+		Parameters: []pathvars.Parameter{
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps: pathvars.NameSpecProps{Name: "code"},
+				Location:  pathvars.PathLocation,
+				DataType:  pathvars.StringType,
+				Regex:     codeRegex,
+			}),
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps: pathvars.NameSpecProps{Name: "ref"},
+				Location:  pathvars.QueryLocation,
+				DataType:  pathvars.StringType,
+				Regex:     codeRegex,
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		wantErr bool
+	}{
+		{name: "path-and-query-match", target: "/codes/AB%5B12%5D?ref=CD%5B34%5D"},
+		{name: "path-mismatch", target: "/codes/AB12?ref=CD%5B34%5D", wantErr: true},
+		{name: "path-substring-rejected", target: "/codes/xAB%5B12%5D?ref=CD%5B34%5D", wantErr: true},
+		{name: "query-mismatch", target: "/codes/AB%5B12%5D?ref=cd%5B34%5D", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.target, nil))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected validation error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected match but got error: %v", err)
+			}
+			if got, _ := result.GetValue("code"); got != "AB[12]" {
+				t.Errorf("code = %v, want %q", got, "AB[12]")
+			}
+		})
+	}
+}
+
+func TestCompiledRegexNotApplied(t *testing.T) {
+	// A "regex" constraint that cannot take a pre-compiled pattern, as a
+	// custom RegisterConstraint() replacement might be
+	constraints := pathvars.GetConstraintsMap()
+	key := pathvars.GetConstraintMapKey(pathvars.RegexConstraintType, pathvars.StringType.Slug())
+	original := constraints[key]
+	constraints[key] = constraints[pathvars.GetConstraintMapKey(pathvars.LengthConstraintType, pathvars.StringType.Slug())]
+	defer func() { constraints[key] = original }()
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/codes/{code}", &pathvars.RouteArgs{
+		Parameters: []pathvars.Parameter{
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps: pathvars.NameSpecProps{Name: "code"},
+				Location:  pathvars.PathLocation,
+				DataType:  pathvars.StringType,
+				Regex:     regexp.MustCompile(`[A-Z]{2}`),
+			}),
+		},
+	})
+	if !errors.Is(err, pathvars.ErrRegexpNotApplied) {
+		t.Fatalf("AddRoute() error = %v, want ErrRegexpNotApplied", err)
+	}
+}
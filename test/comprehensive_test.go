@@ -1,6 +1,7 @@
 package test
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -142,6 +143,24 @@ func TestMethodMatching(t *testing.T) {
 	}
 }
 
+func TestMethodAny(t *testing.T) {
+	for _, method := range []pathvars.HTTPMethod{pathvars.MethodAny, ""} {
+		router := pathvars.NewRouter()
+		err := router.AddRoute(method, "/health", nil)
+		if err != nil {
+			t.Fatalf("Failed to add route: %v", err)
+		}
+		for _, verb := range []string{"GET", "POST", "PATCH"} {
+			t.Run(fmt.Sprintf("%q-%s", method, verb), func(t *testing.T) {
+				_, err := router.Match(httptest.NewRequest(verb, "/health", nil))
+				if err != nil {
+					t.Errorf("Match() unexpected error: %v", err)
+				}
+			})
+		}
+	}
+}
+
 func TestMatchResultMethods(t *testing.T) {
 	router := pathvars.NewRouter()
 	err := router.AddRoute("GET", "/users/{id:int}/posts/{slug:string}", &pathvars.RouteArgs{
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestContentNegotiation(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/reports/{id:int}", &pathvars.RouteArgs{
+		Produces: []string{"text/csv"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/reports/{id:int}", &pathvars.RouteArgs{
+		Produces: []string{"application/json", "application/xml"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/health", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		target    string
+		accept    string
+		wantIndex int
+		wantType  string
+		wantErr   error
+	}{
+		{name: "csv", target: "/reports/1", accept: "text/csv", wantIndex: 0, wantType: "text/csv"},
+		{name: "json", target: "/reports/1", accept: "application/json", wantIndex: 1, wantType: "application/json"},
+		{name: "case-and-params-ignored", target: "/reports/1", accept: "Application/XML; charset=utf-8", wantIndex: 1, wantType: "application/xml"},
+		{name: "quality-preference", target: "/reports/1", accept: "application/json;q=0.5, application/xml", wantIndex: 1, wantType: "application/xml"},
+		{name: "type-wildcard", target: "/reports/1", accept: "application/*", wantIndex: 1, wantType: "application/json"},
+		{name: "any-wildcard", target: "/reports/1", accept: "*/*", wantIndex: 0, wantType: "text/csv"},
+		{name: "specific-zero-beats-wildcard", target: "/reports/1", accept: "text/csv;q=0, */*;q=0.1", wantIndex: 1, wantType: "application/json"},
+		{name: "no-accept-header", target: "/reports/1", wantIndex: 0, wantType: "text/csv"},
+		{name: "no-produces", target: "/health", accept: "image/png", wantIndex: 2, wantType: ""},
+		{name: "not-acceptable", target: "/reports/1", accept: "image/png", wantErr: pathvars.ErrNotAcceptable},
+		{name: "unknown-path", target: "/nope", accept: "image/png", wantErr: pathvars.ErrNoRouteMatched},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.target, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			result, err := router.Match(req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Match() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			if result.Index != tt.wantIndex {
+				t.Errorf("Index = %d, want %d", result.Index, tt.wantIndex)
+			}
+			if got := result.NegotiatedContentType(); got != tt.wantType {
+				t.Errorf("NegotiatedContentType() = %q, want %q", got, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestContentNegotiationStatus(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/reports/{id:int}", &pathvars.RouteArgs{
+		Produces: []string{"text/csv"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/reports/1", nil)
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotAcceptable {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotAcceptable)
+	}
+}
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestCoordinateTypes(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/tiles/{lat:latitude}/{lng:longitude}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantErr    bool
+		wantParam  string
+		suggestion string
+	}{
+		{name: "new-york", path: "/tiles/40.7128/-74.006"},
+		{name: "negative-both", path: "/tiles/-33.8688/-151.2093"},
+		{name: "integer-degrees", path: "/tiles/0/0"},
+		{name: "north-pole", path: "/tiles/90/180"},
+		{name: "south-pole", path: "/tiles/-90/-180"},
+		{name: "latitude-91", path: "/tiles/91/0", wantErr: true, wantParam: "lat", suggestion: "latitude"},
+		{name: "latitude-minus-91", path: "/tiles/-91/0", wantErr: true, wantParam: "lat", suggestion: "latitude"},
+		{name: "longitude-181", path: "/tiles/0/181", wantErr: true, wantParam: "lng", suggestion: "longitude"},
+		{name: "longitude-minus-180.5", path: "/tiles/0/-180.5", wantErr: true, wantParam: "lng", suggestion: "longitude"},
+		{name: "not-a-number", path: "/tiles/north/0", wantErr: true, wantParam: "lat", suggestion: "latitude"},
+		{name: "nan", path: "/tiles/NaN/0", wantErr: true, wantParam: "lat", suggestion: "latitude"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Match(%s) unexpected error: %v", tt.path, err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Match(%s) expected error, got none", tt.path)
+			}
+			tes := pathvars.TemplateErrors(err)
+			if len(tes) != 1 {
+				t.Fatalf("Expected 1 template error, got %d: %v", len(tes), err)
+			}
+			if tes[0].Parameter() != tt.wantParam {
+				t.Errorf("Parameter() = %q, want %q", tes[0].Parameter(), tt.wantParam)
+			}
+			if s := tes[0].GetSuggestion(); !strings.Contains(s, tt.suggestion+" in decimal degrees") {
+				t.Errorf("GetSuggestion() = %q, want a %s-specific suggestion", s, tt.suggestion)
+			}
+		})
+	}
+}
+
+func TestCoordinateTypeRangeNarrowing(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/tropics/{lat:latitude:range[-23.5..23.5]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/tropics/10.5", nil))
+	if err != nil {
+		t.Errorf("Match() unexpected error: %v", err)
+	}
+	_, err = router.Match(httptest.NewRequest("GET", "/tropics/45", nil))
+	if !errors.Is(err, pathvars.ErrNoMatch) {
+		t.Errorf("Match() error = %v, want range violation", err)
+	}
+}
+
+func TestCoordinateTypeExamples(t *testing.T) {
+	for _, dt := range []pathvars.PVDataType{pathvars.LatitudeType, pathvars.LongitudeType} {
+		c, err := pathvars.GetDataTypeClassifier(dt)
+		if err != nil {
+			t.Fatalf("GetDataTypeClassifier(%s) error: %v", dt.Slug(), err)
+		}
+		example := fmt.Sprintf("%v", dt.Example())
+		if err = c.Validate(example); err != nil {
+			t.Errorf("%s Example() %s does not validate: %v", dt.Slug(), example, err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package test
+
+import (
+	"maps"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+// sharesMemory reports whether sub's bytes lie within s's backing array.
+func sharesMemory(s, sub string) bool {
+	if len(s) == 0 || len(sub) == 0 {
+		return false
+	}
+	start := uintptr(unsafe.Pointer(unsafe.StringData(s)))
+	p := uintptr(unsafe.Pointer(unsafe.StringData(sub)))
+	return p >= start && p < start+uintptr(len(s))
+}
+
+func TestRouterCopyValues(t *testing.T) {
+	// A large path stands in for a request buffer worth not retaining
+	path := "/files/" + strings.Repeat("a", 64<<10) + "/users/42"
+	template := pathvars.Template("/files/{blob:string}/users/{id:int}")
+
+	results := make(map[bool]pathvars.MatchResult)
+	for _, copyValues := range []bool{false, true} {
+		router := pathvars.NewRouter(&pathvars.RouterArgs{CopyValues: copyValues})
+		err := router.AddRoute("GET", template, nil)
+		if err != nil {
+			t.Fatalf("Failed to add route: %v", err)
+		}
+		result, err := router.MatchPath("GET", path)
+		if err != nil {
+			t.Fatalf("MatchPath() unexpected error: %v", err)
+		}
+		results[copyValues] = result
+
+		for name, value := range result.ValuesMap().Iterator() {
+			got := sharesMemory(path, value.(string))
+			if got == copyValues {
+				t.Errorf("CopyValues=%t: value %q shares memory with path = %t, want %t",
+					copyValues, name, got, !copyValues)
+			}
+		}
+	}
+
+	if !maps.Equal(results[false].ToMap(), results[true].ToMap()) {
+		t.Errorf("CopyValues changed values: %v vs %v", results[false].ToMap(), results[true].ToMap())
+	}
+}
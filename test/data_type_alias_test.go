@@ -0,0 +1,95 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// Registered once per process so the test also passes with -count > 1
+var numAliasErr = pathvars.RegisterDataTypeAlias("num", pathvars.IntTypeSlug)
+
+func TestRegisterDataTypeAlias(t *testing.T) {
+	if numAliasErr != nil {
+		t.Fatalf("RegisterDataTypeAlias(num, int) error: %v", numAliasErr)
+	}
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/x/{v:num:range[1..10]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/x/7", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if v, _ := result.Typed("v"); v != int64(7) {
+		t.Errorf("Typed(v) = %#v, want int64(7)", v)
+	}
+	for _, path := range []string{"/x/seven", "/x/11"} {
+		_, err = router.Match(httptest.NewRequest("GET", path, nil))
+		if _, ok := pathvars.FindErr[*pathvars.ParameterError](err); !ok {
+			t.Errorf("Match(%s) error = %v, want a *ParameterError", path, err)
+		}
+	}
+
+	if canonical := pathvars.GetDataTypeAliasMap()["num"]; canonical != pathvars.IntegerTypeSlug {
+		t.Errorf("GetDataTypeAliasMap()[num] = %q, want %q", canonical, pathvars.IntegerTypeSlug)
+	}
+}
+
+func TestBuiltInDataTypeAliases(t *testing.T) {
+	tests := []struct {
+		slug string
+		want pathvars.PVDataType
+	}{
+		{slug: "int", want: pathvars.IntegerType},
+		{slug: "integer", want: pathvars.IntegerType},
+		{slug: "bool", want: pathvars.BooleanType},
+		{slug: "boolean", want: pathvars.BooleanType},
+		{slug: "alphanum", want: pathvars.AlphanumericType},
+		{slug: "alphanumeric", want: pathvars.AlphanumericType},
+		{slug: "INT", want: pathvars.IntegerType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			dt, err := pathvars.ParsePVDataType(tt.slug)
+			if err != nil || dt != tt.want {
+				t.Errorf("ParsePVDataType(%q) = %v, %v, want %v", tt.slug, dt, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterDataTypeAliasErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		alias     pathvars.PVDataTypeSlug
+		canonical pathvars.PVDataTypeSlug
+		want      error
+	}{
+		{name: "duplicate-alias", alias: "int", canonical: "integer", want: pvtypes.ErrDataTypeAliasExists},
+		{name: "conflicting-alias", alias: "bool", canonical: "string", want: pvtypes.ErrDataTypeAliasExists},
+		{name: "built-in-slug", alias: "String", canonical: "slug", want: pvtypes.ErrDataTypeAliasExists},
+		{name: "unknown-canonical", alias: "money", canonical: "currency", want: pvtypes.ErrUnsupportedDataType},
+		{name: "invalid-alias", alias: "my-int", canonical: "int", want: pvtypes.ErrInvalidDataTypeAlias},
+		{name: "empty-alias", alias: "", canonical: "int", want: pvtypes.ErrInvalidDataTypeAlias},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pathvars.RegisterDataTypeAlias(tt.alias, tt.canonical)
+			if !errors.Is(err, pvtypes.ErrInvalidDataTypeAlias) || !errors.Is(err, tt.want) {
+				t.Errorf("RegisterDataTypeAlias(%q, %q) error = %v, want %v", tt.alias, tt.canonical, err, tt.want)
+			}
+		})
+	}
+
+	// A failed registration leaves the alias unregistered
+	if _, err := pathvars.ParsePVDataType("money"); err == nil {
+		t.Error("ParsePVDataType(money) succeeded after a rejected registration")
+	}
+}
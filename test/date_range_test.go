@@ -0,0 +1,112 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func TestDateRangeCrossCheck(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/reports/{from:date}?{to:date:format[yyyy/mm/dd]}", &pathvars.RouteArgs{
+		CrossChecks: []pathvars.CrossCheck{pathvars.DateRange("from", "to")},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/bookings?{checkin:date:format[utc]}&{checkout?:date:format[dateonly]}", &pathvars.RouteArgs{
+		CrossChecks: []pathvars.CrossCheck{pathvars.DateRange("checkin", "checkout")},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		target    string
+		wantErr   error
+		wantStart string
+		wantEnd   string
+	}{
+		{name: "valid-range", target: "/reports/2025-01-01?to=2025/01/31"},
+		{name: "equal-dates", target: "/reports/2025-01-15?to=2025/01/15"},
+		{name: "reversed-range", target: "/reports/2025-02-01?to=2025/01/31", wantErr: pathvars.ErrDateRangeReversed, wantStart: "from", wantEnd: "to"},
+		{name: "timestamp-before-date", target: "/bookings?checkin=2025-03-01T15:00:00Z&checkout=2025-03-04"},
+		{name: "timestamp-after-date", target: "/bookings?checkin=2025-03-05T15:00:00Z&checkout=2025-03-04", wantErr: pathvars.ErrDateRangeReversed, wantStart: "checkin", wantEnd: "checkout"},
+		{name: "end-absent-skips-check", target: "/bookings?checkin=2025-03-05T15:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest("GET", tt.target, nil))
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Match(%s) unexpected error: %v", tt.target, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) || !errors.Is(err, pathvars.ErrCrossCheckFailed) {
+				t.Fatalf("Match(%s) error = %v, want %v", tt.target, err, tt.wantErr)
+			}
+			for key, want := range map[string]string{"start_parameter": tt.wantStart, "end_parameter": tt.wantEnd} {
+				got, ok := pathvars.ErrValue[pathvars.Identifier](err, key)
+				if !ok || string(got) != want {
+					t.Errorf("ErrValue(%s) = %q, %t; want %q", key, got, ok, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDateRangeCrossCheckInvalidParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   pathvars.Identifier
+		end     pathvars.Identifier
+		wantErr error
+	}{
+		{name: "non-date-parameter", start: "from", end: "count", wantErr: pathvars.ErrCrossCheckParameterNotDate},
+		{name: "undeclared-parameter", start: "form", end: "to", wantErr: pathvars.ErrCrossCheckParameterNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", "/reports/{from:date}/{count:int}?{to:date}", &pathvars.RouteArgs{
+				CrossChecks: []pathvars.CrossCheck{pathvars.DateRange(tt.start, tt.end)},
+			})
+			if !errors.Is(err, pathvars.ErrInvalidCrossCheck) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddRoute() error = %v, want %v wrapping %v", err, pathvars.ErrInvalidCrossCheck, tt.wantErr)
+			}
+			if param, _ := pathvars.ErrValue[pathvars.Identifier](err, "parameter"); param != tt.end && param != tt.start {
+				t.Errorf("AddRoute() error parameter = %q, want the offending name", param)
+			}
+		})
+	}
+}
+
+func TestCrossCheckFunc(t *testing.T) {
+	errSameDay := errors.New("from and to must differ")
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/reports/{from:date}/{to:date}", &pathvars.RouteArgs{
+		CrossChecks: []pathvars.CrossCheck{
+			pathvars.CrossCheckFunc(func(_ *pathvars.ParsedTemplate, values pvtypes.ValuesMap) error {
+				from, _ := values.Get("from")
+				to, _ := values.Get("to")
+				if from == to {
+					return errSameDay
+				}
+				return nil
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	_, err = router.Match(httptest.NewRequest("GET", "/reports/2025-01-01/2025-01-01", nil))
+	if !errors.Is(err, pathvars.ErrCrossCheckFailed) || !errors.Is(err, errSameDay) {
+		t.Errorf("Match() error = %v, want ErrCrossCheckFailed wrapping errSameDay", err)
+	}
+}
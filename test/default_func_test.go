@@ -0,0 +1,83 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestParameterDefaultFunc(t *testing.T) {
+	var calls int
+	today := func() string {
+		calls++
+		return time.Now().Format(time.DateOnly)
+	}
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/events?{page?:int}", &pathvars.RouteArgs{
+		Parameters: []pathvars.Parameter{
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps:   pathvars.NameSpecProps{Name: "since", Optional: true},
+				Location:    pathvars.QueryLocation,
+				DataType:    pathvars.DateType,
+				DefaultFunc: today,
+			}),
+			// Applies to the template-declared parameter too
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps:   pathvars.NameSpecProps{Name: "page", Optional: true},
+				Location:    pathvars.QueryLocation,
+				DataType:    pathvars.IntegerType,
+				DefaultFunc: func() string { return "1" },
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	t.Run("omitted", func(t *testing.T) {
+		result, err := router.Match(httptest.NewRequest("GET", "/events", nil))
+		if err != nil {
+			t.Fatalf("Match() unexpected error: %v", err)
+		}
+		want := time.Now().Format(time.DateOnly)
+		if got, _ := result.GetValue("since"); got != want {
+			t.Errorf("GetValue(\"since\") = %v, want %q", got, want)
+		}
+		if got, _ := result.GetValue("page"); got != "1" {
+			t.Errorf("GetValue(\"page\") = %v, want %q", got, "1")
+		}
+		if result.WasProvided("since") {
+			t.Error("WasProvided(\"since\") = true, want false for a computed default")
+		}
+	})
+
+	t.Run("called-per-request", func(t *testing.T) {
+		before := calls
+		for range 3 {
+			_, err := router.Match(httptest.NewRequest("GET", "/events", nil))
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+		}
+		if got := calls - before; got != 3 {
+			t.Errorf("DefaultFunc called %d times for 3 requests, want 3", got)
+		}
+	})
+
+	t.Run("provided", func(t *testing.T) {
+		before := calls
+		result, err := router.Match(httptest.NewRequest("GET", "/events?since=2024-01-15", nil))
+		if err != nil {
+			t.Fatalf("Match() unexpected error: %v", err)
+		}
+		if got, _ := result.GetValue("since"); got != "2024-01-15" {
+			t.Errorf("GetValue(\"since\") = %v, want %q", got, "2024-01-15")
+		}
+		if calls != before {
+			t.Error("DefaultFunc called even though the client supplied a value")
+		}
+	})
+}
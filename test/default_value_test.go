@@ -0,0 +1,55 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func TestDefaultValueValidatedAtParseTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		template pathvars.Template
+		path     string
+		wantErr  error
+		param    pathvars.Identifier
+		want     string
+	}{
+		{name: "query-out-of-range", template: "/items?{limit?500:int:range[1..100]}", wantErr: pvtypes.ErrConstraintValidationFailed},
+		{name: "query-wrong-type", template: "/items?{limit?abc:int}", wantErr: pvtypes.ErrInvalidIntegerFormat},
+		{name: "query-inferred-type", template: "/items?{int?500::range[1..100]}", wantErr: pvtypes.ErrConstraintValidationFailed},
+		{name: "path-out-of-range", template: "/items/{page?500:int:range[1..100]}", wantErr: pvtypes.ErrConstraintValidationFailed},
+		{name: "path-wrong-type", template: "/items/{page?abc:int}", wantErr: pvtypes.ErrInvalidIntegerFormat},
+		{name: "query-valid", template: "/items?{limit?20:int:range[1..100]}", path: "/items", param: "limit", want: "20"},
+		{name: "path-valid", template: "/items/{page?3:int:range[1..100]}", path: "/items/7", param: "page", want: "7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", tt.template, nil)
+			if tt.wantErr != nil {
+				if !errors.Is(err, pvtypes.ErrDefaultValueViolatesConstraints) {
+					t.Fatalf("AddRoute(%q) error = %v, want %v", tt.template, err, pvtypes.ErrDefaultValueViolatesConstraints)
+				}
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("AddRoute(%q) error = %v, want it to wrap %v", tt.template, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddRoute(%q) unexpected error: %v", tt.template, err)
+			}
+			result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if err != nil {
+				t.Fatalf("Match(%q) unexpected error: %v", tt.path, err)
+			}
+			if got, _ := result.GetValue(tt.param); got != tt.want {
+				t.Errorf("GetValue(%q) = %v, want %q", tt.param, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRouterDump(t *testing.T) {
+	router := pathvars.NewRouter()
+	routes := []struct {
+		method   pathvars.HTTPMethod
+		template pathvars.Template
+		args     *pathvars.RouteArgs
+	}{
+		{"GET", "/users/{id:int:range[1..1000]}", &pathvars.RouteArgs{Description: "Get user"}},
+		{"POST", "/users", nil},
+		{"GET", "/{category}/items?{limit?10:int}", nil},
+		{"GET", "/users", nil},
+		{"GET", "/files/{path*}", nil},
+	}
+	for _, route := range routes {
+		err := router.AddRoute(route.method, route.template, route.args)
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", route.template, err)
+		}
+	}
+
+	var sb strings.Builder
+	router.Dump(&sb)
+	dump := sb.String()
+
+	for _, want := range []string{
+		"GET\n",
+		"POST\n",
+		"  /users\n",
+		"  /files\n",
+		"  (parameter)\n",
+		"[0] /users/{id:int:range[1..1000]} - Get user\n",
+		"id: integer (path) range[1..1000]\n",
+		"[1] /users\n",
+		"[2] /{category}/items?{limit?10:int}\n",
+		`limit: integer (query, optional, default "10")`,
+		"path: string (path, multi-segment)",
+	} {
+		if !strings.Contains(dump, want) {
+			t.Errorf("Dump() missing %q in:\n%s", want, dump)
+		}
+	}
+
+	// Both GET /users routes are listed under the same group
+	getSection, postSection, _ := strings.Cut(dump, "POST\n")
+	if strings.Count(getSection, "  /users\n") != 1 {
+		t.Errorf("Dump() expected one GET /users group in:\n%s", dump)
+	}
+	if !strings.Contains(postSection, "[1] /users") {
+		t.Errorf("Dump() expected POST /users under POST in:\n%s", dump)
+	}
+}
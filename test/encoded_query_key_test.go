@@ -0,0 +1,90 @@
+package test
+
+import (
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestEncodedQueryKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  map[string]string
+	}{
+		{
+			name:  "encoded-underscore",
+			query: "user%5Fname=ann",
+			want:  map[string]string{"user_name": "ann", "page": "1"},
+		},
+		{
+			name:  "every-character-encoded",
+			query: "%75%73%65%72%5F%6E%61%6D%65=ann&%70%61%67%65=3",
+			want:  map[string]string{"user_name": "ann", "page": "3"},
+		},
+		{
+			name:  "encoded-key-and-value",
+			query: "user%5Fname=ann%20lee&p%61ge=2",
+			want:  map[string]string{"user_name": "ann lee", "page": "2"},
+		},
+		{
+			name:  "first-of-plain-and-encoded-duplicates",
+			query: "user_name=ann&user%5Fname=bob",
+			want:  map[string]string{"user_name": "ann", "page": "1"},
+		},
+	}
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/search?{user_name}&{page?1:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", "/search?"+tt.query, nil))
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			got := result.ToMap()
+			for name, want := range tt.want {
+				if got[name] != want {
+					t.Errorf("value of %q = %q, want %q", name, got[name], want)
+				}
+			}
+			if !result.WasProvided("user_name") {
+				t.Error("WasProvided(user_name) = false for an encoded key")
+			}
+		})
+	}
+}
+
+func TestEncodedQueryKeysInMatchResult(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/search?{user_name}&{fields[,]?:string}", &pathvars.RouteArgs{RequireAllQuery: true})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/search?user%5Fname=ann&fi%65lds=id%2Cname&utm%5Fsource=mail", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+
+	fields, _ := result.GetValue("fields")
+	if !slices.Equal(fields.([]string), []string{"id", "name"}) {
+		t.Errorf("GetValue(fields) = %v, want [id name]", fields)
+	}
+	if got := result.Query().Get("user_name"); got != "ann" {
+		t.Errorf("Query().Get(user_name) = %q, want %q", got, "ann")
+	}
+	if got := result.QueryInOrder()[0].Name; got != "user_name" {
+		t.Errorf("QueryInOrder()[0].Name = %q, want %q", got, "user_name")
+	}
+	extra := result.ExtraQuery()
+	if len(extra) != 1 || extra["utm_source"] != "mail" {
+		t.Errorf("ExtraQuery() = %v, want only utm_source=mail", extra)
+	}
+}
@@ -0,0 +1,55 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestEnumStoresCanonicalMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		path     string
+		want     string
+	}{
+		{name: "mixed-number", template: "/users/{status:string:enum[1, active, 0, inactive]}", path: "/users/0", want: "0"},
+		{name: "mixed-word", template: "/users/{status:string:enum[1, active, 0, inactive]}", path: "/users/active", want: "active"},
+		{name: "int-leading-zero", template: "/users/{status:int:enum[1,2,3]}", path: "/users/02", want: "2"},
+		{name: "int-member-spelling", template: "/users/{status:int:enum[01,02]}", path: "/users/1", want: "01"},
+		{name: "query-int-leading-zero", template: "/users?{status:int:enum[1,2,3]}", path: "/users?status=003", want: "3"},
+		{name: "query-mixed-word", template: "/users?{status:string:enum[1, active, 0, inactive]}", path: "/users?status=inactive", want: "inactive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", pathvars.Template(tt.template), nil)
+			if err != nil {
+				t.Fatalf("Failed to add route: %v", err)
+			}
+			result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if err != nil {
+				t.Fatalf("Match(%s) unexpected error: %v", tt.path, err)
+			}
+			if got := result.ToMap()["status"]; got != tt.want {
+				t.Errorf("status = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumMixedContentRejectsNonMembers(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{status:string:enum[1, active, 0, inactive]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	for _, path := range []string{"/users/01", "/users/Active", "/users/2"} {
+		_, err = router.Match(httptest.NewRequest("GET", path, nil))
+		if err == nil {
+			t.Errorf("Match(%s) expected error for a non-member", path)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestExactlyOne(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users?{id?:int}&{email?}&{page?1:int}", &pathvars.RouteArgs{
+		ExactlyOne: [][]pathvars.Identifier{{"id", "email"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "none-provided", path: "/users?page=2", wantErr: true},
+		{name: "both-provided", path: "/users?id=7&email=a@example.com", wantErr: true},
+		{name: "only-id", path: "/users?id=7", wantErr: false},
+		{name: "only-email", path: "/users?email=a@example.com&page=2", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("Match() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, pathvars.ErrExactlyOneViolated) {
+				t.Fatalf("Match() error = %v, want ErrExactlyOneViolated", err)
+			}
+			if !strings.Contains(err.Error(), "id, email") {
+				t.Errorf("Match() error = %q, want it to name the group's members", err)
+			}
+			if status := pathvars.StatusForError(err); status != http.StatusBadRequest {
+				t.Errorf("StatusForError() = %d, want %d", status, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestExactlyOneDefaultsNotProvided(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/orders?{id?:int}&{status?open}", &pathvars.RouteArgs{
+		ExactlyOne: [][]pathvars.Identifier{{"id", "status"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	// status only has its default, so id alone satisfies the group
+	_, err = router.Match(httptest.NewRequest("GET", "/orders?id=3", nil))
+	if err != nil {
+		t.Errorf("Match() unexpected error: %v", err)
+	}
+}
+
+func TestExactlyOneInvalidGroup(t *testing.T) {
+	tests := []struct {
+		name  string
+		group []pathvars.Identifier
+		want  error
+	}{
+		{name: "undeclared-parameter", group: []pathvars.Identifier{"id", "mail"}, want: pathvars.ErrExactlyOneParameterNotFound},
+		{name: "single-member", group: []pathvars.Identifier{"id"}, want: pathvars.ErrExactlyOneGroupTooSmall},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", "/users?{id?:int}&{email?}", &pathvars.RouteArgs{
+				ExactlyOne: [][]pathvars.Identifier{tt.group},
+			})
+			if !errors.Is(err, pathvars.ErrInvalidExactlyOneGroup) || !errors.Is(err, tt.want) {
+				t.Errorf("AddRoute() error = %v, want ErrInvalidExactlyOneGroup wrapping %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExactlyOneProblemDetails(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users?{id?:int}&{email?}", &pathvars.RouteArgs{
+		ExactlyOne: [][]pathvars.Identifier{{"id", "email"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	_, err = router.Match(req)
+	pd := pathvars.NewProblemDetails(req, pathvars.StatusForError(err), err)
+	if pd.Detail != "Exactly one of id, email must be provided" {
+		t.Errorf("ProblemDetails.Detail = %q, want the group's members", pd.Detail)
+	}
+}
@@ -0,0 +1,204 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRouterExplain(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/health", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/users/{id:int}?{limit:int:range[1..100]}&{sort?:string:enum[name,date]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("POST", "/users/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	explanation := router.Explain(httptest.NewRequest("GET", "/users/42?limit=500&sort=size", nil))
+
+	if explanation.Err == nil {
+		t.Fatal("Explain() Err = nil, want the error Match() returns")
+	}
+	if _, matchErr := router.Match(httptest.NewRequest("GET", "/users/42?limit=500&sort=size", nil)); matchErr.Error() != explanation.Err.Error() {
+		t.Errorf("Explain() Err = %v, want %v", explanation.Err, matchErr)
+	}
+	if !explanation.MethodAllowed {
+		t.Error("Explain() MethodAllowed = false, want true")
+	}
+	if len(explanation.Routes) != 3 {
+		t.Fatalf("Explain() returned %d routes, want 3", len(explanation.Routes))
+	}
+
+	health := explanation.Routes[0]
+	if !health.MethodMatched || health.PathMatched {
+		t.Errorf("/health: MethodMatched = %t, PathMatched = %t, want true, false", health.MethodMatched, health.PathMatched)
+	}
+	if len(health.Parameters) != 0 || len(health.Errors) != 0 {
+		t.Errorf("/health: got %d parameters and %d errors, want none", len(health.Parameters), len(health.Errors))
+	}
+
+	users := explanation.Routes[1]
+	if !users.MethodMatched || !users.PathMatched || users.Matched() {
+		t.Errorf("GET /users: MethodMatched = %t, PathMatched = %t, Matched() = %t, want true, true, false",
+			users.MethodMatched, users.PathMatched, users.Matched())
+	}
+	if len(users.Parameters) != 3 {
+		t.Fatalf("GET /users: got %d parameters, want 3", len(users.Parameters))
+	}
+	failures := users.ParameterFailures()
+	if len(failures) != 2 {
+		t.Fatalf("GET /users: ParameterFailures() = %v, want 2 failures", failures)
+	}
+	want := map[pathvars.Identifier]string{"limit": "500", "sort": "size"}
+	for _, failure := range failures {
+		value, ok := want[failure.Name]
+		if !ok {
+			t.Errorf("GET /users: unexpected failure for %s: %v", failure.Name, failure.Err)
+			continue
+		}
+		if failure.Value != value || !failure.Provided || failure.Location != pathvars.QueryLocation {
+			t.Errorf("GET /users: %s = {Value: %q, Provided: %t, Location: %v}, want {%q, true, query}",
+				failure.Name, failure.Value, failure.Provided, failure.Location, value)
+		}
+	}
+	if len(users.Errors) == 0 {
+		t.Error("GET /users: Errors is empty, want the validation error")
+	}
+
+	post := explanation.Routes[2]
+	if post.MethodMatched || !post.PathMatched {
+		t.Errorf("POST /users: MethodMatched = %t, PathMatched = %t, want false, true", post.MethodMatched, post.PathMatched)
+	}
+}
+
+func TestRouterExplainMethodNotAllowed(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{AllowedMethods: []pathvars.HTTPMethod{"GET"}})
+	err := router.AddRoute("GET", "/health", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	explanation := router.Explain(httptest.NewRequest("DELETE", "/health", nil))
+	if explanation.MethodAllowed {
+		t.Error("Explain() MethodAllowed = true, want false")
+	}
+	if len(explanation.Routes) != 0 {
+		t.Errorf("Explain() returned %d routes, want 0", len(explanation.Routes))
+	}
+	if !errors.Is(explanation.Err, pathvars.ErrMethodNotAllowed) {
+		t.Errorf("Explain() Err = %v, want %v", explanation.Err, pathvars.ErrMethodNotAllowed)
+	}
+}
+
+func TestRouterExplainAgreesWithMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    *pathvars.RouterArgs
+		path    string
+		wantErr error
+	}{
+		{name: "trailing-slash", args: &pathvars.RouterArgs{IgnoreTrailingSlash: true}, path: "/users/5/"},
+		{name: "case-insensitive", args: &pathvars.RouterArgs{CaseInsensitive: true}, path: "/USERS/5"},
+		{name: "reserved-char", args: &pathvars.RouterArgs{StrictReservedChars: true}, path: "/users/5[", wantErr: pathvars.ErrUnencodedReservedChar},
+		{name: "values-size", args: &pathvars.RouterArgs{MaxValuesSize: 4}, path: "/users/123456", wantErr: pathvars.ErrValuesTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter(tt.args)
+			err := router.AddRoute("GET", "/users/{id}", nil)
+			if err != nil {
+				t.Fatalf("Failed to add route: %v", err)
+			}
+
+			explanation := router.Explain(httptest.NewRequest("GET", tt.path, nil))
+			if !errors.Is(explanation.Err, tt.wantErr) || (tt.wantErr == nil) != (explanation.Err == nil) {
+				t.Fatalf("Explain() Err = %v, want %v", explanation.Err, tt.wantErr)
+			}
+			route := explanation.Routes[0]
+			if !route.PathMatched {
+				t.Fatal("Explain() PathMatched = false, want true as Match() matched the path")
+			}
+			if route.Matched() != (tt.wantErr == nil) {
+				t.Errorf("Explain() Matched() = %t, want %t; Errors = %v", route.Matched(), tt.wantErr == nil, route.Errors)
+			}
+			if tt.wantErr != nil && !errors.Is(errors.Join(route.Errors...), tt.wantErr) {
+				t.Errorf("Explain() Errors = %v, want one wrapping %v", route.Errors, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRouterExplainRunsAsyncValidatorsOnce(t *testing.T) {
+	var calls atomic.Int32
+	validator := func(ctx context.Context, value string) error {
+		calls.Add(1)
+		if value != "acme" {
+			return errUnknownTenant
+		}
+		return nil
+	}
+	router := pathvars.NewRouter()
+	routes := []struct {
+		host   string
+		method pathvars.HTTPMethod
+	}{
+		{host: "api.example.com", method: "GET"},
+		{method: "POST"},
+		{method: "GET"},
+	}
+	for _, route := range routes {
+		err := router.AddRoute(route.method, "/tenants/{tenant:slug}/users", &pathvars.RouteArgs{
+			Host: route.host,
+			Parameters: []pathvars.Parameter{
+				pathvars.NewParameter(pathvars.ParameterArgs{
+					NameProps:      pathvars.NameSpecProps{Name: "tenant"},
+					Location:       pathvars.PathLocation,
+					DataType:       pathvars.SlugType,
+					AsyncValidator: validator,
+				}),
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to add %s route: %v", route.method, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr error
+	}{
+		{name: "accepted", path: "/tenants/acme/users"},
+		{name: "rejected", path: "/tenants/globex/users", wantErr: pathvars.ErrAsyncValidationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls.Store(0)
+			explanation := router.Explain(httptest.NewRequest("GET", tt.path, nil))
+			if !errors.Is(explanation.Err, tt.wantErr) {
+				t.Errorf("Explain().Err = %v, want %v", explanation.Err, tt.wantErr)
+			}
+			// Only the host-agnostic GET route can serve the request, and
+			// Match() already validated it
+			if got := calls.Load(); got != 1 {
+				t.Errorf("validator called %d times, want 1", got)
+			}
+			re := explanation.Routes[len(explanation.Routes)-1]
+			if got := errors.Is(errors.Join(re.Errors...), pathvars.ErrAsyncValidationFailed); got != (tt.wantErr != nil) {
+				t.Errorf("GET route Errors = %v, want async failure %t", re.Errors, tt.wantErr != nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"maps"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestExtraQuery(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/proxy/{id:int}?{limit?10:int}&{q:string}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		target    string
+		wantLimit string
+		want      map[string]string
+	}{
+		{
+			name:      "extras-collected",
+			target:    "/proxy/7?q=go&foo=bar&limit=5&baz=qux",
+			wantLimit: "5",
+			want:      map[string]string{"foo": "bar", "baz": "qux"},
+		},
+		{
+			name:      "no-extras",
+			target:    "/proxy/7?q=go",
+			wantLimit: "10",
+			want:      map[string]string{},
+		},
+		{
+			name:      "first-value-and-decoding",
+			target:    "/proxy/7?q=go&tag=a%20b&tag=c&empty",
+			wantLimit: "10",
+			want:      map[string]string{"tag": "a b", "empty": ""},
+		},
+		{
+			name:      "path-parameter-name-in-query-is-extra",
+			target:    "/proxy/7?q=go&id=9",
+			wantLimit: "10",
+			want:      map[string]string{"id": "9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.target, nil))
+			if err != nil {
+				t.Fatalf("Match(%s) unexpected error: %v", tt.target, err)
+			}
+			if got, _ := result.GetValue("q"); got != "go" {
+				t.Errorf("GetValue(q) = %v, want %q", got, "go")
+			}
+			if got, _ := result.GetValue("limit"); got != tt.wantLimit {
+				t.Errorf("GetValue(limit) = %v, want %q", got, tt.wantLimit)
+			}
+			got := result.ExtraQuery()
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("ExtraQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (pathvars.MatchResult{}).ExtraQuery(); got == nil || len(got) != 0 {
+		t.Errorf("zero MatchResult ExtraQuery() = %v, want empty map", got)
+	}
+}
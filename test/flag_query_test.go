@@ -0,0 +1,69 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestFlagQueryParameters(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/reports?{verbose?:flag}&{pretty?:flag}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		target      string
+		wantVerbose string
+		wantPretty  string
+		wantErr     bool
+	}{
+		{name: "bare-flag", target: "/reports?verbose", wantVerbose: "true", wantPretty: "false"},
+		{name: "bare-flags", target: "/reports?verbose&pretty", wantVerbose: "true", wantPretty: "true"},
+		{name: "empty-value", target: "/reports?verbose=", wantVerbose: "true", wantPretty: "false"},
+		{name: "absent", target: "/reports", wantVerbose: "false", wantPretty: "false"},
+		{name: "explicit-false", target: "/reports?verbose=false", wantVerbose: "false", wantPretty: "false"},
+		{name: "explicit-true", target: "/reports?verbose=true", wantVerbose: "true", wantPretty: "false"},
+		{name: "invalid-value", target: "/reports?verbose=yes", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.target, nil))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected validation error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected match but got error: %v", err)
+			}
+			if got, _ := result.GetValue("verbose"); got != tt.wantVerbose {
+				t.Errorf("verbose = %v, want %v", got, tt.wantVerbose)
+			}
+			if got, _ := result.GetValue("pretty"); got != tt.wantPretty {
+				t.Errorf("pretty = %v, want %v", got, tt.wantPretty)
+			}
+		})
+	}
+
+	t.Run("bool-type-unchanged", func(t *testing.T) {
+		router := pathvars.NewRouter()
+		err := router.AddRoute("GET", "/reports?{verbose?:bool}", nil)
+		if err != nil {
+			t.Fatalf("Failed to add route: %v", err)
+		}
+		result, err := router.Match(httptest.NewRequest("GET", "/reports?verbose", nil))
+		if err != nil {
+			t.Fatalf("Expected match but got error: %v", err)
+		}
+		// Only flag treats presence as true; a bare bool is still an empty value
+		if got, _ := result.GetValue("verbose"); got != "" {
+			t.Errorf("verbose = %v, want empty string", got)
+		}
+	})
+}
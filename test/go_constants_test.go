@@ -0,0 +1,108 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestGenerateGoConstants(t *testing.T) {
+	routes := []pathvars.RouteSpec{
+		{Name: "ListUsers", Method: "GET", Template: "/users?{limit?10:int}&{fields[,]?}"},
+		{Name: "User", Method: "GET", Template: "/users/{id:int}", Index: 1},
+		{Name: "Report", Method: "GET", Template: "/reports/{from:date}/{user_uuid:uuid}?{draft?:bool}", Index: 7},
+	}
+
+	var buf bytes.Buffer
+	err := pathvars.GenerateGoConstants(routes, "api", &buf)
+	if err != nil {
+		t.Fatalf("GenerateGoConstants() unexpected error: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "routes_gen.go", buf.Bytes(), 0)
+	if err != nil {
+		t.Fatalf("generated source does not parse: %v\n%s", err, buf.String())
+	}
+	if file.Name.Name != "api" {
+		t.Errorf("package = %q, want %q", file.Name.Name, "api")
+	}
+
+	signatures := make(map[string]string)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		var sig bytes.Buffer
+		_ = printer.Fprint(&sig, fset, fn.Type)
+		signatures[fn.Name.Name] = sig.String()
+	}
+
+	want := map[string]string{
+		"UserIDFromMatch":          "func(m pathvars.MatchResult) (value int64, ok bool)",
+		"ListUsersLimitFromMatch":  "func(m pathvars.MatchResult) (value int64, ok bool)",
+		"ListUsersFieldsFromMatch": "func(m pathvars.MatchResult) (value []string, ok bool)",
+		"ReportFromFromMatch":      "func(m pathvars.MatchResult) (value time.Time, ok bool)",
+		"ReportUserUUIDFromMatch":  "func(m pathvars.MatchResult) (value string, ok bool)",
+		"ReportDraftFromMatch":     "func(m pathvars.MatchResult) (value bool, ok bool)",
+	}
+	for name, sig := range want {
+		if signatures[name] != sig {
+			t.Errorf("%s signature = %q, want %q", name, signatures[name], sig)
+		}
+	}
+
+	for _, constant := range []string{"RouteListUsers = 0", "RouteUser = 1", "RouteReport = 7"} {
+		if !strings.Contains(buf.String(), constant) {
+			t.Errorf("generated source lacks %q", constant)
+		}
+	}
+}
+
+func TestGenerateGoConstantsInvalidSpecs(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes []pathvars.RouteSpec
+		pkg    string
+		want   error
+	}{
+		{
+			name:   "unexported-name",
+			routes: []pathvars.RouteSpec{{Name: "user", Template: "/users/{id}"}},
+			pkg:    "api",
+			want:   pathvars.ErrInvalidRouteSpec,
+		},
+		{
+			name: "duplicate-name",
+			routes: []pathvars.RouteSpec{
+				{Name: "User", Template: "/users/{id}"},
+				{Name: "User", Template: "/people/{id}"},
+			},
+			pkg:  "api",
+			want: pathvars.ErrDuplicateGoIdentifier,
+		},
+		{
+			name:   "invalid-package",
+			routes: []pathvars.RouteSpec{{Name: "User", Template: "/users/{id}"}},
+			pkg:    "my-api",
+			want:   pathvars.ErrInvalidRouteSpec,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pathvars.GenerateGoConstants(tt.routes, tt.pkg, &bytes.Buffer{})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("GenerateGoConstants() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
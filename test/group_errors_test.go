@@ -0,0 +1,35 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestGroupErrors(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}?{limit:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/users/abc?limit=xyz", nil))
+	if err == nil {
+		t.Fatal("Match() expected error, got nil")
+	}
+
+	groups := pathvars.GroupErrors(err)
+	path := groups[pathvars.PathLocation]
+	if len(path) != 1 || path[0].Parameter != "id" {
+		t.Errorf("GroupErrors()[PathLocation] = %v, want one error for 'id'", path)
+	}
+	query := groups[pathvars.QueryLocation]
+	if len(query) != 1 || query[0].Parameter != "limit" {
+		t.Errorf("GroupErrors()[QueryLocation] = %v, want one error for 'limit'", query)
+	}
+
+	if got := pathvars.GroupErrors(nil); len(got) != 0 {
+		t.Errorf("GroupErrors(nil) = %v, want empty", got)
+	}
+}
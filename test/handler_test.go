@@ -0,0 +1,161 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRouterHandler(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int:range[1..1000]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		result, ok := pathvars.MatchResultFromContext(req.Context())
+		if !ok {
+			w.WriteHeader(http.StatusTeapot)
+			_, _ = w.Write([]byte("fallback"))
+			return
+		}
+		id, _ := result.GetValue("id")
+		_, _ = w.Write([]byte(fmt.Sprintf("matched %v/%s", id, req.PathValue("id"))))
+	})
+	handler := router.Handler(fallback)
+
+	t.Run("matched", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/users/42", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		if got := rec.Body.String(); got != "matched 42/42" {
+			t.Errorf("body = %q, want %q", got, "matched 42/42")
+		}
+	})
+
+	t.Run("unmatched-passthrough", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/legacy/page", nil))
+		if rec.Code != http.StatusTeapot {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusTeapot)
+		}
+		if got := rec.Body.String(); got != "fallback" {
+			t.Errorf("body = %q, want %q", got, "fallback")
+		}
+	})
+
+	t.Run("validation-error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/users/5000", nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+			t.Errorf("Content-Type = %q, want application/problem+json", ct)
+		}
+		var pd pathvars.ProblemDetails
+		err := json.Unmarshal(rec.Body.Bytes(), &pd)
+		if err != nil {
+			t.Fatalf("Failed to decode problem details: %v", err)
+		}
+		if pd.Status != http.StatusBadRequest {
+			t.Errorf("status field = %d, want %d", pd.Status, http.StatusBadRequest)
+		}
+		if len(pd.Errors) != 1 {
+			t.Fatalf("len(errors) = %d, want 1", len(pd.Errors))
+		}
+		pp := pd.Errors[0]
+		if pp.Parameter != "id" {
+			t.Errorf("parameter = %q, want %q", pp.Parameter, "id")
+		}
+		if pp.ReceivedValue != "5000" {
+			t.Errorf("received_value = %q, want %q", pp.ReceivedValue, "5000")
+		}
+		if pp.Location != pathvars.PathLocation {
+			t.Errorf("location = %q, want %q", pp.Location, pathvars.PathLocation)
+		}
+		if !strings.Contains(pp.Suggestion, "/users/") {
+			t.Errorf("suggestion = %q, want it to contain an example URL", pp.Suggestion)
+		}
+	})
+}
+
+func TestRouterErrorHandler(t *testing.T) {
+	var handledErr error
+	router := pathvars.NewRouter(&pathvars.RouterArgs{
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			handledErr = err
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte("custom: " + pathvars.TemplateErrors(err)[0].Parameter()))
+		},
+	})
+	err := router.AddRoute("GET", "/users/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	t.Run("custom-validation-error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", "/users/abc", nil))
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+		}
+		if got := rec.Body.String(); got != "custom: id" {
+			t.Errorf("body = %q, want %q", got, "custom: id")
+		}
+		if _, ok := pathvars.FindErr[*pathvars.ParameterError](handledErr); !ok {
+			t.Errorf("ErrorHandler received %v, want a ParameterError", handledErr)
+		}
+	})
+
+	t.Run("custom-handler-via-middleware", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler := router.Handler(http.NotFoundHandler())
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/users/abc", nil))
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+		}
+	})
+}
+
+func TestRouterServeHTTPDefaultErrors(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{name: "not-found", path: "/nowhere", status: http.StatusNotFound},
+		{name: "validation-error", path: "/users/abc", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.status)
+			}
+			var pd pathvars.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &pd)
+			if err != nil {
+				t.Fatalf("Failed to decode problem details: %v", err)
+			}
+			if pd.Status != tt.status {
+				t.Errorf("status field = %d, want %d", pd.Status, tt.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestHostRoutes(t *testing.T) {
+	router := pathvars.NewRouter()
+	routes := []struct {
+		host        string
+		template    pathvars.Template
+		description string
+	}{
+		{host: "api.example.com", template: "/users/{id:int}", description: "api"},
+		{host: "*.tenants.example.com", template: "/users/{id:int}", description: "tenant"},
+		{template: "/users/{id:int}", description: "any"},
+		{host: "admin.example.com", template: "/admin", description: "admin"},
+	}
+	for _, route := range routes {
+		err := router.AddHostRoute(route.host, "GET", route.template, &pathvars.RouteArgs{
+			Description: route.description,
+		})
+		if err != nil {
+			t.Fatalf("Failed to add route %s %s: %v", route.host, route.template, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		host    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{name: "exact-host", host: "api.example.com", path: "/users/1", want: "api"},
+		{name: "exact-host-with-port", host: "api.example.com:8443", path: "/users/1", want: "api"},
+		{name: "exact-host-case-insensitive", host: "API.Example.COM", path: "/users/1", want: "api"},
+		{name: "wildcard-subdomain", host: "acme.tenants.example.com", path: "/users/1", want: "tenant"},
+		{name: "wildcard-nested-subdomain", host: "eu.acme.tenants.example.com", path: "/users/1", want: "tenant"},
+		{name: "wildcard-excludes-apex", host: "tenants.example.com", path: "/users/1", want: "any"},
+		{name: "mismatch-falls-through", host: "www.example.com", path: "/users/1", want: "any"},
+		{name: "lookalike-host-falls-through", host: "evilapi.example.com", path: "/users/1", want: "any"},
+		{name: "host-only-route", host: "admin.example.com", path: "/admin", want: "admin"},
+		{name: "host-only-route-mismatch", host: "www.example.com", path: "/admin", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			req.Host = tt.host
+			result, err := router.Match(req)
+			if tt.wantErr {
+				if !errors.Is(err, pathvars.ErrNoRouteMatched) {
+					t.Fatalf("Match() error = %v, want ErrNoRouteMatched", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			if result.Route.Description != tt.want {
+				t.Errorf("matched route %q, want %q", result.Route.Description, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostRoutesAddRoute(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users", &pathvars.RouteArgs{Host: "api.example.com"})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	req := httptest.NewRequest("GET", "/users", nil)
+	req.Host = "www.example.com"
+	_, err = router.Match(req)
+	if err == nil {
+		t.Error("Match() expected RouteArgs.Host to restrict the route")
+	}
+	if _, err = router.MatchPath("GET", "/users"); err != nil {
+		t.Errorf("MatchPath() should ignore the host, got: %v", err)
+	}
+
+	for _, host := range []string{"api.*.com", "*", "api.example.com/v1"} {
+		err = router.AddHostRoute(host, "GET", "/users", nil)
+		if !errors.Is(err, pathvars.ErrInvalidRouteHost) {
+			t.Errorf("AddHostRoute(%q) error = %v, want ErrInvalidRouteHost", host, err)
+		}
+	}
+}
+
+func TestHostRoutesEncoding(t *testing.T) {
+	source := pathvars.NewRouter()
+	err := source.AddHostRoute("*.example.com", "GET", "/users", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	data, err := source.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+	router := pathvars.NewRouter()
+	err = router.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() error: %v", err)
+	}
+	req := httptest.NewRequest("GET", "/users", nil)
+	req.Host = "example.org"
+	if _, err = router.Match(req); err == nil {
+		t.Error("Match() expected the decoded route to keep its host")
+	}
+}
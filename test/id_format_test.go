@@ -0,0 +1,54 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestIDFormat(t *testing.T) {
+	router := pathvars.NewRouter()
+	for _, template := range []pathvars.Template{
+		"/obj/{id:string:format[id]}",
+		"/orders/{id:string:format[ulid]}",
+	} {
+		err := router.AddRoute("GET", template, nil)
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", template, err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantErr    bool
+		wantFormat string
+		wantOK     bool
+	}{
+		{name: "uuid", path: "/obj/550e8400-e29b-41d4-a716-446655440000", wantFormat: "uuid", wantOK: true},
+		{name: "ulid", path: "/obj/01ARZ3NDEKTSV4RRFFQ69G5FAV", wantFormat: "ulid", wantOK: true},
+		{name: "nanoid", path: "/obj/V1StGXR8_Z5jdHi6B-myT", wantFormat: "nanoid", wantOK: true},
+		{name: "short-string", path: "/obj/abc123", wantErr: true},
+		{name: "not-format-id", path: "/orders/01ARZ3NDEKTSV4RRFFQ69G5FAV"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Match() expected an error for %s", tt.path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			format, ok := result.IDFormat("id")
+			if ok != tt.wantOK || format != tt.wantFormat {
+				t.Errorf("IDFormat(%q) = (%q, %t), want (%q, %t)", "id", format, ok, tt.wantFormat, tt.wantOK)
+			}
+		})
+	}
+}
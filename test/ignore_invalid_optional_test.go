@@ -0,0 +1,139 @@
+package test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestIgnoreInvalidOptional(t *testing.T) {
+	const template = "/items?{q}&{limit?20:int:range[1..100]}&{sort?:string:enum[name,date]}"
+
+	tests := []struct {
+		name       string
+		query      string
+		wantLimit  string
+		wantSort   string
+		wantLimitP bool // whether limit counts as provided
+	}{
+		{name: "invalid-type", query: "q=go&limit=invalid", wantLimit: "20", wantSort: "", wantLimitP: false},
+		{name: "out-of-range", query: "q=go&limit=500", wantLimit: "20", wantSort: "", wantLimitP: false},
+		{name: "invalid-enum", query: "q=go&sort=size", wantLimit: "20", wantSort: "", wantLimitP: false},
+		{name: "valid-values-kept", query: "q=go&limit=5&sort=date", wantLimit: "5", wantSort: "date", wantLimitP: true},
+	}
+
+	lenient := pathvars.NewRouter()
+	err := lenient.AddRoute("GET", template, &pathvars.RouteArgs{IgnoreInvalidOptional: true})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	strict := pathvars.NewRouter()
+	err = strict.AddRoute("GET", template, nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/items?"+tt.query, nil)
+			result, err := lenient.Match(req)
+			if err != nil {
+				t.Fatalf("Match() unexpected error with IgnoreInvalidOptional: %v", err)
+			}
+			values := result.ToMap()
+			if values["limit"] != tt.wantLimit || values["sort"] != tt.wantSort {
+				t.Errorf("limit, sort = %q, %q, want %q, %q", values["limit"], values["sort"], tt.wantLimit, tt.wantSort)
+			}
+			if result.WasProvided("limit") != tt.wantLimitP {
+				t.Errorf("WasProvided(limit) = %v, want %v", result.WasProvided("limit"), tt.wantLimitP)
+			}
+
+			_, err = strict.Match(httptest.NewRequest("GET", "/items?"+tt.query, nil))
+			wantStrictErr := tt.wantLimitP == false
+			if wantStrictErr {
+				_, ok := pathvars.FindErr[*pathvars.ParameterError](err)
+				if !ok {
+					t.Errorf("strict Match() error = %v, want a *ParameterError", err)
+				}
+			} else if err != nil {
+				t.Errorf("strict Match() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestIgnoreInvalidOptionalKeepsRequiredStrict(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/items?{page:int}&{limit?20:int}", &pathvars.RouteArgs{IgnoreInvalidOptional: true})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/items?page=first&limit=oops", nil))
+	pe, ok := pathvars.FindErr[*pathvars.ParameterError](err)
+	if !ok || pe.Parameter != "page" {
+		t.Errorf("Match() error = %v, want a *ParameterError for the required page", err)
+	}
+
+	// The option survives encoding
+	data, err := router.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+	restored := pathvars.NewRouter()
+	err = restored.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() error: %v", err)
+	}
+	result, err := restored.Match(httptest.NewRequest("GET", "/items?page=2&limit=oops", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error after UnmarshalBinary(): %v", err)
+	}
+	if limit := result.ToMap()["limit"]; limit != "20" {
+		t.Errorf("limit = %q after UnmarshalBinary(), want the default %q", limit, "20")
+	}
+}
+
+// TestIgnoreInvalidOptionalConcurrent mixes invalid and valid limits across
+// parallel requests; run it with -race to catch ignored values being removed
+// from state the requests share.
+func TestIgnoreInvalidOptionalConcurrent(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/items?{limit?20:int}", &pathvars.RouteArgs{IgnoreInvalidOptional: true})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				query, want, wantProvided := "limit=5", "5", true
+				if (g+i)%2 == 0 {
+					query, want, wantProvided = "limit=invalid", "20", false
+				}
+				result, err := router.Match(httptest.NewRequest("GET", "/items?"+query, nil))
+				if err != nil {
+					errs <- fmt.Errorf("Match(%q) unexpected error: %w", query, err)
+					return
+				}
+				if got := result.ToMap()["limit"]; got != want || result.WasProvided("limit") != wantProvided {
+					errs <- fmt.Errorf("Match(%q) limit = %q, provided %v, want %q, %v",
+						query, got, result.WasProvided("limit"), want, wantProvided)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
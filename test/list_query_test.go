@@ -0,0 +1,86 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func TestListQueryParameter(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users?{fields[,]?id:string:enum[id,name,email]}&{ids[|]?:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		fields  []string
+		ids     []string
+		wantErr error
+	}{
+		{name: "split", query: "fields=id,name", fields: []string{"id", "name"}},
+		{name: "single-element", query: "fields=email", fields: []string{"email"}},
+		{name: "default", query: "", fields: []string{"id"}},
+		{name: "other-delimiter", query: "ids=1|2|3", fields: []string{"id"}, ids: []string{"1", "2", "3"}},
+		{name: "element-outside-enum", query: "fields=id,bogus", wantErr: pvtypes.ErrParameterValidationFailed},
+		{name: "element-wrong-type", query: "ids=1|x", wantErr: pvtypes.ErrParameterValidationFailed},
+		{name: "empty-element", query: "fields=id,,name", wantErr: pvtypes.ErrEmptyListElement},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", "/users?"+tt.query, nil))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Match(%q) error = %v, want %v", tt.query, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match(%q) unexpected error: %v", tt.query, err)
+			}
+			for name, want := range map[pathvars.Identifier][]string{"fields": tt.fields, "ids": tt.ids} {
+				value, found := result.GetValue(name)
+				if want == nil {
+					// An omitted optional int has no implicit default
+					if found {
+						t.Errorf("GetValue(%q) = %#v, want not found", name, value)
+					}
+					continue
+				}
+				got, ok := value.([]string)
+				if !ok || !slices.Equal(got, want) {
+					t.Errorf("GetValue(%q) = %#v, want %#v", name, value, want)
+				}
+			}
+		})
+	}
+}
+
+func TestListQueryParameterToMap(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{CopyValues: true})
+	err := router.AddRoute("GET", "/posts?{tags[,]:slug}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	result, err := router.Match(httptest.NewRequest("GET", "/posts?tags=go,web-dev", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if got := result.ToMap()["tags"]; got != "go,web-dev" {
+		t.Errorf(`ToMap()["tags"] = %q, want "go,web-dev"`, got)
+	}
+}
+
+func TestListParameterRequiresQuery(t *testing.T) {
+	_, err := pathvars.ParseTemplate("/users/{ids[,]:int}")
+	if !errors.Is(err, pvtypes.ErrListParameterNotInQuery) {
+		t.Fatalf("ParseTemplate() error = %v, want %v", err, pvtypes.ErrListParameterNotInQuery)
+	}
+}
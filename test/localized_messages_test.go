@@ -0,0 +1,74 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestLocalizedErrorMessages(t *testing.T) {
+	pathvars.SetMessages("fr", map[string]string{
+		pathvars.TypeErrorDetailMessage:     "Le paramètre '{parameter}' attend le type {type} mais a reçu '{value}'",
+		pathvars.TypeErrorSuggestionMessage: "Utilisez une valeur {type} pour '{parameter}', par exemple : {example}",
+	})
+
+	matchTypeError := func(t *testing.T, router *pathvars.Router, lang string) *pathvars.TemplateError {
+		t.Helper()
+		req := httptest.NewRequest("GET", "/users/abc", nil)
+		if lang != "" {
+			req = req.WithContext(pathvars.WithLanguage(req.Context(), lang))
+		}
+		_, err := router.Match(req)
+		if err == nil {
+			t.Fatal("Match() expected error, got nil")
+		}
+		tes := pathvars.TemplateErrors(err)
+		if len(tes) != 1 {
+			t.Fatalf("TemplateErrors() returned %d errors, want 1", len(tes))
+		}
+		return tes[0]
+	}
+
+	const wantFrench = "Utilisez une valeur integer pour 'id', par exemple : /users/123"
+	const wantDetail = "Le paramètre 'id' attend le type integer mais a reçu 'abc'"
+	const wantEnglish = "Use an integer for 'id' like 123, for example: /users/123"
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	t.Run("context-language", func(t *testing.T) {
+		te := matchTypeError(t, router, "fr-CA")
+		if got := te.GetSuggestion(); got != wantFrench {
+			t.Errorf("GetSuggestion() = %q, want %q", got, wantFrench)
+		}
+		if got := te.Detail(); got != wantDetail {
+			t.Errorf("Detail() = %q, want %q", got, wantDetail)
+		}
+	})
+
+	t.Run("default-english", func(t *testing.T) {
+		te := matchTypeError(t, router, "")
+		if got := te.GetSuggestion(); got != wantEnglish {
+			t.Errorf("GetSuggestion() = %q, want %q", got, wantEnglish)
+		}
+	})
+
+	t.Run("router-language", func(t *testing.T) {
+		frRouter := pathvars.NewRouter(&pathvars.RouterArgs{Language: "fr"})
+		err := frRouter.AddRoute("GET", "/users/{id:int}", nil)
+		if err != nil {
+			t.Fatalf("Failed to add route: %v", err)
+		}
+		if got := matchTypeError(t, frRouter, "").GetSuggestion(); got != wantFrench {
+			t.Errorf("GetSuggestion() = %q, want %q", got, wantFrench)
+		}
+		// The request context overrides the router's language
+		if got := matchTypeError(t, frRouter, "en").GetSuggestion(); got != wantEnglish {
+			t.Errorf("GetSuggestion() = %q, want %q", got, wantEnglish)
+		}
+	})
+}
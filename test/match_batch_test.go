@@ -0,0 +1,121 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func newBatchRouter(tb testing.TB) *pathvars.Router {
+	tb.Helper()
+	router := pathvars.NewRouter()
+	for _, template := range []string{
+		"/users/{id:int}",
+		"/users/{id:int}/posts/{slug:slug}",
+		"/orgs/{org:slug}/repos/{repo:string}",
+		"/archive/{date*:date}",
+		"/search?{q:string}&{limit?10:int:range[1..100]}",
+		"/files/{path*}",
+	} {
+		err := router.AddRoute("GET", pathvars.Template(template), nil)
+		if err != nil {
+			tb.Fatalf("Failed to add route %s: %v", template, err)
+		}
+	}
+	return router
+}
+
+func batchPaths(n int) []string {
+	samples := []string{
+		"/users/%d",
+		"/users/%d/posts/hello-world",
+		"/orgs/acme/repos/repo-%d",
+		"/archive/2025/10/%02d",
+		"/search?q=term%d&limit=20",
+		"/search?q=term%d&limit=500",
+		"/files/a/b/%d.txt",
+		"/nowhere/%d",
+		"/users/not-a-number-%d",
+	}
+	paths := make([]string, n)
+	for i := range paths {
+		paths[i] = fmt.Sprintf(samples[i%len(samples)], i%28+1)
+	}
+	return paths
+}
+
+func TestMatchBatchMatchesIndividualCalls(t *testing.T) {
+	router := newBatchRouter(t)
+	paths := batchPaths(200)
+
+	results := router.MatchBatch("GET", paths)
+	if len(results) != len(paths) {
+		t.Fatalf("MatchBatch() returned %d results, want %d", len(results), len(paths))
+	}
+
+	for i, path := range paths {
+		single, err := router.MatchPath("GET", path)
+		if err != nil {
+			if results[i].Route != nil {
+				t.Errorf("%s: MatchPath() error %v but MatchBatch() matched route %d", path, err, results[i].Index)
+			}
+			continue
+		}
+		if results[i].Route != single.Route {
+			t.Errorf("%s: MatchBatch() route = %v, want %v", path, results[i].Route, single.Route)
+			continue
+		}
+		if !reflect.DeepEqual(results[i].ToMap(), single.ToMap()) {
+			t.Errorf("%s: MatchBatch() values = %v, want %v", path, results[i].ToMap(), single.ToMap())
+		}
+
+		// MatchPath() must agree with Match() for requests without body or Accept requirements
+		viaReq, err := router.Match(httptest.NewRequest("GET", path, nil))
+		if err != nil || viaReq.Route != single.Route {
+			t.Errorf("%s: Match() = %v, %v; MatchPath() matched route %d", path, viaReq.Route, err, single.Index)
+		}
+	}
+}
+
+func TestMatchBatchUnmatchedPaths(t *testing.T) {
+	router := newBatchRouter(t)
+	results := router.MatchBatch("POST", []string{"/users/1"})
+	if results[0].Route != nil {
+		t.Errorf("Expected no match for POST, got route %d", results[0].Index)
+	}
+
+	results = router.MatchBatch("GET", []string{"/nowhere", "/users/abc", "/users/7"})
+	if results[0].Route != nil || results[1].Route != nil {
+		t.Errorf("Expected unmatched and invalid paths to yield zero results, got %v", results[:2])
+	}
+	if id := results[2].ToMap()["id"]; id != "7" {
+		t.Errorf("Expected id=7, got %q", id)
+	}
+}
+
+func BenchmarkMatchVsMatchBatch(b *testing.B) {
+	router := newBatchRouter(b)
+	paths := batchPaths(100_000)
+
+	reqs := make([]*http.Request, len(paths))
+	for i, path := range paths {
+		reqs[i] = httptest.NewRequest("GET", path, nil)
+	}
+
+	b.Run("Match", func(b *testing.B) {
+		for b.Loop() {
+			for _, req := range reqs {
+				_, _ = router.Match(req)
+			}
+		}
+	})
+	b.Run("MatchBatch", func(b *testing.B) {
+		for b.Loop() {
+			_ = router.MatchBatch("GET", paths)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRouterMatchInto(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}/posts/{slug:slug}?{limit?10:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	t.Run("sets-path-values", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/users/42/posts/hello-world", nil)
+		_, err := router.MatchInto(req)
+		if err != nil {
+			t.Fatalf("MatchInto() unexpected error: %v", err)
+		}
+		if got := req.PathValue("id"); got != "42" {
+			t.Errorf("PathValue(\"id\") = %q, want %q", got, "42")
+		}
+		if got := req.PathValue("slug"); got != "hello-world" {
+			t.Errorf("PathValue(\"slug\") = %q, want %q", got, "hello-world")
+		}
+		if got := req.PathValue("limit"); got != "10" {
+			t.Errorf("PathValue(\"limit\") = %q, want %q", got, "10")
+		}
+	})
+
+	t.Run("list-values-keep-delimiter", func(t *testing.T) {
+		router := pathvars.NewRouter()
+		err := router.AddRoute("GET", "/users?{ids[,]:int}", nil)
+		if err != nil {
+			t.Fatalf("Failed to add route: %v", err)
+		}
+		req := httptest.NewRequest("GET", "/users?ids=1,2", nil)
+		_, err = router.MatchInto(req)
+		if err != nil {
+			t.Fatalf("MatchInto() unexpected error: %v", err)
+		}
+		if got := req.PathValue("ids"); got != "1,2" {
+			t.Errorf("PathValue(\"ids\") = %q, want %q", got, "1,2")
+		}
+	})
+
+	t.Run("no-values-on-failure", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/users/abc/posts/hello-world", nil)
+		_, err := router.MatchInto(req)
+		if err == nil {
+			t.Fatal("MatchInto() expected validation error but got none")
+		}
+		if got := req.PathValue("id"); got != "" {
+			t.Errorf("PathValue(\"id\") = %q, want empty on failed match", got)
+		}
+	})
+}
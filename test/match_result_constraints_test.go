@@ -0,0 +1,57 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatchResultConstraints(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/items/{id:int:range[1..100]}?{tag?:string:length[2..10],!enum[admin]}&{q?:string}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/items/42", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+
+	constraints := result.Constraints("id")
+	if len(constraints) != 1 {
+		t.Fatalf("Constraints(id) returned %d constraints, want 1", len(constraints))
+	}
+	if constraints[0].Type() != pathvars.RangeConstraintType {
+		t.Errorf("Constraints(id)[0].Type() = %v, want %v", constraints[0].Type(), pathvars.RangeConstraintType)
+	}
+	if constraints[0].Rule() != "1..100" {
+		t.Errorf("Constraints(id)[0].Rule() = %q, want %q", constraints[0].Rule(), "1..100")
+	}
+
+	// Constraints of a parameter that was not provided are still available
+	constraints = result.Constraints("tag")
+	if len(constraints) != 2 {
+		t.Fatalf("Constraints(tag) returned %d constraints, want 2", len(constraints))
+	}
+	if constraints[0].Type() != pathvars.LengthConstraintType {
+		t.Errorf("Constraints(tag)[0].Type() = %v, want %v", constraints[0].Type(), pathvars.LengthConstraintType)
+	}
+
+	// Changing the returned slice does not affect the route
+	constraints[0] = nil
+	if result.Constraints("tag")[0] == nil {
+		t.Error("Constraints() returned a slice sharing the route's constraints")
+	}
+
+	for _, name := range []pathvars.Identifier{"q", "missing"} {
+		if got := result.Constraints(name); got != nil {
+			t.Errorf("Constraints(%s) = %v, want nil", name, got)
+		}
+	}
+
+	if got := (pathvars.MatchResult{}).Constraints("id"); got != nil {
+		t.Errorf("zero MatchResult Constraints() = %v, want nil", got)
+	}
+}
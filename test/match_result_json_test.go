@@ -0,0 +1,69 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatchResultMarshalJSON(t *testing.T) {
+	router := pathvars.NewRouter()
+	for _, template := range []pathvars.Template{
+		"/health",
+		"/users/{id:int}/{on:date}?{active?:bool}&{price?:decimal}&{tags[,]?:slug}&{name?}",
+	} {
+		if err := router.AddRoute("GET", template, nil); err != nil {
+			t.Fatalf("AddRoute(%q) unexpected error: %v", template, err)
+		}
+	}
+
+	req := httptest.NewRequest("GET", "/users/123/2025-03-14?active=true&price=19.5&tags=go,web&name=Ann", nil)
+	result, err := router.Match(req)
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+
+	var got struct {
+		Index    int                        `json:"index"`
+		Method   string                     `json:"method"`
+		Template string                     `json:"template"`
+		Values   map[string]json.RawMessage `json:"values"`
+	}
+	if err = json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) unexpected error: %v", data, err)
+	}
+	if got.Index != 1 || got.Method != "GET" || got.Template != result.Route.ParsedTemplate.Original() {
+		t.Errorf("index, method, template = %d, %q, %q; want 1, GET, %q", got.Index, got.Method, got.Template, result.Route.ParsedTemplate.Original())
+	}
+
+	want := map[string]string{
+		"id":     `123`,
+		"on":     `"2025-03-14"`,
+		"active": `true`,
+		"price":  `19.5`,
+		"tags":   `["go","web"]`,
+		"name":   `"Ann"`,
+	}
+	for name, wantJSON := range want {
+		if gotJSON := string(got.Values[name]); gotJSON != wantJSON {
+			t.Errorf("values[%q] = %s, want %s (in %s)", name, gotJSON, wantJSON, data)
+		}
+	}
+}
+
+func TestMatchResultMarshalJSONZero(t *testing.T) {
+	data, err := json.Marshal(pathvars.MatchResult{})
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	if string(data) != `{"index":0,"values":{}}` {
+		t.Errorf("json.Marshal(MatchResult{}) = %s", data)
+	}
+}
@@ -0,0 +1,113 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatrixParameters(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{MatrixParameters: true})
+	for _, template := range []pathvars.Template{
+		"/users/{id:int}",
+		"/maps/{map}/tiles",
+		"/files/{path*}",
+	} {
+		err := router.AddRoute("GET", template, nil)
+		if err != nil {
+			t.Fatalf("AddRoute(%s) unexpected error: %v", template, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		want    map[pathvars.Identifier]string
+		absent  []pathvars.Identifier
+		wantErr bool
+	}{
+		{
+			name:   "parameter-segment",
+			target: "/users/123;role=admin",
+			want:   map[pathvars.Identifier]string{"id": "123", "id.role": "admin"},
+		},
+		{
+			name:   "several-pairs-and-bare-key",
+			target: "/users/123;role=admin;active;lang=en",
+			want:   map[pathvars.Identifier]string{"id": "123", "id.role": "admin", "id.active": "", "id.lang": "en"},
+		},
+		{
+			name:   "first-value-wins",
+			target: "/users/123;role=admin;role=guest",
+			want:   map[pathvars.Identifier]string{"id.role": "admin"},
+		},
+		{
+			name:   "literal-segment",
+			target: "/maps/world;zoom=3/tiles;format=png",
+			want:   map[pathvars.Identifier]string{"map": "world", "map.zoom": "3", "tiles.format": "png"},
+		},
+		{
+			name:   "multi-segment-parameter",
+			target: "/files/docs;rev=2/readme.md;lang=fr",
+			want:   map[pathvars.Identifier]string{"path": "docs/readme.md", "path.rev": "2", "path.lang": "fr"},
+		},
+		{
+			name:   "normal-segments-unaffected",
+			target: "/users/123",
+			want:   map[pathvars.Identifier]string{"id": "123"},
+			absent: []pathvars.Identifier{"id.role"},
+		},
+		{
+			name:    "parameter-still-validated",
+			target:  "/users/abc;role=admin",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.target, nil))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Match(%s) expected error but got none", tt.target)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match(%s) unexpected error: %v", tt.target, err)
+			}
+			for name, want := range tt.want {
+				got, found := result.GetValue(name)
+				if !found || got != want {
+					t.Errorf("GetValue(%s) = %v, %t; want %q", name, got, found, want)
+				}
+			}
+			for _, name := range tt.absent {
+				if _, found := result.GetValue(name); found {
+					t.Errorf("GetValue(%s) unexpectedly found", name)
+				}
+			}
+		})
+	}
+}
+
+func TestMatrixParametersDisabledByDefault(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/users/123;role=admin", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	got, _ := result.GetValue("id")
+	if got != "123;role=admin" {
+		t.Errorf("GetValue(id) = %v, want %q", got, "123;role=admin")
+	}
+	if _, found := result.GetValue("id.role"); found {
+		t.Error("GetValue(id.role) found with MatrixParameters disabled")
+	}
+}
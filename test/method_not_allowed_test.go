@@ -0,0 +1,100 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func newUsersRouter(t *testing.T) *pathvars.Router {
+	t.Helper()
+	router := pathvars.NewRouter()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	for _, method := range []pathvars.HTTPMethod{"GET", "PUT", "GET"} {
+		err := router.AddRoute(method, "/users/{id:int}", &pathvars.RouteArgs{Handler: handler})
+		if err != nil {
+			t.Fatalf("Failed to add %s route: %v", method, err)
+		}
+	}
+	err := router.AddRoute("POST", "/users", &pathvars.RouteArgs{Handler: handler})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	return router
+}
+
+func TestMatchMethodNotAllowed(t *testing.T) {
+	router := newUsersRouter(t)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		want405     bool
+		wantAllowed string
+	}{
+		{name: "wrong-method", method: "DELETE", path: "/users/42", want405: true, wantAllowed: "GET, PUT"},
+		{name: "wrong-method-invalid-value", method: "DELETE", path: "/users/abc", want405: true, wantAllowed: "GET, PUT"},
+		{name: "other-path", method: "GET", path: "/users", want405: true, wantAllowed: "POST"},
+		{name: "unknown-path", method: "DELETE", path: "/orders/42", want405: false},
+		{name: "right-method-invalid-value", method: "GET", path: "/users/abc", want405: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest(tt.method, tt.path, nil))
+			if errors.Is(err, pathvars.ErrMethodNotAllowed) != tt.want405 {
+				t.Fatalf("Match() error = %v, want ErrMethodNotAllowed %v", err, tt.want405)
+			}
+			if !tt.want405 {
+				return
+			}
+			// Still a no-match, so Handler() passes it on to the next handler
+			if !errors.Is(err, pathvars.ErrNoRouteMatched) {
+				t.Errorf("Match() error = %v, want it to also wrap ErrNoRouteMatched", err)
+			}
+			if status := pathvars.StatusForError(err); status != http.StatusMethodNotAllowed {
+				t.Errorf("StatusForError() = %d, want %d", status, http.StatusMethodNotAllowed)
+			}
+			if allowed, _ := pathvars.ErrValue[string](err, "allowed_methods"); allowed != tt.wantAllowed {
+				t.Errorf("allowed_methods = %q, want %q", allowed, tt.wantAllowed)
+			}
+
+			_, err = router.MatchPath(pathvars.HTTPMethod(tt.method), tt.path)
+			if !errors.Is(err, pathvars.ErrMethodNotAllowed) {
+				t.Errorf("MatchPath() error = %v, want ErrMethodNotAllowed", err)
+			}
+		})
+	}
+}
+
+func TestServeHTTPMethodNotAllowed(t *testing.T) {
+	router := newUsersRouter(t)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("DELETE", "/users/42", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+	if got := rec.Header().Get("Allow"); got != "GET, PUT" {
+		t.Errorf("Allow = %q, want %q", got, "GET, PUT")
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("DELETE", "/orders/42", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d for an unknown path, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	// As middleware the request is passed on, since next may serve DELETE
+	var reached bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { reached = true })
+	rec = httptest.NewRecorder()
+	router.Handler(next).ServeHTTP(rec, httptest.NewRequest("DELETE", "/users/42", nil))
+	if !reached {
+		t.Error("Handler() did not pass a wrong-method request to next")
+	}
+}
@@ -0,0 +1,54 @@
+package test
+
+import (
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMissingParameters(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/search?{email:string}&{min_score:int}&{page?1:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/export?{format?csv:string}&{limit?10:int}", &pathvars.RouteArgs{
+		RequireAllQuery: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		target string
+		want   []pathvars.Identifier
+	}{
+		{name: "two-missing", target: "/search", want: []pathvars.Identifier{"email", "min_score"}},
+		{name: "one-missing", target: "/search?email=a%40example.com", want: []pathvars.Identifier{"min_score"}},
+		{name: "missing-with-invalid", target: "/search?page=abc", want: []pathvars.Identifier{"email", "min_score"}},
+		{name: "invalid-only", target: "/search?email=a%40example.com&min_score=high"},
+		{name: "require-all-query", target: "/export", want: []pathvars.Identifier{"format", "limit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest("GET", tt.target, nil))
+			if err == nil {
+				t.Fatalf("Match(%s) expected error, got nil", tt.target)
+			}
+			// Parameter order is not guaranteed, so compare sorted names
+			got := pathvars.MissingParameters(err)
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("MissingParameters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := pathvars.MissingParameters(nil); len(got) != 0 {
+		t.Errorf("MissingParameters(nil) = %v, want empty", got)
+	}
+}
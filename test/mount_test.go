@@ -0,0 +1,202 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func newAdminRouter(t *testing.T) *pathvars.Router {
+	t.Helper()
+	admin := pathvars.NewRouter()
+	routes := []struct {
+		method      pathvars.HTTPMethod
+		template    pathvars.Template
+		description string
+	}{
+		{method: "GET", template: "/", description: "admin-home"},
+		{method: "GET", template: "/users/{id:int}", description: "admin-user"},
+		{method: "DELETE", template: "/users/{id:int}", description: "admin-delete-user"},
+		{method: "GET", template: "/audit?{limit?10:int}", description: "admin-audit"},
+	}
+	for _, route := range routes {
+		description := route.description
+		err := admin.AddRoute(route.method, route.template, &pathvars.RouteArgs{
+			Description: route.description,
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(description))
+			}),
+		})
+		if err != nil {
+			t.Fatalf("Failed to add route %s %s: %v", route.method, route.template, err)
+		}
+	}
+	return admin
+}
+
+func TestMount(t *testing.T) {
+	router := pathvars.NewRouter()
+	for _, template := range []pathvars.Template{"/status", "/users/{id:int}"} {
+		err := router.AddRoute("GET", template, &pathvars.RouteArgs{Description: "public"})
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", template, err)
+		}
+	}
+
+	err := router.Mount("/admin/", newAdminRouter(t))
+	if err != nil {
+		t.Fatalf("Mount() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		method    string
+		target    string
+		want      string
+		wantIndex int
+		wantValue string
+		wantErr   bool
+	}{
+		{name: "parent-route", method: "GET", target: "/status", want: "public", wantIndex: 0},
+		{name: "parent-param-route", method: "GET", target: "/users/7", want: "public", wantIndex: 1, wantValue: "7"},
+		{name: "mounted-root-is-prefix", method: "GET", target: "/admin", want: "admin-home", wantIndex: 2},
+		{name: "mounted-route", method: "GET", target: "/admin/users/7", want: "admin-user", wantIndex: 3, wantValue: "7"},
+		{name: "mounted-method", method: "DELETE", target: "/admin/users/7", want: "admin-delete-user", wantIndex: 4, wantValue: "7"},
+		{name: "mounted-only-path", method: "GET", target: "/admin/audit?limit=5", want: "admin-audit", wantIndex: 5},
+		{name: "sub-path-not-at-root", method: "GET", target: "/audit", wantErr: true},
+		{name: "mounted-validation", method: "GET", target: "/admin/users/abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest(tt.method, tt.target, nil))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			if result.Route.Description != tt.want {
+				t.Errorf("matched route %q, want %q", result.Route.Description, tt.want)
+			}
+			if result.Index != tt.wantIndex {
+				t.Errorf("Index = %d, want %d", result.Index, tt.wantIndex)
+			}
+			if tt.wantValue != "" {
+				value, _ := result.GetValue("id")
+				if value != tt.wantValue {
+					t.Errorf("id = %v, want %v", value, tt.wantValue)
+				}
+			}
+		})
+	}
+}
+
+func TestMountPreservesHandlers(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.Mount("/admin", newAdminRouter(t))
+	if err != nil {
+		t.Fatalf("Mount() unexpected error: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", "/admin/users/7", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Body.String() != "admin-user" {
+		t.Errorf("body = %q, want %q", rec.Body.String(), "admin-user")
+	}
+}
+
+func TestMountPrefixParameters(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.Mount("/orgs/{org:string}", newAdminRouter(t))
+	if err != nil {
+		t.Fatalf("Mount() unexpected error: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/orgs/acme/users/7", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	org, _ := result.GetValue("org")
+	if org != "acme" {
+		t.Errorf("org = %v, want %v", org, "acme")
+	}
+	id, _ := result.GetValue("id")
+	if id != "7" {
+		t.Errorf("id = %v, want %v", id, "7")
+	}
+}
+
+func TestMountIntoEmptyRouterKeepsIndices(t *testing.T) {
+	sub := pathvars.NewRouter()
+	for _, template := range []pathvars.Template{"/users/{id}", "/users/me"} {
+		err := sub.AddRoute("GET", template, nil)
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", template, err)
+		}
+	}
+
+	router := pathvars.NewRouter()
+	err := router.Mount("/admin", sub)
+	if err != nil {
+		t.Fatalf("Mount() unexpected error: %v", err)
+	}
+
+	indices := make(map[int]string)
+	router.Walk(func(_ pathvars.HTTPMethod, pt *pathvars.ParsedTemplate, args pathvars.RouteArgs) bool {
+		if other, ok := indices[args.Index]; ok {
+			t.Errorf("Index %d used by both %s and %s", args.Index, other, pt.Original())
+		}
+		indices[args.Index] = pt.Original()
+		return true
+	})
+
+	tests := []struct {
+		index  int
+		values map[pathvars.Identifier]any
+		want   string
+	}{
+		{0, map[pathvars.Identifier]any{"id": "42"}, "/admin/users/42"},
+		{1, nil, "/admin/users/me"},
+	}
+	for _, tt := range tests {
+		u, err := router.URLFor(tt.index, tt.values)
+		if err != nil {
+			t.Fatalf("URLFor(%d) unexpected error: %v", tt.index, err)
+		}
+		if u != tt.want {
+			t.Errorf("URLFor(%d) = %q, want %q", tt.index, u, tt.want)
+		}
+	}
+}
+
+func TestMountInvalidPrefix(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.Mount("/admin?{q}", newAdminRouter(t))
+	if !errors.Is(err, pathvars.ErrInvalidMountPrefix) {
+		t.Fatalf("Mount() error = %v, want ErrInvalidMountPrefix", err)
+	}
+
+	// A prefix that fails to parse adds no routes
+	err = router.Mount("/accounts/{id:nosuchtype}", newAdminRouter(t))
+	if err == nil {
+		t.Fatal("Expected error for unknown prefix parameter type but got none")
+	}
+	count := 0
+	router.Walk(func(pathvars.HTTPMethod, *pathvars.ParsedTemplate, pathvars.RouteArgs) bool {
+		count++
+		return true
+	})
+	if count != 0 {
+		t.Errorf("router has %d routes after failed Mount(), want 0", count)
+	}
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func TestMultiParameterSegment(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/export/{id:int}.{ext:string:enum[csv,json,xml]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/export/42.csv", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if got, _ := result.GetValue("id"); got != "42" {
+		t.Errorf("GetValue(\"id\") = %v, want %q", got, "42")
+	}
+	if got, _ := result.GetValue("ext"); got != "csv" {
+		t.Errorf("GetValue(\"ext\") = %v, want %q", got, "csv")
+	}
+
+	for _, path := range []string{"/export/42.pdf", "/export/abc.csv"} {
+		_, err = router.Match(httptest.NewRequest("GET", path, nil))
+		if err == nil {
+			t.Errorf("Match(%q) expected a validation error, got nil", path)
+		}
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/export/42", nil))
+	if err == nil {
+		t.Error("Match(\"/export/42\") expected no match, got nil error")
+	}
+}
+
+func TestMultiParameterSegmentTemplate(t *testing.T) {
+	pt, err := pathvars.ParseTemplate("/files/v{major:int}.{minor:int}-{name}.tar")
+	if err != nil {
+		t.Fatalf("ParseTemplate() unexpected error: %v", err)
+	}
+	want := `^/files/v([^/]+)\.([^/]+)-([^/]+)\.tar$`
+	if got := pt.RegexString(); got != want {
+		t.Errorf("RegexString() = %q, want %q", got, want)
+	}
+
+	values := pvtypes.NewOrderedMap[pathvars.Identifier, any](3)
+	values.Set("major", 1)
+	values.Set("minor", 2)
+	values.Set("name", "core")
+	path, err := pt.Substitute(values)
+	if err != nil {
+		t.Fatalf("Substitute() unexpected error: %v", err)
+	}
+	if path != "/files/v1.2-core.tar" {
+		t.Errorf("Substitute() = %q, want %q", path, "/files/v1.2-core.tar")
+	}
+}
+
+func TestMultiParameterSegmentErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  error
+	}{
+		{name: "adjacent-parameters", template: "/export/{id}{ext}", wantErr: pathvars.ErrAdjacentSegmentParameters},
+		{name: "duplicate-in-segment", template: "/export/{id}.{id}", wantErr: pathvars.ErrDuplicateParameterName},
+		{name: "unmatched-brace", template: "/export/{id}.{ext", wantErr: pathvars.ErrUnmatchedOpeningBrace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pathvars.ParseTemplate(tt.template)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseTemplate(%q) error = %v, want %v", tt.template, err, tt.wantErr)
+			}
+		})
+	}
+}
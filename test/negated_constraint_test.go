@@ -0,0 +1,74 @@
+package test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestNegatedConstraints(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   string
+		path       string
+		wantErr    bool
+		suggestion string
+	}{
+		{name: "regex-not-all-digits-ok", template: "/users/{name:string:!regex[[0-9]+]}", path: "/users/alice"},
+		{name: "regex-mixed-ok", template: "/users/{name:string:!regex[[0-9]+]}", path: "/users/alice42"},
+		{name: "regex-all-digits-rejected", template: "/users/{name:string:!regex[[0-9]+]}", path: "/users/12345", wantErr: true, suggestion: "does NOT match the pattern [0-9]+"},
+		{name: "enum-other-ok", template: "/users/{name:string:!enum[admin,root]}", path: "/users/alice"},
+		{name: "enum-member-rejected", template: "/users/{name:string:!enum[admin,root]}", path: "/users/root", wantErr: true, suggestion: "does NOT satisfy the constraint: enum[admin,root]"},
+		{name: "range-outside-ok", template: "/ports/{port:int:!range[0..1023]}", path: "/ports/8080"},
+		{name: "range-inside-rejected", template: "/ports/{port:int:!range[0..1023]}", path: "/ports/80", wantErr: true, suggestion: "does NOT satisfy the constraint: range[0..1023]"},
+		{name: "composed-ok", template: "/users/{name:string:length[3..10],!enum[admin,root]}", path: "/users/alice"},
+		{name: "composed-negated-fails", template: "/users/{name:string:length[3..10],!enum[admin,root]}", path: "/users/admin", wantErr: true, suggestion: "does NOT satisfy"},
+		{name: "composed-positive-fails", template: "/users/{name:string:length[3..10],!enum[admin,root]}", path: "/users/al", wantErr: true, suggestion: "length"},
+		{name: "composed-regex-ok", template: "/users/{name:string:length[3..10],!regex[[0-9]+]}", path: "/users/bob"},
+		{name: "composed-regex-negated-fails", template: "/users/{name:string:length[3..10],!regex[[0-9]+]}", path: "/users/1234", wantErr: true, suggestion: "does NOT match the pattern"},
+		{name: "composed-regex-positive-fails", template: "/users/{name:string:length[3..10],!regex[[0-9]+]}", path: "/users/bobbybobbybob", wantErr: true, suggestion: "length"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", pathvars.Template(tt.template), nil)
+			if err != nil {
+				t.Fatalf("Failed to add route: %v", err)
+			}
+			_, err = router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Match(%s) unexpected error: %v", tt.path, err)
+				}
+				return
+			}
+			tes := pathvars.TemplateErrors(err)
+			if len(tes) != 1 {
+				t.Fatalf("Expected 1 template error, got %d: %v", len(tes), err)
+			}
+			if s := tes[0].GetSuggestion(); !strings.Contains(s, tt.suggestion) {
+				t.Errorf("GetSuggestion() = %q, want it to contain %q", s, tt.suggestion)
+			}
+		})
+	}
+}
+
+func TestNegatedConstraintString(t *testing.T) {
+	constraints, err := pathvars.ParseConstraints("length[3..10], !enum[admin,root]", pathvars.StringType)
+	if err != nil {
+		t.Fatalf("ParseConstraints() error: %v", err)
+	}
+	if got := pathvars.Constraints(constraints).String(); got != "length[3..10],!enum[admin,root]" {
+		t.Errorf("String() = %q, want %q", got, "length[3..10],!enum[admin,root]")
+	}
+	neg, ok := constraints[1].(*pathvars.NegatedConstraint)
+	if !ok {
+		t.Fatalf("constraints[1] is %T, want *NegatedConstraint", constraints[1])
+	}
+	if neg.Type() != pathvars.EnumConstraintType || neg.Rule() != "admin,root" {
+		t.Errorf("Type(), Rule() = %s, %s; want enum, admin,root", neg.Type(), neg.Rule())
+	}
+}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func TestNonFiniteNumbers(t *testing.T) {
+	tests := []struct {
+		name     string
+		template pathvars.Template
+		path     string
+		wantErr  bool
+	}{
+		{name: "real-nan", template: "/m/{v:real}", path: "/m/NaN", wantErr: true},
+		{name: "real-inf", template: "/m/{v:real}", path: "/m/Inf", wantErr: true},
+		{name: "real-plus-inf", template: "/m/{v:real}", path: "/m/+Inf", wantErr: true},
+		{name: "real-minus-inf", template: "/m/{v:real}", path: "/m/-Inf", wantErr: true},
+		{name: "real-infinity", template: "/m/{v:real}", path: "/m/infinity", wantErr: true},
+		{name: "real-finite", template: "/m/{v:real}", path: "/m/1.5", wantErr: false},
+		{name: "real-negative", template: "/m/{v:real}", path: "/m/-2", wantErr: false},
+		{name: "real-scientific", template: "/m/{v:real}", path: "/m/1e3", wantErr: false},
+		{name: "real-negative-exponent", template: "/m/{v:real}", path: "/m/2.5E-4", wantErr: false},
+		{name: "decimal-nan", template: "/m/{v:decimal}", path: "/m/nan", wantErr: true},
+		{name: "decimal-minus-inf", template: "/m/{v:decimal}", path: "/m/-Inf", wantErr: true},
+		{name: "decimal-finite", template: "/m/{v:decimal}", path: "/m/19.99", wantErr: false},
+		{name: "query-real-inf", template: "/m?{v:real}", path: "/m?v=Inf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", tt.template, nil)
+			if err != nil {
+				t.Fatalf("Failed to add route: %v", err)
+			}
+
+			_, err = router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Match() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, pvtypes.ErrNonFiniteNumber) {
+				t.Fatalf("Match() error = %v, want ErrNonFiniteNumber", err)
+			}
+			pe, ok := pathvars.FindErr[*pathvars.ParameterError](err)
+			if !ok || pe.Parameter != "v" {
+				t.Errorf("Match() error = %v, want a *ParameterError for %q", err, "v")
+			}
+			if status := pathvars.StatusForError(err); status != http.StatusBadRequest {
+				t.Errorf("StatusForError() = %d, want %d", status, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestAllowNonFinite(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/m/{v:real}", &pathvars.RouteArgs{
+		Parameters: []pathvars.Parameter{
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps:      pathvars.NameSpecProps{Name: "v"},
+				Location:       pathvars.PathLocation,
+				DataType:       pathvars.RealType,
+				AllowNonFinite: true,
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	data, err := router.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+	restored := pathvars.NewRouter()
+	err = restored.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() error: %v", err)
+	}
+
+	for _, r := range []*pathvars.Router{router, restored} {
+		for _, path := range []string{"/m/NaN", "/m/Inf", "/m/-Inf", "/m/1e3"} {
+			_, err = r.Match(httptest.NewRequest("GET", path, nil))
+			if err != nil {
+				t.Errorf("Match(%q) unexpected error with AllowNonFinite: %v", path, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestOffsetDateTimeFormat(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/events/{at:date:format[offset]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/meetings?{at:date:format[offset:utc]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		want    string
+		wantErr bool
+	}{
+		{name: "path-plus-offset", target: "/events/2023-12-25T10:30:00+02:00", want: "2023-12-25T10:30:00+02:00"},
+		{name: "path-minus-offset", target: "/events/2023-12-25T10:30:00-05:00", want: "2023-12-25T10:30:00-05:00"},
+		{name: "path-z", target: "/events/2023-12-25T10:30:00Z", want: "2023-12-25T10:30:00Z"},
+		{name: "path-naive", target: "/events/2023-12-25T10:30:00", wantErr: true},
+		{name: "query-normalized-plus", target: "/meetings?at=2023-12-25T10:30:00%2B02:00", want: "2023-12-25T08:30:00Z"},
+		{name: "query-normalized-minus", target: "/meetings?at=2023-12-25T10:30:00-05:00", want: "2023-12-25T15:30:00Z"},
+		{name: "query-normalized-z", target: "/meetings?at=2023-12-25T10:30:00Z", want: "2023-12-25T10:30:00Z"},
+		{name: "query-naive", target: "/meetings?at=2023-12-25T10:30:00", wantErr: true},
+		// An unencoded '+' decodes as a space, which is not an offset
+		{name: "query-raw-plus", target: "/meetings?at=2023-12-25T10:30:00+02:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.target, nil))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Match(%s) expected error but got none", tt.target)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Match(%s) unexpected error: %v", tt.target, err)
+			}
+			got, _ := result.GetValue("at")
+			if got != tt.want {
+				t.Errorf("GetValue(at) = %v, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func newDescribedRouter(t *testing.T) *pathvars.Router {
+	t.Helper()
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}?{fields?:string}", &pathvars.RouteArgs{
+		Parameters: []pathvars.Parameter{
+			// Documents a parameter declared in the template
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps:   pathvars.NameSpecProps{Name: "id"},
+				Description: "Numeric user ID",
+			}),
+			// Declares and documents a query parameter not in the template
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps:   pathvars.NameSpecProps{Name: "lang", Optional: true},
+				Location:    pathvars.QueryLocation,
+				DataType:    pathvars.StringType,
+				Description: "Preferred response language",
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	return router
+}
+
+// wantDescriptions lists the descriptions newDescribedRouter() attaches.
+var wantDescriptions = map[pathvars.Identifier]string{
+	"id":     "Numeric user ID",
+	"fields": "",
+	"lang":   "Preferred response language",
+}
+
+func checkDescriptions(t *testing.T, params []pathvars.Parameter) {
+	t.Helper()
+	if len(params) != len(wantDescriptions) {
+		t.Fatalf("got %d parameters, want %d", len(params), len(wantDescriptions))
+	}
+	for _, p := range params {
+		want, ok := wantDescriptions[p.Name]
+		if !ok {
+			t.Errorf("unexpected parameter %s", p.Name)
+			continue
+		}
+		if p.Description() != want {
+			t.Errorf("%s Description() = %q, want %q", p.Name, p.Description(), want)
+		}
+	}
+}
+
+func TestParameterDescriptionThroughMatch(t *testing.T) {
+	router := newDescribedRouter(t)
+
+	result, err := router.Match(httptest.NewRequest("GET", "/users/42?lang=fr", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	checkDescriptions(t, result.Route.Args().Parameters)
+
+	// The description does not change how the parameter is typed or validated
+	_, err = router.Match(httptest.NewRequest("GET", "/users/abc", nil))
+	if err == nil {
+		t.Error("Expected validation error for non-integer id but got none")
+	}
+}
+
+func TestParameterDescriptionThroughWalk(t *testing.T) {
+	router := newDescribedRouter(t)
+
+	router.Walk(func(method pathvars.HTTPMethod, template *pathvars.ParsedTemplate, args pathvars.RouteArgs) bool {
+		checkDescriptions(t, args.Parameters)
+		return true
+	})
+}
+
+func TestParameterDescriptionEncoding(t *testing.T) {
+	data, err := newDescribedRouter(t).MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() unexpected error: %v", err)
+	}
+	router := pathvars.NewRouter()
+	err = router.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() unexpected error: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/users/42", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	checkDescriptions(t, result.Route.Args().Parameters)
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+const employeeMessage = "Use your 8-digit employee number"
+
+func TestParameterErrorMessage(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{Language: "fr"})
+	err := router.AddRoute("GET", "/employees/{id:int:range[10000000..99999999]}?{dept?:string}", &pathvars.RouteArgs{
+		Parameters: []pathvars.Parameter{
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps:    pathvars.NameSpecProps{Name: "id"},
+				ErrorMessage: employeeMessage,
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "type-failure", path: "/employees/abc"},
+		{name: "constraint-failure", path: "/employees/42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if err == nil {
+				t.Fatalf("Match(%q) expected an error", tt.path)
+			}
+			pe, ok := pathvars.FindErr[*pathvars.ParameterError](err)
+			if !ok {
+				t.Fatalf("Match(%q) error has no ParameterError: %v", tt.path, err)
+			}
+			if pe.GetDetail() != employeeMessage {
+				t.Errorf("GetDetail() = %q, want %q", pe.GetDetail(), employeeMessage)
+			}
+			if pe.GetSuggestion() != employeeMessage {
+				t.Errorf("GetSuggestion() = %q, want %q", pe.GetSuggestion(), employeeMessage)
+			}
+			te, ok := pathvars.FindErr[*pathvars.TemplateError](err)
+			if !ok {
+				t.Fatalf("Match(%q) error has no TemplateError: %v", tt.path, err)
+			}
+			if !strings.HasPrefix(te.GetSuggestion(), employeeMessage) {
+				t.Errorf("TemplateError.GetSuggestion() = %q, want it to start with %q", te.GetSuggestion(), employeeMessage)
+			}
+		})
+	}
+}
+
+func TestParameterWithoutErrorMessage(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/employees/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	_, err = router.Match(httptest.NewRequest("GET", "/employees/abc", nil))
+	pe, ok := pathvars.FindErr[*pathvars.ParameterError](err)
+	if !ok {
+		t.Fatalf("Match() error has no ParameterError: %v", err)
+	}
+	if pe.GetDetail() == employeeMessage || pe.GetDetail() == "" {
+		t.Errorf("GetDetail() = %q, want the generated detail", pe.GetDetail())
+	}
+}
@@ -1,7 +1,10 @@
 package test
 
 import (
+	"maps"
 	"reflect"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
@@ -1206,3 +1209,229 @@ func TestParsedTemplate_Validate_TypeConversions(t *testing.T) {
 		})
 	}
 }
+
+func TestParsedTemplate_FirstLiteral(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		wantLiteral string
+		wantOK      bool
+	}{
+		{name: "leading-literal", template: "/api/v1/users/{id:int}", wantLiteral: "api", wantOK: true},
+		{name: "single-literal", template: "/health", wantLiteral: "health", wantOK: true},
+		{name: "literal-with-query", template: "/search?{q:string}", wantLiteral: "search", wantOK: true},
+		{name: "leading-parameter", template: "/{category}/items", wantOK: false},
+		{name: "prefixed-parameter", template: "/v{version:int}/items", wantOK: false},
+		{name: "root", template: "/", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt, err := pathvars.ParseTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("ParseTemplate(%q) unexpected error: %v", tt.template, err)
+			}
+			literal, ok := pt.FirstLiteral()
+			if ok != tt.wantOK {
+				t.Fatalf("FirstLiteral() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if literal != tt.wantLiteral {
+				t.Errorf("FirstLiteral() = %q, want %q", literal, tt.wantLiteral)
+			}
+		})
+	}
+}
+
+func TestParsedTemplateValidationTags(t *testing.T) {
+	pt, err := pathvars.ParseTemplate("/users/{id:int}/posts/{slug:slug:length[3..50]}?{limit?20:int:range[1..100]}&{sort?name:string:enum[name,date]}")
+	if err != nil {
+		t.Fatalf("ParseTemplate() unexpected error: %v", err)
+	}
+
+	tags := pt.ValidationTags()
+	want := map[pathvars.Identifier]string{
+		"id":    "required,numeric",
+		"slug":  "required,min=3,max=50",
+		"limit": "numeric,min=1,max=100",
+		"sort":  "oneof=name date",
+	}
+	for name, wantTag := range want {
+		if got := tags[name]; got != wantTag {
+			t.Errorf("ValidationTags()[%q] = %q, want %q", name, got, wantTag)
+		}
+	}
+	if len(tags) != len(want) {
+		t.Errorf("ValidationTags() returned %d tags, want %d", len(tags), len(want))
+	}
+}
+
+func TestParsedTemplateNormalizePreservesOriginal(t *testing.T) {
+	const template = "/users/{id:int}?{limit?20:int}"
+	pt, err := pathvars.ParseTemplate(template)
+	if err != nil {
+		t.Fatalf("ParseTemplate() unexpected error: %v", err)
+	}
+
+	pt.Normalize()
+
+	if got := pt.Original(); got != template {
+		t.Errorf("Original() = %q, want %q", got, template)
+	}
+	const want = "users/{id:int}?{limit?20:int}"
+	if got := pt.Normalized(); got != want {
+		t.Errorf("Normalized() = %q, want %q", got, want)
+	}
+	if got := pt.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	// Normalizing must not affect matching
+	_, err = pt.Match("/users/42", "")
+	if err != nil {
+		t.Errorf("Match() after Normalize() unexpected error: %v", err)
+	}
+}
+
+func TestParsedTemplateRegexString(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  string
+		wantRegex string
+	}{
+		{name: "single-parameter", template: "/users/{id:int}", wantRegex: `^/users/([^/]+)$`},
+		{name: "literal-only", template: "/health", wantRegex: `^/health$`},
+		{name: "query-excluded", template: "/search?{q:string}", wantRegex: `^/search$`},
+		{name: "multi-segment", template: "/files/{path*}", wantRegex: `^/files/([^/]+(?:/[^/]+)*)$`},
+		{name: "prefixed-parameter", template: "/v{version:int}/items", wantRegex: `^/v([^/]+)/items$`},
+		{name: "suffixed-parameter", template: "/files/{id}.json", wantRegex: `^/files/([^/]+)\.json$`},
+		{name: "escaped-literal", template: "/api/v1.0/{id}", wantRegex: `^/api/v1\.0/([^/]+)$`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt, err := pathvars.ParseTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("ParseTemplate(%q) unexpected error: %v", tt.template, err)
+			}
+			if got := pt.RegexString(); got != tt.wantRegex {
+				t.Errorf("RegexString() = %q, want %q", got, tt.wantRegex)
+			}
+		})
+	}
+}
+
+func TestParsedTemplateCurlExample(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		method   pathvars.HTTPMethod
+		baseURL  string
+		want     string
+	}{
+		{
+			name:     "get-with-path-and-query",
+			template: "/users/{id:int}?{limit:int:range[1..100]}&{sort?:string}",
+			method:   "GET",
+			baseURL:  "http://host",
+			want:     "curl -X GET 'http://host/users/123?limit=50'",
+		},
+		{
+			name:     "trailing-slash-base-url",
+			template: "/health",
+			method:   "get",
+			baseURL:  "https://api.example.com/",
+			want:     "curl -X GET 'https://api.example.com/health'",
+		},
+		{
+			name:     "post-includes-body-placeholder",
+			template: "/users",
+			method:   "POST",
+			baseURL:  "http://host",
+			want:     "curl -X POST 'http://host/users' -d '{BODY}'",
+		},
+		{
+			name:     "method-from-template",
+			template: "DELETE /users/{id:int}",
+			baseURL:  "http://host",
+			want:     "curl -X DELETE 'http://host/users/123'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt, err := pathvars.ParseTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("ParseTemplate(%q) unexpected error: %v", tt.template, err)
+			}
+			if got := pt.CurlExample(tt.method, tt.baseURL); got != tt.want {
+				t.Errorf("CurlExample() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsedTemplateParameterOrder(t *testing.T) {
+	// Repeated because map iteration order varies between parses
+	for range 20 {
+		pt, err := pathvars.ParseTemplate("/files/{src*}/to/{dst*}/{rev:int}?{q}&{limit?10:int}&{page?1:int}")
+		if err != nil {
+			t.Fatalf("ParseTemplate() unexpected error: %v", err)
+		}
+		want := []pathvars.Identifier{"src", "dst", "rev", "q", "limit", "page"}
+		got := pt.Parameters().GetKeys()
+		if !slices.Equal(got, want) {
+			t.Fatalf("Parameters() keys = %v, want %v", got, want)
+		}
+		for i, name := range want {
+			p, _ := pt.Parameters().Get(name)
+			if p.Position() != i {
+				t.Errorf("%s Position() = %d, want %d", name, p.Position(), i)
+			}
+		}
+	}
+}
+
+func TestParameterPositions(t *testing.T) {
+	pt, err := pathvars.ParseTemplate("/users/{uid:int}/posts/{pid:int}/{slug}?{q}&{page?1:int}")
+	if err != nil {
+		t.Fatalf("Failed to parse template: %v", err)
+	}
+
+	want := map[pathvars.Identifier]int{"uid": 0, "pid": 1, "slug": 2, "q": 3, "page": 4}
+	got := pt.ParameterPositions()
+	if !maps.Equal(got, want) {
+		t.Errorf("ParameterPositions() = %v, want %v", got, want)
+	}
+
+	// Path positions index the regex capture groups
+	matches := regexp.MustCompile(pt.RegexString()).FindStringSubmatch("/users/7/posts/42/hello")
+	for name, value := range map[pathvars.Identifier]string{"uid": "7", "pid": "42", "slug": "hello"} {
+		if group := matches[got[name]+1]; group != value {
+			t.Errorf("capture group for %s = %q, want %q", name, group, value)
+		}
+	}
+}
+
+func TestParsedTemplateMatchParsedQuery(t *testing.T) {
+	pt, err := pathvars.ParseTemplate("/items?{a?1:int}")
+	if err != nil {
+		t.Fatalf("ParseTemplate() unexpected error: %v", err)
+	}
+
+	first, err := pt.Match("/items", "a=5&extra=x")
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	// Each attempt keeps its own query, unaffected by later matches
+	_, err = pt.Match("/items", "extra=y")
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if first.ParsedQuery == nil {
+		t.Fatal("MatchAttempt.ParsedQuery = nil, want the request's query")
+	}
+	extra, ok := first.ParsedQuery.Get("extra")
+	if !ok || len(extra) != 1 || extra[0] != "x" {
+		t.Errorf("ParsedQuery.Get(\"extra\") = %v, %t, want [x], true", extra, ok)
+	}
+}
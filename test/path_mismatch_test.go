@@ -0,0 +1,147 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestDescribeMismatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		templates    []pathvars.Template
+		path         string
+		wantErr      error
+		wantTemplate string
+		wantIndex    int // -1 when segment_index is not reported
+	}{
+		{
+			name:         "extra-segment",
+			templates:    []pathvars.Template{"/users/{id:int}"},
+			path:         "/users/123/extra",
+			wantErr:      pathvars.ErrSegmentCountMismatch,
+			wantTemplate: "/users/{id:int}",
+			wantIndex:    -1,
+		},
+		{
+			name:         "missing-segment",
+			templates:    []pathvars.Template{"/users/{id:int}/posts"},
+			path:         "/users/123",
+			wantErr:      pathvars.ErrSegmentCountMismatch,
+			wantTemplate: "/users/{id:int}/posts",
+			wantIndex:    -1,
+		},
+		{
+			name:         "first-literal",
+			templates:    []pathvars.Template{"/users/{id:int}"},
+			path:         "/posts/123",
+			wantErr:      pathvars.ErrSegmentLiteralMismatch,
+			wantTemplate: "/users/{id:int}",
+			wantIndex:    0,
+		},
+		{
+			name:         "later-literal",
+			templates:    []pathvars.Template{"/users/{id:int}/posts/{post:int}"},
+			path:         "/users/123/comments/4",
+			wantErr:      pathvars.ErrSegmentLiteralMismatch,
+			wantTemplate: "/users/{id:int}/posts/{post:int}",
+			wantIndex:    2,
+		},
+		{
+			name:         "parameter-prefix",
+			templates:    []pathvars.Template{"/api/v{version:int}/users"},
+			path:         "/api/x2/users",
+			wantErr:      pathvars.ErrSegmentLiteralMismatch,
+			wantTemplate: "/api/v{version:int}/users",
+			wantIndex:    1,
+		},
+		{
+			name:         "literal-after-multi-segment",
+			templates:    []pathvars.Template{"/files/{path*}/raw"},
+			path:         "/files/a/b/c/blob",
+			wantErr:      pathvars.ErrSegmentLiteralMismatch,
+			wantTemplate: "/files/{path*}/raw",
+			wantIndex:    4,
+		},
+		{
+			name:         "closest-route-wins",
+			templates:    []pathvars.Template{"/orders/{id:int}", "/users/{id:int}/posts/{post:int}", "/users/{id:int}"},
+			path:         "/users/123/posts",
+			wantErr:      pathvars.ErrSegmentCountMismatch,
+			wantTemplate: "/users/{id:int}/posts/{post:int}",
+			wantIndex:    -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter(&pathvars.RouterArgs{DescribeMismatch: true})
+			for _, template := range tt.templates {
+				err := router.AddRoute("GET", template, nil)
+				if err != nil {
+					t.Fatalf("Failed to add route %s: %v", template, err)
+				}
+			}
+
+			_, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if !errors.Is(err, pathvars.ErrNoMatch) || !errors.Is(err, pathvars.ErrPathMismatch) {
+				t.Fatalf("Match() error = %v, want ErrNoMatch wrapping ErrPathMismatch", err)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Match() error = %v, want %v", err, tt.wantErr)
+			}
+			template, _ := pathvars.ErrValue[string](err, "template")
+			if template != tt.wantTemplate {
+				t.Errorf("template = %q, want %q", template, tt.wantTemplate)
+			}
+			index, found := pathvars.ErrValue[int](err, "segment_index")
+			switch {
+			case tt.wantIndex < 0 && found:
+				t.Errorf("segment_index = %d, want none", index)
+			case tt.wantIndex >= 0 && index != tt.wantIndex:
+				t.Errorf("segment_index = %d (found %v), want %d", index, found, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestDescribeMismatchSegmentCounts(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{DescribeMismatch: true})
+	err := router.AddRoute("GET", "/users/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	_, err = router.MatchPath("GET", "/users/123/extra")
+	expected, _ := pathvars.ErrValue[int](err, "expected_segments")
+	actual, _ := pathvars.ErrValue[int](err, "actual_segments")
+	if expected != 2 || actual != 3 {
+		t.Errorf("expected_segments = %d, actual_segments = %d, want 2 and 3", expected, actual)
+	}
+}
+
+func TestDescribeMismatchOff(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	_, err = router.MatchPath("GET", "/users/123/extra")
+	if !errors.Is(err, pathvars.ErrNoMatch) || errors.Is(err, pathvars.ErrPathMismatch) {
+		t.Errorf("MatchPath() error = %v, want a bare ErrNoMatch without DescribeMismatch", err)
+	}
+
+	// A structurally matching path still fails on its values alone
+	router = pathvars.NewRouter(&pathvars.RouterArgs{DescribeMismatch: true})
+	err = router.AddRoute("GET", "/users/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	_, err = router.MatchPath("GET", "/users/abc")
+	if errors.Is(err, pathvars.ErrPathMismatch) {
+		t.Errorf("MatchPath() error = %v, want no ErrPathMismatch for an invalid value", err)
+	}
+}
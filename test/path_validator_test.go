@@ -0,0 +1,114 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+var errNotARealDate = errors.New("not a real date")
+
+// realDate is a PathValidator requiring the y, m and d path values to name a
+// calendar date.
+func realDate(values pvtypes.ValuesMap) error {
+	var parts [3]int
+	for i, name := range []pathvars.Identifier{"y", "m", "d"} {
+		value, _ := values.Get(name)
+		n, err := strconv.Atoi(fmt.Sprintf("%v", value))
+		if err != nil {
+			return err
+		}
+		parts[i] = n
+	}
+	t := time.Date(parts[0], time.Month(parts[1]), parts[2], 0, 0, 0, 0, time.UTC)
+	if t.Year() != parts[0] || int(t.Month()) != parts[1] || t.Day() != parts[2] {
+		return errNotARealDate
+	}
+	return nil
+}
+
+func TestPathValidator(t *testing.T) {
+	var seen []pathvars.Identifier
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/archive/{y:int}/{m:int}/{d:int}?{page?1:int}", &pathvars.RouteArgs{
+		PathValidator: func(values pvtypes.ValuesMap) error {
+			seen = nil
+			for name := range values.Keys() {
+				seen = append(seen, name)
+			}
+			return realDate(values)
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "real-date", path: "/archive/2024/3/15", wantErr: false},
+		{name: "leap-day", path: "/archive/2024/2/29?page=2", wantErr: false},
+		{name: "february-30", path: "/archive/2024/2/30", wantErr: true},
+		{name: "non-leap-day", path: "/archive/2023/2/29", wantErr: true},
+		{name: "month-13", path: "/archive/2024/13/1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Match() unexpected error: %v", err)
+				}
+				if len(seen) != 3 {
+					t.Errorf("PathValidator saw %v, want only the path values y, m and d", seen)
+				}
+				return
+			}
+			if !errors.Is(err, pathvars.ErrPathValidationFailed) || !errors.Is(err, errNotARealDate) {
+				t.Fatalf("Match() error = %v, want ErrPathValidationFailed wrapping errNotARealDate", err)
+			}
+			if status := pathvars.StatusForError(err); status != http.StatusBadRequest {
+				t.Errorf("StatusForError() = %d, want %d", status, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestPathValidatorRunsAfterSegmentValidation(t *testing.T) {
+	var calls int
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/archive/{y:int}/{m:int:range[1..12]}/{d:int}", &pathvars.RouteArgs{
+		PathValidator: func(values pvtypes.ValuesMap) error {
+			calls++
+			return realDate(values)
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/archive/2024/13/1", nil))
+	if err == nil || errors.Is(err, pathvars.ErrPathValidationFailed) {
+		t.Errorf("Match() error = %v, want the range constraint's error", err)
+	}
+	if calls != 0 {
+		t.Errorf("PathValidator called %d times for an invalid segment, want 0", calls)
+	}
+
+	_, err = router.MarshalBinary()
+	if !errors.Is(err, pathvars.ErrRouteNotEncodable) {
+		t.Errorf("MarshalBinary() error = %v, want ErrRouteNotEncodable", err)
+	}
+}
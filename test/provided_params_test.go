@@ -0,0 +1,89 @@
+package test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatchResultProvided(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("PATCH", "/users/{id:int}?{name?:string}&{limit?20:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("PATCH", "/users/42?name=alice", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+
+	if got, _ := result.GetValue("limit"); got != "20" {
+		t.Errorf("GetValue(\"limit\") = %v, want default %q", got, "20")
+	}
+	if result.WasProvided("limit") {
+		t.Error("WasProvided(\"limit\") = true, want false for a defaulted parameter")
+	}
+	if !result.WasProvided("name") {
+		t.Error("WasProvided(\"name\") = false, want true for a supplied parameter")
+	}
+	if !result.WasProvided("id") {
+		t.Error("WasProvided(\"id\") = false, want true for a path parameter")
+	}
+
+	want := []pathvars.Identifier{"id", "name"}
+	if got := result.Provided(); !slices.Equal(got, want) {
+		t.Errorf("Provided() = %v, want %v", got, want)
+	}
+}
+
+// TestMatchResultProvidedConcurrent matches requests providing different
+// parameters in parallel, so run it with -race to catch per-request state
+// kept on the shared template.
+func TestMatchResultProvidedConcurrent(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/items?{a?1:int}&{b?2:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	queries := []struct {
+		query string
+		want  []pathvars.Identifier
+	}{
+		{query: "a=5", want: []pathvars.Identifier{"a"}},
+		{query: "b=5", want: []pathvars.Identifier{"b"}},
+		{query: "a=5&b=6", want: []pathvars.Identifier{"a", "b"}},
+		{query: "", want: nil},
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				q := queries[(g+i)%len(queries)]
+				result, err := router.Match(httptest.NewRequest("GET", "/items?"+q.query, nil))
+				if err != nil {
+					errs <- fmt.Errorf("Match(%q) unexpected error: %w", q.query, err)
+					return
+				}
+				if got := result.Provided(); !slices.Equal(got, q.want) {
+					errs <- fmt.Errorf("Match(%q).Provided() = %v, want %v", q.query, got, q.want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
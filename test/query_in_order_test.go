@@ -0,0 +1,62 @@
+package test
+
+import (
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatchResultQueryInOrder(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/search?{a:int}&{b:int}&{c?:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []pathvars.QueryPair
+	}{
+		{
+			name:  "request-order",
+			query: "b=2&a=1&c=3",
+			want:  []pathvars.QueryPair{{Name: "b", Value: "2"}, {Name: "a", Value: "1"}, {Name: "c", Value: "3"}},
+		},
+		{
+			name:  "undeclared-and-repeated",
+			query: "a=1&tag=x&b=2&tag=y%20z&flag",
+			want: []pathvars.QueryPair{
+				{Name: "a", Value: "1"},
+				{Name: "tag", Value: "x"},
+				{Name: "b", Value: "2"},
+				{Name: "tag", Value: "y z"},
+				{Name: "flag", Value: ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", "/search?"+tt.query, nil))
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			if got := result.QueryInOrder(); !slices.Equal(got, tt.want) {
+				t.Errorf("QueryInOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/search?a=1&b=2", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	for _, pair := range result.QueryInOrder() {
+		if pair.Name == "c" {
+			t.Error("QueryInOrder() includes defaulted parameter c, want only request parameters")
+		}
+	}
+}
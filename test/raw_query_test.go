@@ -0,0 +1,44 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatchResultRawQuery(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/proxy/{service:slug}?{limit?10:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	t.Run("preserves-order-and-duplicates", func(t *testing.T) {
+		const rawQuery = "z=1&limit=5&tag=b&tag=a&q=hello%20world&flag"
+		result, err := router.Match(httptest.NewRequest("GET", "/proxy/search?"+rawQuery, nil))
+		if err != nil {
+			t.Fatalf("Match() unexpected error: %v", err)
+		}
+		if got := result.RawQuery(); got != rawQuery {
+			t.Errorf("RawQuery() = %q, want %q", got, rawQuery)
+		}
+		tags := result.Query()["tag"]
+		if len(tags) != 2 || tags[0] != "b" || tags[1] != "a" {
+			t.Errorf("Query()[\"tag\"] = %v, want [b a]", tags)
+		}
+		if got, _ := result.GetValue("limit"); got != "5" {
+			t.Errorf("GetValue(\"limit\") = %v, want %q", got, "5")
+		}
+	})
+
+	t.Run("empty-query", func(t *testing.T) {
+		result, err := router.Match(httptest.NewRequest("GET", "/proxy/search", nil))
+		if err != nil {
+			t.Fatalf("Match() unexpected error: %v", err)
+		}
+		if got := result.RawQuery(); got != "" {
+			t.Errorf("RawQuery() = %q, want empty", got)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRouteRequireBodyAndContentTypes(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("POST", "/users", &pathvars.RouteArgs{
+		RequireBody:  true,
+		ContentTypes: []string{"application/json"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("PUT", "/users/{id:int}", &pathvars.RouteArgs{
+		ContentTypes: []string{"application/json"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		body        io.Reader
+		contentType string
+		wantErr     error
+	}{
+		{name: "json-body-accepted", method: "POST", target: "/users", body: strings.NewReader(`{"name":"Ann"}`), contentType: "application/json"},
+		{name: "json-with-charset-accepted", method: "POST", target: "/users", body: strings.NewReader(`{}`), contentType: "Application/JSON; charset=utf-8"},
+		{name: "wrong-content-type", method: "POST", target: "/users", body: strings.NewReader("name=Ann"), contentType: "application/x-www-form-urlencoded", wantErr: pathvars.ErrUnsupportedContentType},
+		{name: "missing-content-type", method: "POST", target: "/users", body: strings.NewReader(`{}`), wantErr: pathvars.ErrInvalidContentType},
+		{name: "missing-body", method: "POST", target: "/users", contentType: "application/json", wantErr: pathvars.ErrRequestBodyRequired},
+		{name: "optional-body-absent", method: "PUT", target: "/users/1"},
+		{name: "optional-body-wrong-type", method: "PUT", target: "/users/1", body: strings.NewReader("x"), contentType: "text/plain", wantErr: pathvars.ErrUnsupportedContentType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, tt.body)
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			_, err := router.Match(req)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Expected match but got error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Match() error = %v, want %v", err, tt.wantErr)
+			}
+			if errors.Is(err, pathvars.ErrNoRouteMatched) {
+				t.Error("Body validation failure should not be reported as no route matched")
+			}
+		})
+	}
+}
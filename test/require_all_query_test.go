@@ -0,0 +1,83 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRequireAllQuery(t *testing.T) {
+	const template = "/rpc/search?{q:string}&{limit?10:int}&{offset?0:int}"
+
+	tests := []struct {
+		name    string
+		path    string
+		strict  bool
+		wantErr bool
+		missing []string
+	}{
+		{name: "strict-all-present", path: "/rpc/search?q=go&limit=5&offset=0", strict: true},
+		{name: "strict-missing-optional", path: "/rpc/search?q=go&limit=5", strict: true, wantErr: true, missing: []string{"offset"}},
+		{name: "strict-missing-two", path: "/rpc/search?q=go", strict: true, wantErr: true, missing: []string{"limit", "offset"}},
+		{name: "strict-missing-required", path: "/rpc/search?limit=5&offset=0", strict: true, wantErr: true},
+		{name: "default-all-present", path: "/rpc/search?q=go&limit=5&offset=0"},
+		{name: "default-missing-optional", path: "/rpc/search?q=go&limit=5"},
+		{name: "default-missing-two", path: "/rpc/search?q=go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", template, &pathvars.RouteArgs{RequireAllQuery: tt.strict})
+			if err != nil {
+				t.Fatalf("Failed to add route: %v", err)
+			}
+			_, err = router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Match(%s) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+			if len(tt.missing) == 0 {
+				return
+			}
+			if !errors.Is(err, pathvars.ErrRequiredParameterNotProvided) {
+				t.Errorf("Match(%s) error does not wrap ErrRequiredParameterNotProvided: %v", tt.path, err)
+			}
+			tes := pathvars.TemplateErrors(err)
+			if len(tes) != len(tt.missing) {
+				t.Fatalf("Expected %d template errors, got %d: %v", len(tt.missing), len(tes), err)
+			}
+			// Query parameters are not reported in template order, so compare as a set
+			for i, te := range tes {
+				var pe *pathvars.ParameterError
+				if !errors.As(te, &pe) {
+					t.Fatalf("template error %d is not a *ParameterError: %v", i, te)
+				}
+				if !slices.Contains(tt.missing, pe.Parameter) {
+					t.Errorf("ParameterError.Parameter = %s, want one of %v", pe.Parameter, tt.missing)
+				}
+				if !strings.Contains(te.GetSuggestion(), pe.Parameter+"=") {
+					t.Errorf("GetSuggestion() = %q, want an example with %s", te.GetSuggestion(), pe.Parameter)
+				}
+			}
+		})
+	}
+}
+
+func TestRequireAllQueryDefaultsUnused(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/rpc/search?{q:string}&{limit?10:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	result, err := router.Match(httptest.NewRequest("GET", "/rpc/search?q=go", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if got := result.ToMap()["limit"]; got != "10" {
+		t.Errorf("limit = %q, want default 10 without RequireAllQuery", got)
+	}
+}
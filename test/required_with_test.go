@@ -0,0 +1,65 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRequiredWith(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/posts?{sort_by?}&{sort_dir?asc}", &pathvars.RouteArgs{
+		RequiredWith: map[pathvars.Identifier][]pathvars.Identifier{"sort_dir": {"sort_by"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "dependent-alone", path: "/posts?sort_dir=desc", wantErr: true},
+		{name: "both-present", path: "/posts?sort_by=date&sort_dir=desc", wantErr: false},
+		{name: "neither-present", path: "/posts", wantErr: false},
+		{name: "dependency-alone", path: "/posts?sort_by=date", wantErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			_, err := router.Match(req)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("Match() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, pathvars.ErrRequiredWithViolated) {
+				t.Fatalf("Match() error = %v, want ErrRequiredWithViolated", err)
+			}
+			status := pathvars.StatusForError(err)
+			if status != http.StatusBadRequest {
+				t.Errorf("StatusForError() = %d, want %d", status, http.StatusBadRequest)
+			}
+			pd := pathvars.NewProblemDetails(req, status, err)
+			if pd.Detail != "Parameter 'sort_dir' requires sort_by" {
+				t.Errorf("ProblemDetails.Detail = %q, want it to name both parameters", pd.Detail)
+			}
+		})
+	}
+}
+
+func TestRequiredWithUndeclaredParameter(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/posts?{sort_by?}&{sort_dir?}", &pathvars.RouteArgs{
+		RequiredWith: map[pathvars.Identifier][]pathvars.Identifier{"sort_dir": {"sortby"}},
+	})
+	if !errors.Is(err, pathvars.ErrInvalidRequiredWith) || !errors.Is(err, pathvars.ErrRequiredWithParameterNotFound) {
+		t.Errorf("AddRoute() error = %v, want ErrInvalidRequiredWith wrapping ErrRequiredWithParameterNotFound", err)
+	}
+}
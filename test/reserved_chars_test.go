@@ -0,0 +1,80 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestStrictReservedChars(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  pathvars.Template
+		path      string
+		wantParam string
+		wantIndex int
+		wantValue string
+	}{
+		{name: "raw-hash", template: "/files/{name}", path: "/files/a#b", wantParam: "name", wantIndex: 1, wantValue: "a#b"},
+		{name: "raw-bracket", template: "/items/{id}/tags", path: "/items/x[1]/tags", wantParam: "id", wantIndex: 1, wantValue: "x[1]"},
+		{name: "after-multi-segment", template: "/docs/{path*}/v/{rev}", path: "/docs/a/b/v/r#2", wantParam: "rev", wantIndex: 4, wantValue: "r#2"},
+		{name: "within-multi-segment", template: "/docs/{path*}/v/{rev}", path: "/docs/a/b#c/v/2", wantParam: "path", wantIndex: 2, wantValue: "a/b#c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strict := pathvars.NewRouter(&pathvars.RouterArgs{StrictReservedChars: true})
+			lenient := pathvars.NewRouter()
+			for _, router := range []*pathvars.Router{strict, lenient} {
+				err := router.AddRoute("GET", tt.template, nil)
+				if err != nil {
+					t.Fatalf("Failed to add route: %v", err)
+				}
+			}
+
+			_, err := strict.Match(httptest.NewRequest("GET", tt.path, nil))
+			if !errors.Is(err, pathvars.ErrUnencodedReservedChar) {
+				t.Fatalf("strict Match() error = %v, want ErrUnencodedReservedChar", err)
+			}
+			pe, ok := pathvars.FindErr[*pathvars.ParameterError](err)
+			if !ok {
+				t.Fatalf("strict Match() error = %v, want a *ParameterError", err)
+			}
+			if pe.Parameter != tt.wantParam || pe.SegmentIndex != tt.wantIndex {
+				t.Errorf("ParameterError = %q at %d, want %q at %d", pe.Parameter, pe.SegmentIndex, tt.wantParam, tt.wantIndex)
+			}
+			if status := pathvars.StatusForError(err); status != http.StatusBadRequest {
+				t.Errorf("StatusForError() = %d, want %d", status, http.StatusBadRequest)
+			}
+
+			// Lenient mode matches the character literally
+			result, err := lenient.Match(httptest.NewRequest("GET", tt.path, nil))
+			if err != nil {
+				t.Fatalf("lenient Match() unexpected error: %v", err)
+			}
+			value, _ := result.GetValue(pathvars.Identifier(tt.wantParam))
+			if value != tt.wantValue {
+				t.Errorf("lenient %s = %q, want %q", tt.wantParam, value, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestStrictReservedCharsEncoded(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{StrictReservedChars: true})
+	err := router.AddRoute("GET", "/files/{name}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/files/a%23b%5B1%5D", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error for encoded characters: %v", err)
+	}
+	if value, _ := result.GetValue("name"); value != "a#b[1]" {
+		t.Errorf("name = %q, want %q", value, "a#b[1]")
+	}
+}
@@ -0,0 +1,114 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+const validRouteConfig = `[
+  {"method": "GET", "template": "/users/{id:int}", "description": "Get user"},
+  {
+    "method": "POST",
+    "template": "/users",
+    "require_body": true,
+    "content_types": ["application/json"]
+  }
+]`
+
+func TestLoadRoutesJSON(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.LoadRoutesJSON(strings.NewReader(validRouteConfig))
+	if err != nil {
+		t.Fatalf("LoadRoutesJSON() unexpected error: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/users/42", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if result.Route.Description != "Get user" {
+		t.Errorf("Match() route = %q, want %q", result.Route.Description, "Get user")
+	}
+
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name":"Ada"}`))
+	req.Header.Set("Content-Type", "application/json")
+	_, err = router.Match(req)
+	if err != nil {
+		t.Errorf("Match() unexpected error: %v", err)
+	}
+}
+
+func TestLoadRoutesJSONReportsLine(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       string
+		wantLine     int
+		wantTemplate string
+	}{
+		{
+			name: "bad-template-on-own-line",
+			config: `[
+  {"method": "GET", "template": "/users/{id:int}"},
+  {
+    "method": "GET",
+    "description": "Broken",
+    "template": "/posts/{slug:nosuchtype}"
+  },
+  {"method": "GET", "template": "/tags"}
+]`,
+			wantLine:     6,
+			wantTemplate: "/posts/{slug:nosuchtype}",
+		},
+		{
+			name: "bad-template-inline",
+			config: `[
+  {"method": "GET", "template": "/users"},
+  {"method": "GET", "template": "/users/{id:int"}
+]`,
+			wantLine:     3,
+			wantTemplate: "/users/{id:int",
+		},
+		{
+			name: "json-syntax-error",
+			config: `[
+  {"method": "GET", "template": "/users"},
+  {"method": "GET" "template": "/tags"}
+]`,
+			wantLine: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.LoadRoutesJSON(strings.NewReader(tt.config))
+			ce, ok := pathvars.FindErr[*pathvars.ConfigError](err)
+			if !ok {
+				t.Fatalf("LoadRoutesJSON() error = %v, want *ConfigError", err)
+			}
+			if ce.Line != tt.wantLine {
+				t.Errorf("ConfigError.Line = %d, want %d", ce.Line, tt.wantLine)
+			}
+			if ce.Template != tt.wantTemplate {
+				t.Errorf("ConfigError.Template = %q, want %q", ce.Template, tt.wantTemplate)
+			}
+
+			// No routes are added when loading fails
+			_, err = router.Match(httptest.NewRequest("GET", "/users", nil))
+			if !errors.Is(err, pathvars.ErrNoRouteMatched) {
+				t.Errorf("Match() error = %v, want ErrNoRouteMatched after failed load", err)
+			}
+		})
+	}
+}
+
+func TestLoadRoutesJSONNotArray(t *testing.T) {
+	err := pathvars.NewRouter().LoadRoutesJSON(strings.NewReader(`{"method": "GET"}`))
+	if !errors.Is(err, pathvars.ErrRouteConfigNotArray) {
+		t.Errorf("LoadRoutesJSON() error = %v, want ErrRouteConfigNotArray", err)
+	}
+}
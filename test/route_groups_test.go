@@ -0,0 +1,50 @@
+package test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRoutesByFirstSegment(t *testing.T) {
+	router := pathvars.NewRouter()
+	for _, route := range []struct {
+		method   pathvars.HTTPMethod
+		template pathvars.Template
+	}{
+		{"GET", "/users"},
+		{"GET", "/posts/{slug}"},
+		{"GET", "/users/{id:int}"},
+		{"GET", "/{category}/items"},
+		{"POST", "/users"},
+	} {
+		err := router.AddRoute(route.method, route.template, nil)
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", route.template, err)
+		}
+	}
+
+	groups := router.RoutesByFirstSegment()
+
+	want := map[string][]string{
+		"users": {"GET /users", "GET /users/{id:int}", "POST /users"},
+		"posts": {"GET /posts/{slug}"},
+		"":      {"GET /{category}/items"},
+	}
+	if len(groups) != len(want) {
+		t.Errorf("RoutesByFirstSegment() has %d groups, want %d", len(groups), len(want))
+	}
+	for literal, endpoints := range want {
+		var got []string
+		for _, info := range groups[literal] {
+			got = append(got, info.Route.Endpoint())
+			if info.ShadowedBy != nil {
+				t.Errorf("%s ShadowedBy = %s, want nil", info.Route.Endpoint(), info.ShadowedBy.Endpoint())
+			}
+		}
+		if !slices.Equal(got, endpoints) {
+			t.Errorf("RoutesByFirstSegment()[%q] = %v, want %v", literal, got, endpoints)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRoutePriority(t *testing.T) {
+	router := pathvars.NewRouter()
+	for _, route := range []struct {
+		template    string
+		description string
+		priority    int
+	}{
+		{"/users/{name}", "default", 0},
+		{"/users/{id}", "override", 10},
+		{"/users/{key}", "fallback", -1},
+		{"/users/{user}", "also-override", 10},
+	} {
+		err := router.AddRoute("GET", pathvars.Template(route.template), &pathvars.RouteArgs{
+			Description: route.description,
+			Priority:    route.priority,
+		})
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", route.template, err)
+		}
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/users/42", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if result.Route.Description != "override" {
+		t.Errorf("Match() route = %q, want %q", result.Route.Description, "override")
+	}
+	if result.Index != 1 {
+		t.Errorf("Match() Index = %d, want registration index 1", result.Index)
+	}
+
+	var order []string
+	router.Walk(func(_ pathvars.HTTPMethod, _ *pathvars.ParsedTemplate, args pathvars.RouteArgs) bool {
+		order = append(order, args.Description)
+		return true
+	})
+	want := []string{"override", "also-override", "default", "fallback"}
+	if len(order) != len(want) {
+		t.Fatalf("Walk() visited %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("Walk() visited %v, want %v", order, want)
+			break
+		}
+	}
+}
+
+func TestRoutePriorityOverridesLiteral(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}", &pathvars.RouteArgs{Description: "by-id"})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/users/me", &pathvars.RouteArgs{Description: "me", Priority: 1})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/users/me", "me"},
+		{"/users/42", "by-id"},
+	}
+	for _, tt := range tests {
+		result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+		if err != nil {
+			t.Fatalf("Match(%s) unexpected error: %v", tt.path, err)
+		}
+		if result.Route.Description != tt.want {
+			t.Errorf("Match(%s) route = %q, want %q", tt.path, result.Route.Description, tt.want)
+		}
+	}
+	if len(router.UnreachableRoutes()) != 0 {
+		t.Errorf("UnreachableRoutes() = %v, want none once /users/me has priority", router.UnreachableRoutes())
+	}
+}
+
+func TestRouteSpecificityBreaksPriorityTies(t *testing.T) {
+	type route struct {
+		template    pathvars.Template
+		description string
+		priority    int
+	}
+	tests := []struct {
+		name   string
+		routes []route
+		path   string
+		want   string
+	}{
+		{
+			name:   "literal-added-after-parameter",
+			routes: []route{{"/users/{id}", "by-id", 0}, {"/users/me", "me", 0}},
+			path:   "/users/me",
+			want:   "me",
+		},
+		{
+			name:   "parameter-still-matches-others",
+			routes: []route{{"/users/{id}", "by-id", 0}, {"/users/me", "me", 0}},
+			path:   "/users/42",
+			want:   "by-id",
+		},
+		{
+			name:   "affixed-parameter-beats-plain",
+			routes: []route{{"/files/{name}", "plain", 0}, {"/files/{name}.json", "json", 0}},
+			path:   "/files/report.json",
+			want:   "json",
+		},
+		{
+			name:   "segments-beat-catch-all",
+			routes: []route{{"/files/{path*}", "catch-all", 0}, {"/files/{dir}/{name}", "two-segments", 0}},
+			path:   "/files/docs/readme",
+			want:   "two-segments",
+		},
+		{
+			name:   "earlier-literal-differs-first",
+			routes: []route{{"/{tenant}/users/me", "tenant-me", 0}, {"/api/users/{id}", "api-id", 0}},
+			path:   "/api/users/me",
+			want:   "api-id",
+		},
+		{
+			name:   "equal-specificity-keeps-registration-order",
+			routes: []route{{"/users/{id}", "first", 0}, {"/users/{name}", "second", 0}},
+			path:   "/users/42",
+			want:   "first",
+		},
+		{
+			name:   "priority-overrides-specificity",
+			routes: []route{{"/users/me", "me", 0}, {"/users/{id}", "by-id", 1}},
+			path:   "/users/me",
+			want:   "by-id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			for _, r := range tt.routes {
+				err := router.AddRoute("GET", r.template, &pathvars.RouteArgs{
+					Description: r.description,
+					Priority:    r.priority,
+				})
+				if err != nil {
+					t.Fatalf("Failed to add route %s: %v", r.template, err)
+				}
+			}
+			result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if err != nil {
+				t.Fatalf("Match(%s) unexpected error: %v", tt.path, err)
+			}
+			if result.Route.Description != tt.want {
+				t.Errorf("Match(%s) route = %q, want %q", tt.path, result.Route.Description, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouteSpecificityKeepsMoreSpecificRouteAhead(t *testing.T) {
+	router := pathvars.NewRouter()
+	for _, route := range []struct {
+		template    pathvars.Template
+		description string
+	}{
+		{"/a/{x}", "one-segment"},
+		{"/a/c/d", "literal"},
+		{"/a/{x}/{rest*}", "catch-all"},
+	} {
+		err := router.AddRoute("GET", route.template, &pathvars.RouteArgs{Description: route.description})
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", route.template, err)
+		}
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/a/c/d", "literal"},
+		{"/a/b", "one-segment"},
+		{"/a/b/c/d", "catch-all"},
+	}
+	for _, tt := range tests {
+		result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+		if err != nil {
+			t.Fatalf("Match(%s) unexpected error: %v", tt.path, err)
+		}
+		if result.Route.Description != tt.want {
+			t.Errorf("Match(%s) route = %q, want %q", tt.path, result.Route.Description, tt.want)
+		}
+	}
+	if unreachable := router.UnreachableRoutes(); len(unreachable) != 0 {
+		t.Errorf("UnreachableRoutes() = %v, want none", unreachable)
+	}
+}
+
+func TestRoutePrioritySurvivesEncoding(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id}", &pathvars.RouteArgs{Description: "default"})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = router.AddRoute("GET", "/users/{id}", &pathvars.RouteArgs{Description: "override", Priority: 5})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	data, err := router.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() unexpected error: %v", err)
+	}
+	decoded := pathvars.NewRouter()
+	err = decoded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() unexpected error: %v", err)
+	}
+
+	result, err := decoded.Match(httptest.NewRequest("GET", "/users/42", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if result.Route.Description != "override" || result.Route.Priority != 5 {
+		t.Errorf("Match() route = %q with priority %d, want %q with 5",
+			result.Route.Description, result.Route.Priority, "override")
+	}
+}
+
+func TestRoutePriorityMountRollback(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/status", &pathvars.RouteArgs{Description: "parent"})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	sub := pathvars.NewRouter()
+	err = sub.AddRoute("GET", "/info", &pathvars.RouteArgs{Priority: 5})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	err = sub.AddRoute("GET", "/users/{id}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	// The prefix's {id} collides with the second route's, after the
+	// high-priority first route was already inserted ahead of "/status"
+	err = router.Mount("/orgs/{id}", sub)
+	if err == nil {
+		t.Fatal("Mount() expected error for duplicate parameter name")
+	}
+	var descriptions []string
+	router.Walk(func(_ pathvars.HTTPMethod, _ *pathvars.ParsedTemplate, args pathvars.RouteArgs) bool {
+		descriptions = append(descriptions, args.Description)
+		return true
+	})
+	if len(descriptions) != 1 || descriptions[0] != "parent" {
+		t.Errorf("routes after failed Mount() = %v, want [parent]", descriptions)
+	}
+}
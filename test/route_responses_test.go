@@ -0,0 +1,80 @@
+package test
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+type userResponse struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// wantResponses is the example set attached to the route in each test.
+var wantResponses = map[int]any{
+	200: userResponse{ID: 42, Name: "Ada"},
+	404: map[string]any{"title": "Not Found", "status": 404},
+}
+
+func TestRouteResponsesSurviveMatching(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}", &pathvars.RouteArgs{
+		Responses: wantResponses,
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/users/42", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Route.Responses, wantResponses) {
+		t.Errorf("Route.Responses = %v, want %v", result.Route.Responses, wantResponses)
+	}
+	if !reflect.DeepEqual(result.Route.Args().Responses, wantResponses) {
+		t.Errorf("Route.Args().Responses = %v, want %v", result.Route.Args().Responses, wantResponses)
+	}
+}
+
+func TestRouteResponsesSurviveMount(t *testing.T) {
+	sub := pathvars.NewRouter()
+	err := sub.AddRoute("GET", "/users/{id:int}", &pathvars.RouteArgs{
+		Responses: wantResponses,
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	router := pathvars.NewRouter()
+	err = router.Mount("/admin", sub)
+	if err != nil {
+		t.Fatalf("Mount() unexpected error: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/admin/users/42", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Route.Responses, wantResponses) {
+		t.Errorf("Route.Responses = %v, want %v", result.Route.Responses, wantResponses)
+	}
+}
+
+func TestRouteWithoutResponses(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/health", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/health", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if result.Route.Responses != nil {
+		t.Errorf("Route.Responses = %v, want nil", result.Route.Responses)
+	}
+}
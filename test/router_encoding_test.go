@@ -0,0 +1,116 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestRouterMarshalBinary(t *testing.T) {
+	limitConstraints, err := pathvars.ParseConstraints("range[1..50]", pathvars.IntegerType)
+	if err != nil {
+		t.Fatalf("ParseConstraints() unexpected error: %v", err)
+	}
+	tag, err := pathvars.ParseParameter("{tag?:string:length[2..5]}", pathvars.QueryLocation)
+	if err != nil {
+		t.Fatalf("ParseParameter() unexpected error: %v", err)
+	}
+
+	original := pathvars.NewRouter()
+	routes := []struct {
+		method pathvars.HTTPMethod
+		path   pathvars.Template
+		args   *pathvars.RouteArgs
+	}{
+		{"GET", "/users/{id:int:range[1..1000]}", nil},
+		{"GET", "/files/{path*}", nil},
+		{"GET", "/posts/{slug:slug}?{page?1:int}", &pathvars.RouteArgs{
+			Description: "List posts",
+			Parameters: []pathvars.Parameter{
+				tag,
+				pathvars.NewParameter(pathvars.ParameterArgs{
+					NameProps:   pathvars.NameSpecProps{Name: "limit", Optional: true},
+					Location:    pathvars.QueryLocation,
+					DataType:    pathvars.IntegerType,
+					Constraints: limitConstraints,
+				}),
+			},
+		}},
+		{"POST", "/codes/{code}", &pathvars.RouteArgs{
+			RequireBody: true,
+			Parameters: []pathvars.Parameter{
+				pathvars.NewParameter(pathvars.ParameterArgs{
+					NameProps: pathvars.NameSpecProps{Name: "code"},
+					Location:  pathvars.PathLocation,
+					DataType:  pathvars.StringType,
+					Regex:     regexp.MustCompile(`[A-Z]{2}[0-9]{2}`),
+				}),
+			},
+		}},
+		{"", "/health", nil},
+	}
+	for _, r := range routes {
+		err = original.AddRoute(r.method, r.path, r.args)
+		if err != nil {
+			t.Fatalf("Failed to add route %s %s: %v", r.method, r.path, err)
+		}
+	}
+
+	data, err := original.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() unexpected error: %v", err)
+	}
+	restored := pathvars.NewRouter()
+	err = restored.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() unexpected error: %v", err)
+	}
+
+	requests := []struct {
+		method string
+		target string
+	}{
+		{"GET", "/users/42"},
+		{"GET", "/users/0"},
+		{"GET", "/users/abc"},
+		{"GET", "/files/a/b/c.txt"},
+		{"GET", "/posts/hello-world?page=2&tag=go&limit=10"},
+		{"GET", "/posts/hello-world?tag=toolong"},
+		{"GET", "/posts/hello-world?limit=99"},
+		{"POST", "/codes/AB12"},
+		{"POST", "/codes/ab12"},
+		{"PUT", "/health"},
+		{"GET", "/missing"},
+	}
+	for _, req := range requests {
+		t.Run(req.method+" "+req.target, func(t *testing.T) {
+			want, wantErr := original.Match(httptest.NewRequest(req.method, req.target, nil))
+			got, gotErr := restored.Match(httptest.NewRequest(req.method, req.target, nil))
+			if (gotErr == nil) != (wantErr == nil) {
+				t.Fatalf("restored Match() error = %v, original error = %v", gotErr, wantErr)
+			}
+			if gotErr != nil {
+				return
+			}
+			if got.Index != want.Index {
+				t.Errorf("restored Match() Index = %d, want %d", got.Index, want.Index)
+			}
+			if got.Route.Description != want.Route.Description {
+				t.Errorf("restored Route.Description = %q, want %q", got.Route.Description, want.Route.Description)
+			}
+			if got.ValuesMap().String() != want.ValuesMap().String() {
+				t.Errorf("restored values = %s, want %s", got.ValuesMap(), want.ValuesMap())
+			}
+		})
+	}
+
+	t.Run("invalid-data", func(t *testing.T) {
+		err := pathvars.NewRouter().UnmarshalBinary([]byte("not a router"))
+		if !errors.Is(err, pathvars.ErrInvalidRouterEncoding) {
+			t.Errorf("UnmarshalBinary() error = %v, want ErrInvalidRouterEncoding", err)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+type userAPI struct{}
+
+func (a *userAPI) HandlerRoutes() []pathvars.HandlerRoute {
+	return []pathvars.HandlerRoute{
+		{Method: "GET", Template: "/users", HandlerFunc: a.ListUsers},
+		{Method: "GET", Template: "/users/{id:int}", HandlerFunc: a.GetUser},
+		{Method: "DELETE", Template: "/users/{id:int}", MethodName: "DeleteUser"},
+		{Method: "POST", Template: "/users", MethodName: "CreateUser", Args: &pathvars.RouteArgs{
+			RequireBody: true,
+		}},
+	}
+}
+
+func (a *userAPI) ListUsers(w http.ResponseWriter, req *http.Request) {
+	_, _ = w.Write([]byte("ListUsers"))
+}
+
+func (a *userAPI) GetUser(w http.ResponseWriter, req *http.Request) {
+	result, _ := pathvars.MatchResultFromContext(req.Context())
+	id, _ := result.GetValue("id")
+	_, _ = fmt.Fprintf(w, "GetUser %v", id)
+}
+
+func (a *userAPI) DeleteUser(w http.ResponseWriter, req *http.Request) {
+	_, _ = fmt.Fprintf(w, "DeleteUser %s", req.PathValue("id"))
+}
+
+func (a *userAPI) CreateUser(w http.ResponseWriter, req *http.Request) {
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte("CreateUser"))
+}
+
+func (a *userAPI) NotAHandler(id int) {}
+
+func TestRouterFromStruct(t *testing.T) {
+	router, err := pathvars.RouterFromStruct(&userAPI{})
+	if err != nil {
+		t.Fatalf("RouterFromStruct() error: %v", err)
+	}
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		status int
+		want   string
+	}{
+		{method: "GET", path: "/users", status: http.StatusOK, want: "ListUsers"},
+		{method: "GET", path: "/users/42", status: http.StatusOK, want: "GetUser 42"},
+		{method: "DELETE", path: "/users/7", status: http.StatusOK, want: "DeleteUser 7"},
+		{method: "POST", path: "/users", body: "{}", status: http.StatusCreated, want: "CreateUser"},
+		{method: "POST", path: "/users", status: http.StatusBadRequest},
+		{method: "GET", path: "/users/abc", status: http.StatusBadRequest},
+		{method: "GET", path: "/nowhere", status: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d (body %q)", rec.Code, tt.status, rec.Body.String())
+			}
+			if tt.want != "" && rec.Body.String() != tt.want {
+				t.Errorf("body = %q, want %q", rec.Body.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRouterFromStructErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+	}{
+		{name: "no-routes", v: struct{}{}},
+		{name: "nil", v: nil},
+		{name: "missing-method", v: []pathvars.HandlerRoute{{Method: "GET", Template: "/x", MethodName: "Missing"}}},
+		{name: "no-handler", v: []pathvars.HandlerRoute{{Method: "GET", Template: "/x"}}},
+		{name: "wrong-signature", v: &badSignatureAPI{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, err := pathvars.RouterFromStruct(tt.v)
+			if err == nil || router != nil {
+				t.Fatalf("RouterFromStruct() = %v, %v; want error", router, err)
+			}
+			if !errors.Is(err, pathvars.ErrNoHandlerRoutes) && !errors.Is(err, pathvars.ErrInvalidHandlerRoute) {
+				t.Errorf("RouterFromStruct() error = %v", err)
+			}
+		})
+	}
+}
+
+type badSignatureAPI struct{ userAPI }
+
+func (a *badSignatureAPI) HandlerRoutes() []pathvars.HandlerRoute {
+	return []pathvars.HandlerRoute{{Method: "GET", Template: "/x", MethodName: "NotAHandler"}}
+}
+
+func TestServeHTTPRouteWithoutHandler(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/users/{id:int}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", "/users/1", nil))
+	if rec.Code != http.StatusNotImplemented {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotImplemented)
+	}
+}
@@ -0,0 +1,107 @@
+package test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func seededArgs(seed uint64) *pvtypes.ExampleArgs {
+	return &pvtypes.ExampleArgs{Rand: rand.New(rand.NewPCG(seed, 0))}
+}
+
+func TestSeededParameterExamples(t *testing.T) {
+	specs := []string{
+		"{n:int}",
+		"{n:int:range[1000..2000]}",
+		"{n:int:range[-5..5]}",
+		"{price:decimal:range[0.5..9.5]}",
+		"{ratio:real}",
+		"{name:slug}",
+		"{name:slug:length[4..6]}",
+		"{code:alphanumeric}",
+		"{key:identifier}",
+		"{q:string}",
+		"{status:string:enum[active,inactive,pending]}",
+		"{email:email}",
+		"{on:bool}",
+		"{id:uuid}",
+		"{lat:latitude}",
+		"{lng:longitude}",
+		"{id:uuid:format[v7]}",
+	}
+
+	for _, spec := range specs {
+		t.Run(spec, func(t *testing.T) {
+			param, err := pathvars.ParseParameter(spec, pathvars.PathLocation)
+			if err != nil {
+				t.Fatalf("Failed to parse parameter %q: %v", spec, err)
+			}
+
+			args := seededArgs(42)
+			replay := seededArgs(42)
+			for range 20 {
+				example := fmt.Sprintf("%v", param.Example(nil, args))
+				err = param.Validate(example)
+				if err != nil {
+					t.Fatalf("Example %q failed validation: %v", example, err)
+				}
+				again := fmt.Sprintf("%v", param.Example(nil, replay))
+				if again != example {
+					t.Fatalf("Example() = %q with the same seed, want %q", again, example)
+				}
+			}
+		})
+	}
+}
+
+func TestSeededExampleVaries(t *testing.T) {
+	param, err := pathvars.ParseParameter("{n:int:range[1..1000000]}", pathvars.PathLocation)
+	if err != nil {
+		t.Fatalf("Failed to parse parameter: %v", err)
+	}
+	args := seededArgs(7)
+	seen := make(map[any]bool)
+	for range 10 {
+		seen[param.Example(nil, args)] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("Example() returned %d distinct values from 10 seeded calls, want several", len(seen))
+	}
+
+	// Without a seed the canned example is unchanged
+	if got := param.Example(nil, nil); got != int64(500000) {
+		t.Errorf("Example() without Rand = %v, want the canned midpoint 500000", got)
+	}
+}
+
+func TestSeededTemplateExample(t *testing.T) {
+	pt, err := pathvars.ParseTemplate("/users/{id:int:range[1..99]}/posts/{slug:slug}?{limit:int}&{sort?:string}")
+	if err != nil {
+		t.Fatalf("Failed to parse template: %v", err)
+	}
+
+	first := pt.Example(seededArgs(1))
+	if again := pt.Example(seededArgs(1)); again != first {
+		t.Errorf("Example() = %q with the same seed, want %q", again, first)
+	}
+	if other := pt.Example(seededArgs(2)); other == first {
+		t.Errorf("Example() = %q for different seeds, want different examples", other)
+	}
+	if canned := pt.Example(); canned != "/users/50/posts/abc-123?limit=123" {
+		t.Errorf("Example() without Rand = %q, want the canned example", canned)
+	}
+
+	router := pathvars.NewRouter()
+	err = router.AddRoute("GET", "/users/{id:int:range[1..99]}/posts/{slug:slug}?{limit:int}&{sort?:string}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	_, err = router.MatchPath("GET", first)
+	if err != nil {
+		t.Errorf("MatchPath(%q) error = %v, want the seeded example to match", first, err)
+	}
+}
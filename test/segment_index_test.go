@@ -0,0 +1,86 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestParameterErrorSegmentIndex(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  pathvars.Template
+		path      string
+		wantParam string
+		wantIndex int
+	}{
+		{
+			name:      "second-path-parameter",
+			template:  "/users/{id:int}/posts/{post:int}",
+			path:      "/users/7/posts/42x",
+			wantParam: "post",
+			wantIndex: 3,
+		},
+		{
+			name:      "first-path-parameter",
+			template:  "/users/{id:int}/posts/{post:int}",
+			path:      "/users/seven/posts/42",
+			wantParam: "id",
+			wantIndex: 1,
+		},
+		{
+			name:      "after-multi-segment",
+			template:  "/files/{path*}/v/{rev:int}",
+			path:      "/files/a/b/c/v/latest",
+			wantParam: "rev",
+			wantIndex: 5,
+		},
+		{
+			name:      "second-query-parameter",
+			template:  "/search?{q}&{limit:int}",
+			path:      "/search?q=go&limit=ten",
+			wantParam: "limit",
+			wantIndex: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", tt.template, nil)
+			if err != nil {
+				t.Fatalf("Failed to add route: %v", err)
+			}
+
+			_, err = router.Match(httptest.NewRequest("GET", tt.path, nil))
+			pe, ok := pathvars.FindErr[*pathvars.ParameterError](err)
+			if !ok {
+				t.Fatalf("Match() error = %v, want a *ParameterError", err)
+			}
+			if pe.Parameter != tt.wantParam {
+				t.Errorf("ParameterError.Parameter = %q, want %q", pe.Parameter, tt.wantParam)
+			}
+			if pe.SegmentIndex != tt.wantIndex {
+				t.Errorf("ParameterError.SegmentIndex = %d, want %d", pe.SegmentIndex, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestParameterErrorSegmentIndexRequireAllQuery(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/search?{q?}&{page?1:int}", &pathvars.RouteArgs{RequireAllQuery: true})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/search?q=go", nil))
+	pe, ok := pathvars.FindErr[*pathvars.ParameterError](err)
+	if !ok {
+		t.Fatalf("Match() error = %v, want a *ParameterError", err)
+	}
+	if pe.Parameter != "page" || pe.SegmentIndex != 1 {
+		t.Errorf("ParameterError = %q at %d, want %q at 1", pe.Parameter, pe.SegmentIndex, "page")
+	}
+}
@@ -0,0 +1,166 @@
+package test
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+// segmentMatcherTemplates are matched without regexp, and must behave exactly
+// like their RegexString() pattern.
+var segmentMatcherTemplates = []string{
+	"/health",
+	"/api/v1.0/status",
+	"/users/{id}",
+	"/users/{id}/posts/{post_id}",
+	"/users/{id}/profile",
+	"/v{version}/items",
+	"/files/{name}.json",
+	"/files/v{major}-beta",
+	"/a//b",
+}
+
+var segmentMatcherPaths = []string{
+	"",
+	"/",
+	"//",
+	"/health",
+	"/health/",
+	"/healthz",
+	"/api/v1.0/status",
+	"/api/v1x0/status",
+	"/users",
+	"/users/",
+	"/users/42",
+	"/users/42/",
+	"/users//42",
+	"/users/42/posts/7",
+	"/users/42/posts/",
+	"/users/42/posts/7/comments",
+	"/users/42/profile",
+	"/users/42/settings",
+	"/v2/items",
+	"/v/items",
+	"/vv/items",
+	"/files/report.json",
+	"/files/.json",
+	"/files/a.json.json",
+	"/files/report.xml",
+	"/files/v1-beta",
+	"/files/v-beta",
+	"/a//b",
+	"/a/b",
+	"/users/é",
+}
+
+func TestSegmentMatcherMatchesLikeRegex(t *testing.T) {
+	for _, template := range segmentMatcherTemplates {
+		pt, err := pathvars.ParseTemplate(template)
+		if err != nil {
+			t.Fatalf("ParseTemplate(%q) unexpected error: %v", template, err)
+		}
+		re := regexp.MustCompile(pt.RegexString())
+		for _, path := range segmentMatcherPaths {
+			assertMatchesLikeRegex(t, pt, re, path)
+		}
+	}
+}
+
+func FuzzSegmentMatcher(f *testing.F) {
+	for _, template := range segmentMatcherTemplates {
+		for _, path := range segmentMatcherPaths {
+			f.Add(template, path)
+		}
+	}
+	f.Fuzz(func(t *testing.T, template, path string) {
+		pt, err := pathvars.ParseTemplate(template)
+		if err != nil || pt.RegexString() == "" {
+			t.Skip()
+		}
+		assertMatchesLikeRegex(t, pt, regexp.MustCompile(pt.RegexString()), path)
+	})
+}
+
+func assertMatchesLikeRegex(t *testing.T, pt *pathvars.ParsedTemplate, re *regexp.Regexp, path string) {
+	t.Helper()
+	attempt, _ := pt.Match(path, "")
+	matches := re.FindStringSubmatch(path)
+	if attempt.PathMatched != (matches != nil) {
+		t.Fatalf("Match(%q) against %q: PathMatched = %t, regex matched = %t", path, pt.Original(), attempt.PathMatched, matches != nil)
+	}
+	if matches == nil {
+		return
+	}
+	var got []string
+	for param := range pt.Parameters().Values() {
+		if param.Location() != pathvars.PathLocation {
+			continue
+		}
+		value, _ := attempt.ValuesMap.Get(param.Name)
+		got = append(got, fmt.Sprint(value))
+	}
+	// Parameter order is not guaranteed, so compare captures as sorted lists
+	want := slices.Clone(matches[1:])
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("Match(%q) against %q: captured %q, regex captured %q", path, pt.Original(), got, want)
+	}
+}
+
+// benchmarkRoutes returns count templates of the given shape, e.g.
+// "/r7/users/{id}/posts/{post}".
+func benchmarkRoutes(count int, last string) []pathvars.Template {
+	templates := make([]pathvars.Template, count)
+	for i := range templates {
+		templates[i] = pathvars.Template(fmt.Sprintf("/r%d/users/{id}/posts/%s", i, last))
+	}
+	return templates
+}
+
+// BenchmarkSegmentMatcher compares templates matched by walking path segments
+// with the same templates made multi-segment, which forces the regex matcher.
+// Both shapes match the same paths and capture the same values.
+func BenchmarkSegmentMatcher(b *testing.B) {
+	const routeCount = 200
+	shapes := []struct {
+		name string
+		last string
+	}{
+		{name: "segments", last: "{post}"},
+		{name: "regex", last: "{post*}"},
+	}
+
+	for _, shape := range shapes {
+		templates := benchmarkRoutes(routeCount, shape.last)
+
+		b.Run("cold-start/"+shape.name, func(b *testing.B) {
+			for b.Loop() {
+				router := pathvars.NewRouter()
+				for _, template := range templates {
+					if err := router.AddRoute("GET", template, nil); err != nil {
+						b.Fatalf("AddRoute(%q) unexpected error: %v", template, err)
+					}
+				}
+			}
+		})
+
+		router := pathvars.NewRouter()
+		for _, template := range templates {
+			if err := router.AddRoute("GET", template, nil); err != nil {
+				b.Fatalf("AddRoute(%q) unexpected error: %v", template, err)
+			}
+		}
+		path := fmt.Sprintf("/r%d/users/42/posts/7", routeCount-1)
+		b.Run("steady-state/"+shape.name, func(b *testing.B) {
+			for b.Loop() {
+				if _, err := router.MatchPath("GET", path); err != nil {
+					b.Fatalf("MatchPath(%q) unexpected error: %v", path, err)
+				}
+			}
+		})
+	}
+}
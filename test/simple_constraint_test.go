@@ -0,0 +1,90 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func init() {
+	pathvars.RegisterConstraint(pathvars.NewSimpleConstraint("even",
+		[]pathvars.PVDataType{pathvars.IntegerType},
+		func(value string) error {
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			if n%2 != 0 {
+				return errors.New("value must be even")
+			}
+			return nil
+		},
+	))
+}
+
+func TestSimpleConstraint(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "even", path: "/items/42"},
+		{name: "zero", path: "/items/0"},
+		{name: "negative-even", path: "/items/-8"},
+		{name: "odd", path: "/items/7", wantErr: true},
+		{name: "not-int", path: "/items/abc", wantErr: true},
+	}
+
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/items/{n:int:even}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Match(%s) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSimpleConstraintDetails(t *testing.T) {
+	constraints, err := pathvars.ParseConstraints("even,range[0..100]", pathvars.IntegerType)
+	if err != nil {
+		t.Fatalf("ParseConstraints() error: %v", err)
+	}
+	if got := pathvars.Constraints(constraints).String(); got != "even,range[0..100]" {
+		t.Errorf("String() = %q, want %q", got, "even,range[0..100]")
+	}
+
+	_, err = pathvars.ParseConstraints("even[2]", pathvars.IntegerType)
+	if err == nil {
+		t.Errorf("ParseConstraints(even[2]) expected error for a rule on a simple constraint")
+	}
+
+	_, err = pathvars.ParseConstraints("even", pathvars.StringType)
+	if err == nil {
+		t.Errorf("ParseConstraints(even) expected error for a non-integer data type")
+	}
+
+	router := pathvars.NewRouter()
+	err = router.AddRoute("GET", "/items/{n:int:even}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	_, err = router.Match(httptest.NewRequest("GET", "/items/3", nil))
+	tes := pathvars.TemplateErrors(err)
+	if len(tes) != 1 {
+		t.Fatalf("Expected 1 template error, got %d: %v", len(tes), err)
+	}
+	if s := tes[0].GetSuggestion(); !strings.Contains(s, "satisfies the constraint: even") {
+		t.Errorf("GetSuggestion() = %q, want it to mention the constraint", s)
+	}
+}
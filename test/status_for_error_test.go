@@ -0,0 +1,83 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+func TestStatusForError(t *testing.T) {
+	router := pathvars.NewRouter()
+	routes := []struct {
+		method   pathvars.HTTPMethod
+		template pathvars.Template
+		args     *pathvars.RouteArgs
+	}{
+		{template: "/users/{id:int:range[1..1000]}"},
+		{method: "GET", template: "/reports"},
+		{template: "/search?{q:string}"},
+		{template: "/upload", args: &pathvars.RouteArgs{ContentTypes: []string{"application/json"}}},
+		{template: "/export", args: &pathvars.RouteArgs{Produces: []string{"text/csv"}}},
+		{template: "/misconfigured/{from:int}/{to:int}", args: &pathvars.RouteArgs{
+			CrossChecks: []pathvars.CrossCheck{
+				pathvars.CrossCheckFunc(func(*pathvars.ParsedTemplate, pvtypes.ValuesMap) error {
+					return pathvars.NewErr(errors.New("lookup table missing"), "fault_source", pathvars.ServerFaultSource.Slug())
+				}),
+			},
+		}},
+	}
+	for _, route := range routes {
+		method := route.method
+		if method == "" {
+			method = "*"
+		}
+		err := router.AddRoute(method, route.template, route.args)
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", route.template, err)
+		}
+	}
+
+	matchErr := func(req *http.Request) error {
+		_, err := router.Match(req)
+		if err == nil {
+			t.Fatalf("Match(%s) expected an error", req.URL)
+		}
+		return err
+	}
+	withHeader := func(req *http.Request, key, value string) *http.Request {
+		req.Header.Set(key, value)
+		return req
+	}
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "nil", err: nil, status: http.StatusOK},
+		{name: "no-match", err: matchErr(httptest.NewRequest("GET", "/nowhere", nil)), status: http.StatusNotFound},
+		{name: "method-not-allowed", err: matchErr(httptest.NewRequest("POST", "/reports", nil)), status: http.StatusMethodNotAllowed},
+		{name: "type-error", err: matchErr(httptest.NewRequest("GET", "/users/abc", nil)), status: http.StatusBadRequest},
+		{name: "constraint-error", err: matchErr(httptest.NewRequest("GET", "/users/5000", nil)), status: http.StatusBadRequest},
+		{name: "missing-required-query", err: matchErr(httptest.NewRequest("GET", "/search", nil)), status: http.StatusBadRequest},
+		{name: "unsupported-content-type", err: matchErr(withHeader(httptest.NewRequest("POST", "/upload", strings.NewReader("<x/>")), "Content-Type", "text/xml")), status: http.StatusUnsupportedMediaType},
+		{name: "not-acceptable", err: matchErr(withHeader(httptest.NewRequest("GET", "/export", nil), "Accept", "application/json")), status: http.StatusNotAcceptable},
+		{name: "server-fault-cross-check", err: matchErr(httptest.NewRequest("GET", "/misconfigured/1/2", nil)), status: http.StatusInternalServerError},
+		{name: "server-fault-parameter-error", err: &pathvars.ParameterError{Err: errors.New("bad config"), FaultSource: pathvars.ServerFaultSource}, status: http.StatusInternalServerError},
+		{name: "client-fault-parameter-error", err: &pathvars.ParameterError{Err: errors.New("bad value"), FaultSource: pathvars.ClientFaultSource}, status: http.StatusBadRequest},
+		{name: "unclassified", err: errors.New("something broke"), status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pathvars.StatusForError(tt.err); got != tt.status {
+				t.Errorf("StatusForError(%v) = %d, want %d", tt.err, got, tt.status)
+			}
+		})
+	}
+}
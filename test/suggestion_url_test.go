@@ -1,6 +1,7 @@
 package test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/mikeschinkel/go-pathvars"
@@ -35,14 +36,14 @@ func TestSuggestionURL_ADR018(t *testing.T) {
 			userProvidedParams: newValuesMap(
 				"min_score", 50,
 			),
-			expectedURL: "/GET /api/users/search?min_score={MIN_SCORE}&email=user@example.com",
+			expectedURL: "/api/users/search?min_score={MIN_SCORE}&email=user@example.com",
 		},
 		{
 			name:               "invalid_min_score_no_other_params_provided",
 			templateStr:        "GET /api/users/search?{min_score:int}&{email?:email}",
 			problematicParam:   "min_score",
 			userProvidedParams: newValuesMap("min_score", "invalid"),
-			expectedURL:        "/GET /api/users/search?min_score=123",
+			expectedURL:        "/api/users/search?min_score=123",
 		},
 		{
 			name:             "multiple_user_provided_one_failing",
@@ -53,7 +54,7 @@ func TestSuggestionURL_ADR018(t *testing.T) {
 				"category", "tech",
 				"limit", "invalid",
 			),
-			expectedURL: "/GET /api/posts?user_id={USER_ID}&category={CATEGORY}&limit=123",
+			expectedURL: "/api/posts?user_id={USER_ID}&category={CATEGORY}&limit=123",
 		},
 		{
 			name:             "path_parameter_error_with_query_params",
@@ -63,7 +64,7 @@ func TestSuggestionURL_ADR018(t *testing.T) {
 				"user_id", "invalid-id",
 				"active", true,
 			),
-			expectedURL: "/GET /api/users/123/posts?active={ACTIVE}",
+			expectedURL: "/api/users/123/posts?active={ACTIVE}",
 		},
 		{
 			name:             "optional_parameter_provided_with_invalid_value",
@@ -74,7 +75,7 @@ func TestSuggestionURL_ADR018(t *testing.T) {
 				"created_after", "invalid-date",
 			),
 			// limit and offset NOT shown - user didn't provide them
-			expectedURL: "/GET /api/search?category={CATEGORY}&created_after=1999-12-31",
+			expectedURL: "/api/search?category={CATEGORY}&created_after=1999-12-31",
 		},
 		{
 			name:               "only_required_parameter_fails_nothing_else_provided",
@@ -82,7 +83,7 @@ func TestSuggestionURL_ADR018(t *testing.T) {
 			problematicParam:   "email",
 			userProvidedParams: newValuesMap(),
 			// No other params shown - user didn't provide any, none others are required
-			expectedURL: "/GET /api/search?email=user@example.com",
+			expectedURL: "/api/search?email=user@example.com",
 		},
 		{
 			name:             "all_parameters_fail",
@@ -93,7 +94,7 @@ func TestSuggestionURL_ADR018(t *testing.T) {
 				"min_score", "invalid",
 			),
 			// Both required, both provided, email is problematic so goes last
-			expectedURL: "/GET /api/search?min_score={MIN_SCORE}&email=user@example.com",
+			expectedURL: "/api/search?min_score={MIN_SCORE}&email=user@example.com",
 		},
 		{
 			name:             "query_param_problematic_with_multiple_correct",
@@ -106,7 +107,7 @@ func TestSuggestionURL_ADR018(t *testing.T) {
 				"in_stock", true,
 			),
 			// Note: Query param order may vary based on map iteration
-			expectedURL: "/GET /api/products?category={CATEGORY}&min_price={MIN_PRICE}&in_stock={IN_STOCK}&max_price=123",
+			expectedURL: "/api/products?category={CATEGORY}&min_price={MIN_PRICE}&in_stock={IN_STOCK}&max_price=123",
 		},
 	}
 
@@ -156,7 +157,7 @@ func TestSuggestionURL_PathParameterProblematic(t *testing.T) {
 			userProvidedParams: newValuesMap(
 				"user_id", "abc",
 			),
-			expectedURL: "/GET /api/users/123",
+			expectedURL: "/api/users/123",
 		},
 		{
 			name:             "path_param_problematic_with_query_params",
@@ -167,7 +168,7 @@ func TestSuggestionURL_PathParameterProblematic(t *testing.T) {
 				"limit", 10,
 			),
 			// limit shown because user provided it, offset not shown because user didn't provide it
-			expectedURL: "/GET /api/users/123/posts?limit={LIMIT}",
+			expectedURL: "/api/users/123/posts?limit={LIMIT}",
 		},
 	}
 
@@ -209,14 +210,14 @@ func TestSuggestionURL_EmptyUserProvided(t *testing.T) {
 			templateStr:      "GET /api/users?{email:email}&{name?:string}",
 			problematicParam: "email",
 			// Only required param shown, optional not shown
-			expectedURL: "/GET /api/users?email=user@example.com",
+			expectedURL: "/api/users?email=user@example.com",
 		},
 		{
 			name:             "multiple_required_params_one_missing",
 			templateStr:      "GET /api/search?{query:string}&{category:string}",
 			problematicParam: "query",
 			// Both required params shown
-			expectedURL: "/GET /api/search?category={CATEGORY}&query=abc",
+			expectedURL: "/api/search?category={CATEGORY}&query=abc",
 		},
 	}
 
@@ -244,3 +245,38 @@ func TestSuggestionURL_EmptyUserProvided(t *testing.T) {
 		})
 	}
 }
+
+// TestSuggestionURL_MethodPrefix verifies that a PathSpec-style method prefix is
+// reported by Method() and never embedded in generated example URLs
+func TestSuggestionURL_MethodPrefix(t *testing.T) {
+	tests := []struct {
+		name        string
+		templateStr string
+		wantMethod  pathvars.HTTPMethod
+		wantURL     string
+	}{
+		{name: "get-prefix", templateStr: "GET /api/users/{id:int}", wantMethod: "GET", wantURL: "/api/users/123"},
+		{name: "delete-prefix-with-query", templateStr: "DELETE /api/users/{id:int}?{force:bool}", wantMethod: "DELETE", wantURL: "/api/users/123?force=true"},
+		{name: "extra-spaces", templateStr: "POST   /api/users", wantMethod: "POST", wantURL: "/api/users"},
+		{name: "bare-path", templateStr: "/api/users/{id:int}", wantURL: "/api/users/123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := pathvars.ParseTemplate(tt.templateStr)
+			if err != nil {
+				t.Fatalf("Failed to parse template: %v", err)
+			}
+			if template.Method() != tt.wantMethod {
+				t.Errorf("Method() = %q, want %q", template.Method(), tt.wantMethod)
+			}
+			got := template.Example()
+			if got != tt.wantURL {
+				t.Errorf("Example() = %q, want %q", got, tt.wantURL)
+			}
+			if !strings.HasPrefix(got, "/") || strings.Contains(got, " ") {
+				t.Errorf("Example() = %q is not a valid path", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestParsedTemplateConcat(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		tail     pathvars.Template
+		want     string
+		wantErr  error
+		path     string
+		query    string
+		expected map[pathvars.Identifier]string
+	}{
+		{
+			name:     "literal-base",
+			base:     "/api/v1",
+			tail:     "/users/{id:int}",
+			want:     "/api/v1/users/{id:int}",
+			path:     "/api/v1/users/42",
+			expected: map[pathvars.Identifier]string{"id": "42"},
+		},
+		{
+			name:     "parameters-and-queries-on-both",
+			base:     "GET /api/{version:int}?{key}",
+			tail:     "/users/{id:int}?{limit?10:int}",
+			want:     "/api/{version:int}/users/{id:int}?{key}&{limit?10:int}",
+			path:     "/api/2/users/42",
+			query:    "key=abc",
+			expected: map[pathvars.Identifier]string{"version": "2", "id": "42", "key": "abc", "limit": "10"},
+		},
+		{
+			name:     "trailing-slash-base",
+			base:     "/api/",
+			tail:     "/health",
+			want:     "/api/health",
+			path:     "/api/health",
+			expected: map[pathvars.Identifier]string{},
+		},
+		{
+			name:     "root-tail",
+			base:     "/api",
+			tail:     "/",
+			want:     "/api",
+			path:     "/api",
+			expected: map[pathvars.Identifier]string{},
+		},
+		{
+			name:    "duplicate-parameter",
+			base:    "/orgs/{id}",
+			tail:    "/repos?{id:int}",
+			wantErr: pathvars.ErrDuplicateParameterName,
+		},
+		{
+			name:    "conflicting-methods",
+			base:    "GET /api",
+			tail:    "POST /users",
+			wantErr: pathvars.ErrConflictingTemplateMethods,
+		},
+		{
+			name:    "invalid-tail",
+			base:    "/api",
+			tail:    "/users/{id",
+			wantErr: pathvars.ErrUnmatchedOpeningBrace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, err := pathvars.ParseTemplate(tt.base)
+			if err != nil {
+				t.Fatalf("ParseTemplate(%q) unexpected error: %v", tt.base, err)
+			}
+			combined, err := base.Concat(tt.tail)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Concat(%q) error = %v, want %v", tt.tail, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Concat(%q) unexpected error: %v", tt.tail, err)
+			}
+			if got := combined.Original(); got != tt.want {
+				t.Errorf("Original() = %q, want %q", got, tt.want)
+			}
+			if got := base.Original(); got != tt.base {
+				t.Errorf("base Original() = %q after Concat, want %q", got, tt.base)
+			}
+
+			attempt, err := combined.Match(tt.path, tt.query)
+			if err != nil {
+				t.Fatalf("Match(%q, %q) unexpected error: %v", tt.path, tt.query, err)
+			}
+			if !attempt.PathMatched {
+				t.Fatalf("Match(%q, %q) did not match %q", tt.path, tt.query, combined.Original())
+			}
+			for name, want := range tt.expected {
+				got, _ := attempt.ValuesMap.Get(name)
+				if got != want {
+					t.Errorf("value %q = %v, want %q", name, got, want)
+				}
+			}
+
+			// Positions run path parameters first, then query parameters
+			var position int
+			for _, location := range []pathvars.LocationType{pathvars.PathLocation, pathvars.QueryLocation} {
+				for param := range combined.Parameters().Values() {
+					if param.Location() != location {
+						continue
+					}
+					if param.Position() != position {
+						t.Errorf("%s Position() = %d, want %d", param.Name, param.Position(), position)
+					}
+					position++
+				}
+			}
+		})
+	}
+}
+
+func TestParsedTemplateConcatRoute(t *testing.T) {
+	base, err := pathvars.ParseTemplate("/api/v1")
+	if err != nil {
+		t.Fatalf("ParseTemplate() unexpected error: %v", err)
+	}
+	combined, err := base.Concat("/users/{id:int}")
+	if err != nil {
+		t.Fatalf("Concat() unexpected error: %v", err)
+	}
+
+	router := pathvars.NewRouter()
+	err = router.AddRoute("GET", pathvars.Template(combined.Original()), nil)
+	if err != nil {
+		t.Fatalf("AddRoute() unexpected error: %v", err)
+	}
+	result, err := router.MatchPath("GET", "/api/v1/users/123")
+	if err != nil {
+		t.Fatalf("MatchPath() unexpected error: %v", err)
+	}
+	if got, _ := result.GetValue("id"); got != "123" {
+		t.Errorf("GetValue(id) = %v, want 123", got)
+	}
+	if _, err = router.MatchPath("GET", "/api/v1/users/abc"); err == nil {
+		t.Error("MatchPath(/api/v1/users/abc) expected an error for a non-int id")
+	}
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestTemplateDiagnostic(t *testing.T) {
+	tests := []struct {
+		name     string
+		template pathvars.Template
+		location pathvars.LocationType
+		segment  int
+		position int
+		spec     string
+	}{
+		{
+			name:     "unmatched-opening-brace",
+			template: "/users/{id/posts",
+			location: pathvars.PathLocation,
+			segment:  1,
+			position: 7,
+			spec:     "{id/posts",
+		},
+		{
+			name:     "unmatched-closing-brace",
+			template: "/users/id}/posts",
+			location: pathvars.PathLocation,
+			segment:  1,
+			position: 9,
+		},
+		{
+			name:     "invalid-type",
+			template: "/api/v1/users/{id:bogus}",
+			location: pathvars.PathLocation,
+			segment:  3,
+			position: 14,
+			spec:     "{id:bogus}",
+		},
+		{
+			name:     "invalid-type-after-prefix",
+			template: "/files/v{major:int}.{minor:nope}",
+			location: pathvars.PathLocation,
+			segment:  1,
+			position: 20,
+			spec:     "{minor:nope}",
+		},
+		{
+			name:     "invalid-query-type",
+			template: "/search?{q}&{limit:nope}",
+			location: pathvars.QueryLocation,
+			segment:  -1,
+			position: 12,
+			spec:     "{limit:nope}",
+		},
+		{
+			name:     "adjacent-parameters",
+			template: "/a/{x}{y}",
+			location: pathvars.PathLocation,
+			segment:  1,
+			position: 3,
+		},
+		{
+			name:     "duplicate-parameter",
+			template: "/users/{id}?{id:int}",
+			location: pathvars.QueryLocation,
+			segment:  -1,
+			position: 12,
+			spec:     "{id:int}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", tt.template, nil)
+			if err == nil {
+				t.Fatalf("AddRoute(%q) expected an error", tt.template)
+			}
+			d, ok := pathvars.FindErr[*pathvars.TemplateDiagnostic](err)
+			if !ok {
+				t.Fatalf("AddRoute(%q) error has no TemplateDiagnostic: %v", tt.template, err)
+			}
+			if d.Template != string(tt.template) {
+				t.Errorf("Template = %q, want %q", d.Template, tt.template)
+			}
+			if d.Location != tt.location {
+				t.Errorf("Location = %q, want %q", d.Location, tt.location)
+			}
+			if d.SegmentIndex != tt.segment {
+				t.Errorf("SegmentIndex = %d, want %d", d.SegmentIndex, tt.segment)
+			}
+			if d.Position != tt.position {
+				t.Errorf("Position = %d, want %d", d.Position, tt.position)
+			}
+			if d.ParameterSpec != tt.spec {
+				t.Errorf("ParameterSpec = %q, want %q", d.ParameterSpec, tt.spec)
+			}
+			if d.Message == "" {
+				t.Error("Message is empty")
+			}
+		})
+	}
+}
+
+func TestTemplateDiagnosticMethodPrefix(t *testing.T) {
+	_, err := pathvars.ParseTemplate("GET /users/{id:bogus}")
+	d, ok := pathvars.FindErr[*pathvars.TemplateDiagnostic](err)
+	if !ok {
+		t.Fatalf("ParseTemplate() error has no TemplateDiagnostic: %v", err)
+	}
+	if d.SegmentIndex != 1 || d.Position != 11 {
+		t.Errorf("SegmentIndex, Position = %d, %d; want 1, 11", d.SegmentIndex, d.Position)
+	}
+}
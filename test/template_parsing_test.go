@@ -1,9 +1,11 @@
 package test
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/mikeschinkel/go-pathvars"
@@ -336,3 +338,88 @@ func TestParameterTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateComplexityLimits(t *testing.T) {
+	// paramTemplate builds a template with n distinct path parameters
+	paramTemplate := func(n int) string {
+		var sb strings.Builder
+		for i := range n {
+			fmt.Fprintf(&sb, "/{p%d}", i)
+		}
+		return sb.String()
+	}
+	// lengthTemplate builds a literal template exactly n bytes long
+	lengthTemplate := func(n int) string {
+		return "/" + strings.Repeat("a", n-1)
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{name: "parameters-at-limit", template: paramTemplate(pathvars.MaxTemplateParameters)},
+		{name: "parameters-over-limit", template: paramTemplate(pathvars.MaxTemplateParameters + 1), wantErr: true},
+		{name: "length-at-limit", template: lengthTemplate(pathvars.MaxTemplateLength)},
+		{name: "length-over-limit", template: lengthTemplate(pathvars.MaxTemplateLength + 1), wantErr: true},
+		{name: "nested-braces-not-counted", template: "/{code:string:regex[a{2}b{3}]}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pathvars.ParseTemplate(tt.template)
+			if tt.wantErr {
+				if !errors.Is(err, pathvars.ErrTemplateTooComplex) {
+					t.Errorf("ParseTemplate() error = %v, want ErrTemplateTooComplex", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ParseTemplate() unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("configurable", func(t *testing.T) {
+		saved := pathvars.MaxTemplateParameters
+		pathvars.MaxTemplateParameters = 2
+		t.Cleanup(func() { pathvars.MaxTemplateParameters = saved })
+
+		_, err := pathvars.ParseTemplate(paramTemplate(3))
+		if !errors.Is(err, pathvars.ErrTemplateTooComplex) {
+			t.Errorf("ParseTemplate() error = %v, want ErrTemplateTooComplex", err)
+		}
+	})
+}
+
+func TestDuplicateParameterNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{name: "path-path", template: "/{id}/{id}", wantErr: true},
+		{name: "path-path-different-types", template: "/users/{id:int}/posts/{id:uuid}", wantErr: true},
+		{name: "path-query", template: "/users/{id}?{id:int}", wantErr: true},
+		{name: "query-query", template: "/search?{q}&{q:int}", wantErr: true},
+		{name: "distinct-names", template: "/users/{id:int}/posts/{post_id:int}?{q}&{limit?10:int}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pathvars.ParseTemplate(tt.template)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("ParseTemplate() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, pathvars.ErrDuplicateParameterName) {
+				t.Fatalf("ParseTemplate() error = %v, want ErrDuplicateParameterName", err)
+			}
+			if !strings.Contains(err.Error(), "parameter_name=") {
+				t.Errorf("ParseTemplate() error = %v, want it to name the duplicate parameter", err)
+			}
+		})
+	}
+}
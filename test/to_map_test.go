@@ -0,0 +1,49 @@
+package test
+
+import (
+	"maps"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatchResultToMap(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/archive/{date*:date}/{slug:slug}?{limit?20:int}&{tag?:string}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	result, err := router.Match(httptest.NewRequest("GET", "/archive/2025/10/15/hello-world?tag=go", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"date":       "2025/10/15",
+		"date_year":  "2025",
+		"date_month": "10",
+		"date_day":   "15",
+		"slug":       "hello-world",
+		"limit":      "20",
+		"tag":        "go",
+	}
+	if got := result.ToMap(); !maps.Equal(got, want) {
+		t.Errorf("ToMap() = %v, want %v", got, want)
+	}
+
+	typed := result.ToStringMap()
+	if len(typed) != len(want) {
+		t.Errorf("ToStringMap() has %d entries, want %d", len(typed), len(want))
+	}
+	for name, value := range want {
+		if got := typed[pathvars.Identifier(name)]; got != value {
+			t.Errorf("ToStringMap()[%q] = %q, want %q", name, got, value)
+		}
+	}
+
+	if got := (pathvars.MatchResult{}).ToMap(); len(got) != 0 {
+		t.Errorf("zero MatchResult ToMap() = %v, want empty", got)
+	}
+}
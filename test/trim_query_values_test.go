@@ -0,0 +1,79 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestTrimQueryValues(t *testing.T) {
+	const template = "/items/{kind:string:enum[book,film]}?{sort:string:enum[name,date]}&{page?1:int}"
+
+	tests := []struct {
+		name    string
+		path    string
+		trim    bool
+		wantErr bool
+		sort    string
+		page    string
+	}{
+		{name: "trim-encoded-spaces", path: "/items/book?sort=%20name%20", trim: true, sort: "name", page: "1"},
+		{name: "trim-plus-spaces", path: "/items/book?sort=+date+&page=+2", trim: true, sort: "date", page: "2"},
+		{name: "trim-tabs", path: "/items/book?sort=%09name", trim: true, sort: "name", page: "1"},
+		{name: "trim-clean-value", path: "/items/book?sort=name", trim: true, sort: "name", page: "1"},
+		{name: "trim-only-spaces-fails", path: "/items/book?sort=%20%20", trim: true, wantErr: true},
+		{name: "trim-path-unaffected", path: "/items/%20book?sort=name", trim: true, wantErr: true},
+		{name: "default-spaces-fail", path: "/items/book?sort=%20name%20", wantErr: true},
+		{name: "default-clean-value", path: "/items/book?sort=name", sort: "name", page: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter(&pathvars.RouterArgs{TrimQueryValues: tt.trim})
+			err := router.AddRoute("GET", template, nil)
+			if err != nil {
+				t.Fatalf("Failed to add route: %v", err)
+			}
+			result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Match(%s) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			values := result.ToMap()
+			if values["sort"] != tt.sort {
+				t.Errorf("sort = %q, want %q", values["sort"], tt.sort)
+			}
+			if values["page"] != tt.page {
+				t.Errorf("page = %q, want %q", values["page"], tt.page)
+			}
+		})
+	}
+}
+
+func TestTrimQueryValuesAfterUnmarshal(t *testing.T) {
+	source := pathvars.NewRouter()
+	err := source.AddRoute("GET", "/items?{sort:string:enum[name,date]}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+	data, err := source.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	router := pathvars.NewRouter(&pathvars.RouterArgs{TrimQueryValues: true})
+	err = router.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() error: %v", err)
+	}
+	result, err := router.Match(httptest.NewRequest("GET", "/items?sort=+name+", nil))
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+	if got := result.ToMap()["sort"]; got != "name" {
+		t.Errorf("sort = %q, want %q", got, "name")
+	}
+}
@@ -0,0 +1,62 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatchResultTyped(t *testing.T) {
+	router := pathvars.NewRouter()
+	err := router.AddRoute("GET", "/orders/{id:int}/{price:decimal}/{ratio:real}/{active:bool}/{on:date}/{code:alphanum}?{due?:date:format[yyyy/mm/dd]}&{lat?:latitude}&{verbose?:flag}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/orders/42/19.99/0.5/true/2025-03-14/ABC123?due=2025/04/01&lat=40.7&verbose", nil)
+	result, err := router.Match(req)
+	if err != nil {
+		t.Fatalf("Match() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name pathvars.Identifier
+		want any
+	}{
+		{name: "id", want: int64(42)},
+		{name: "price", want: 19.99},
+		{name: "ratio", want: 0.5},
+		{name: "active", want: true},
+		{name: "on", want: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{name: "code", want: "ABC123"},
+		{name: "due", want: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "lat", want: 40.7},
+		{name: "verbose", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.name), func(t *testing.T) {
+			got, found := result.Typed(tt.name)
+			if !found {
+				t.Fatalf("Typed(%q) not found", tt.name)
+			}
+			switch want := tt.want.(type) {
+			case time.Time:
+				gotTime, ok := got.(time.Time)
+				if !ok || !gotTime.Equal(want) {
+					t.Errorf("Typed(%q) = %#v (%T), want %v", tt.name, got, got, want)
+				}
+			default:
+				if got != tt.want {
+					t.Errorf("Typed(%q) = %#v (%T), want %#v (%T)", tt.name, got, got, tt.want, tt.want)
+				}
+			}
+		})
+	}
+
+	if got, found := result.Typed("missing"); found || got != nil {
+		t.Errorf("Typed(missing) = %v, %t; want nil, false", got, found)
+	}
+}
@@ -0,0 +1,152 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestUnreachableRoutes(t *testing.T) {
+	type route struct {
+		method   pathvars.HTTPMethod
+		template pathvars.Template
+		args     *pathvars.RouteArgs
+	}
+	tests := []struct {
+		name   string
+		routes []route
+		// want maps the index of each unreachable route to the index of the
+		// route that shadows it
+		want map[int]int
+	}{
+		{
+			name: "later-literal-is-more-specific",
+			routes: []route{
+				{method: "GET", template: "/users/{id}"},
+				{method: "GET", template: "/users/active"},
+			},
+		},
+		{
+			name: "literal-shadowed-by-higher-priority-parameter",
+			routes: []route{
+				{method: "GET", template: "/users/{id}", args: &pathvars.RouteArgs{Priority: 1}},
+				{method: "GET", template: "/users/active"},
+			},
+			want: map[int]int{1: 0},
+		},
+		{
+			name: "literal-shadowed-despite-int-validation",
+			routes: []route{
+				{method: "GET", template: "/users/{id:int}", args: &pathvars.RouteArgs{Priority: 1}},
+				{method: "GET", template: "/users/active"},
+			},
+			want: map[int]int{1: 0},
+		},
+		{
+			name: "literal-first-is-reachable",
+			routes: []route{
+				{method: "GET", template: "/users/active"},
+				{method: "GET", template: "/users/{id}"},
+			},
+		},
+		{
+			name: "different-methods",
+			routes: []route{
+				{method: "GET", template: "/users/{id}"},
+				{method: "DELETE", template: "/users/active"},
+			},
+		},
+		{
+			name: "any-method-shadows",
+			routes: []route{
+				{method: pathvars.MethodAny, template: "/users/{id}", args: &pathvars.RouteArgs{Priority: 1}},
+				{method: "DELETE", template: "/users/active"},
+			},
+			want: map[int]int{1: 0},
+		},
+		{
+			name: "duplicate-template",
+			routes: []route{
+				{method: "GET", template: "/users/{id:int}"},
+				{method: "GET", template: "/users/{user_id:string}"},
+			},
+			want: map[int]int{1: 0},
+		},
+		{
+			name: "catch-all-shadows-deeper-routes",
+			routes: []route{
+				{method: "GET", template: "/files/{path*}", args: &pathvars.RouteArgs{Priority: 1}},
+				{method: "GET", template: "/files/{dir}/readme"},
+				{method: "GET", template: "/files"},
+			},
+			want: map[int]int{1: 0},
+		},
+		{
+			name: "affixes-must-cover",
+			routes: []route{
+				{method: "GET", template: "/files/{name}.json"},
+				{method: "GET", template: "/files/report.json"},
+				{method: "GET", template: "/files/report.xml"},
+				{method: "GET", template: "/files/v{n}.json"},
+			},
+			// The literals are more specific, but v{n}.json ranks the same as {name}.json
+			want: map[int]int{3: 0},
+		},
+		{
+			name: "different-segment-counts",
+			routes: []route{
+				{method: "GET", template: "/users/{id}"},
+				{method: "GET", template: "/users/{id}/posts"},
+			},
+		},
+		{
+			name: "host-route-does-not-shadow-any-host",
+			routes: []route{
+				{method: "GET", template: "/users/{id}", args: &pathvars.RouteArgs{Host: "api.example.com"}},
+				{method: "GET", template: "/users/active"},
+			},
+		},
+		{
+			name: "wildcard-host-shadows-subdomain",
+			routes: []route{
+				{method: "GET", template: "/users/{id}", args: &pathvars.RouteArgs{Host: "*.example.com", Priority: 1}},
+				{method: "GET", template: "/users/active", args: &pathvars.RouteArgs{Host: "api.example.com"}},
+			},
+			want: map[int]int{1: 0},
+		},
+		{
+			name: "produces-falls-through",
+			routes: []route{
+				{method: "GET", template: "/users/{id}", args: &pathvars.RouteArgs{Produces: []string{"text/csv"}}},
+				{method: "GET", template: "/users/active"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			for _, r := range tt.routes {
+				err := router.AddRoute(r.method, r.template, r.args)
+				if err != nil {
+					t.Fatalf("AddRoute(%s) unexpected error: %v", r.template, err)
+				}
+			}
+
+			got := router.UnreachableRoutes()
+			if len(got) != len(tt.want) {
+				t.Fatalf("UnreachableRoutes() returned %d routes, want %d: %v", len(got), len(tt.want), got)
+			}
+			for _, info := range got {
+				shadowedBy, ok := tt.want[info.Route.Index]
+				if !ok {
+					t.Errorf("route %d (%s) unexpectedly reported unreachable", info.Route.Index, info.Route.Endpoint())
+					continue
+				}
+				if info.ShadowedBy.Index != shadowedBy {
+					t.Errorf("route %d ShadowedBy = %d, want %d", info.Route.Index, info.ShadowedBy.Index, shadowedBy)
+				}
+			}
+		})
+	}
+}
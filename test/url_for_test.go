@@ -0,0 +1,125 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+const (
+	routeListUsers = iota
+	routeGetUser
+	routeGetFile
+)
+
+func newURLForRouter(t *testing.T) *pathvars.Router {
+	t.Helper()
+	router := pathvars.NewRouter()
+	routes := []struct {
+		template pathvars.Template
+		index    int
+	}{
+		{"/users?{limit?10:int:range[1..100]}&{q?}", routeListUsers},
+		{"/users/{id:uuid}", routeGetUser},
+		{"/files/{path*}", routeGetFile},
+	}
+	for _, route := range routes {
+		err := router.AddRoute("GET", route.template, &pathvars.RouteArgs{Index: route.index})
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", route.template, err)
+		}
+	}
+	return router
+}
+
+func TestURLFor(t *testing.T) {
+	router := newURLForRouter(t)
+
+	tests := []struct {
+		name   string
+		index  int
+		values map[pathvars.Identifier]any
+		want   string
+	}{
+		{
+			name:   "get-user-uuid",
+			index:  routeGetUser,
+			values: map[pathvars.Identifier]any{"id": "550e8400-e29b-41d4-a716-446655440000"},
+			want:   "/users/550e8400-e29b-41d4-a716-446655440000",
+		},
+		{
+			name:   "query-in-template-order",
+			index:  routeListUsers,
+			values: map[pathvars.Identifier]any{"q": "a&b c", "limit": 20},
+			want:   "/users?limit=20&q=a%26b+c",
+		},
+		{
+			name:   "optional-query-omitted",
+			index:  routeListUsers,
+			values: nil,
+			want:   "/users",
+		},
+		{
+			name:   "multi-segment-path",
+			index:  routeGetFile,
+			values: map[pathvars.Identifier]any{"path": "docs/my notes.txt"},
+			want:   "/files/docs/my%20notes.txt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := router.URLFor(tt.index, tt.values)
+			if err != nil {
+				t.Fatalf("URLFor() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("URLFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURLForErrors(t *testing.T) {
+	router := newURLForRouter(t)
+
+	tests := []struct {
+		name   string
+		index  int
+		values map[pathvars.Identifier]any
+		want   error
+	}{
+		{
+			name:  "unknown-index",
+			index: 99,
+			want:  pathvars.ErrRouteIndexNotFound,
+		},
+		{
+			name:  "missing-required",
+			index: routeGetUser,
+			want:  pathvars.ErrRequiredParameterNotProvided,
+		},
+		{
+			name:   "unknown-parameter",
+			index:  routeGetUser,
+			values: map[pathvars.Identifier]any{"id": "550e8400-e29b-41d4-a716-446655440000", "slug": "x"},
+			want:   pathvars.ErrParameterNotFoundInValuesMap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := router.URLFor(tt.index, tt.values)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("URLFor() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// Values are validated like matched requests
+	_, err := router.URLFor(routeGetUser, map[pathvars.Identifier]any{"id": "not-a-uuid"})
+	if err == nil {
+		t.Error("URLFor() expected an error for an invalid UUID")
+	}
+}
@@ -0,0 +1,52 @@
+package test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMatchResultUUIDVersion(t *testing.T) {
+	router := pathvars.NewRouter()
+	for _, template := range []pathvars.Template{
+		"/items/{id:uuid:format[any]}",
+		"/legacy/{id:uuid:format[v1-5]}",
+		"/orders/{id:uuid:format[ulid]}",
+		"/users/{name:string}",
+	} {
+		err := router.AddRoute("GET", template, nil)
+		if err != nil {
+			t.Fatalf("Failed to add route %s: %v", template, err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		param       pathvars.Identifier
+		wantVersion int
+		wantOK      bool
+	}{
+		{name: "any-v1", path: "/items/f81d4fae-7dec-11d0-a765-00a0c91e6bf6", param: "id", wantVersion: 1, wantOK: true},
+		{name: "any-v4", path: "/items/deadbeef-cafe-4011-8123-b1d5c0d51234", param: "id", wantVersion: 4, wantOK: true},
+		{name: "any-v7", path: "/items/018d9f10-5341-7c91-9e73-b3c14d9b4b0e", param: "id", wantVersion: 7, wantOK: true},
+		{name: "range-v5", path: "/legacy/2a98f1f0-0a71-50e5-9d51-8650e68d9518", param: "id", wantVersion: 5, wantOK: true},
+		{name: "ulid", path: "/orders/01ARZ3NDEKTSV4RRFFQ69G5FAV", param: "id"},
+		{name: "not-uuid-typed", path: "/users/alice", param: "name"},
+		{name: "unknown-parameter", path: "/items/f81d4fae-7dec-11d0-a765-00a0c91e6bf6", param: "missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := router.Match(httptest.NewRequest("GET", tt.path, nil))
+			if err != nil {
+				t.Fatalf("Match() unexpected error: %v", err)
+			}
+			version, ok := result.UUIDVersion(tt.param)
+			if ok != tt.wantOK || version != tt.wantVersion {
+				t.Errorf("UUIDVersion(%q) = (%d, %t), want (%d, %t)", tt.param, version, ok, tt.wantVersion, tt.wantOK)
+			}
+		})
+	}
+}
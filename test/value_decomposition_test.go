@@ -176,3 +176,87 @@ func TestValueDecompositionWithFormatConstraint(t *testing.T) {
 		}
 	}
 }
+
+// TestValueDecompositionCustomSeparator tests decomposition on a per-parameter
+// separator supplied via ParameterArgs.Separator.
+func TestValueDecompositionCustomSeparator(t *testing.T) {
+	tests := []struct {
+		name           string
+		template       pathvars.Template
+		paramName      pathvars.Identifier
+		separator      string
+		testPath       string
+		expectedValues map[string]string
+	}{
+		{
+			name:      "dotted path",
+			template:  "/config/{path*:string}",
+			paramName: "path",
+			separator: ".",
+			testPath:  "/config/a.b.c",
+			expectedValues: map[string]string{
+				"path":   "a.b.c",
+				"path_1": "a",
+				"path_2": "b",
+				"path_3": "c",
+			},
+		},
+		{
+			name:      "dotted path keeps slashes within parts",
+			template:  "/config/{path*:string}",
+			paramName: "path",
+			separator: ".",
+			testPath:  "/config/x/y.z",
+			expectedValues: map[string]string{
+				"path":   "x/y.z",
+				"path_1": "x/y",
+				"path_2": "z",
+			},
+		},
+		{
+			name:      "date ignores separator",
+			template:  "/archive/{date*:date}",
+			paramName: "date",
+			separator: ".",
+			testPath:  "/archive/2025/10/15",
+			expectedValues: map[string]string{
+				"date":       "2025/10/15",
+				"date_year":  "2025",
+				"date_month": "10",
+				"date_day":   "15",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := pathvars.NewRouter()
+			err := router.AddRoute("GET", tt.template, &pathvars.RouteArgs{
+				Parameters: []pathvars.Parameter{
+					pathvars.NewParameter(pathvars.ParameterArgs{
+						NameProps: pathvars.NameSpecProps{Name: tt.paramName, MultiSegment: true},
+						Separator: tt.separator,
+					}),
+				},
+			})
+			if err != nil {
+				t.Fatalf("Failed to add route: %v", err)
+			}
+
+			result, err := router.Match(httptest.NewRequest("GET", tt.testPath, nil))
+			if err != nil {
+				t.Fatalf("Expected match but got error: %v", err)
+			}
+
+			got := result.ToMap()
+			for key, expectedValue := range tt.expectedValues {
+				if got[key] != expectedValue {
+					t.Errorf("For key %q: expected %q, got %q", key, expectedValue, got[key])
+				}
+			}
+			if len(got) != len(tt.expectedValues) {
+				t.Errorf("Got values %v, want %v", got, tt.expectedValues)
+			}
+		})
+	}
+}
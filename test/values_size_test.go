@@ -0,0 +1,84 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+func TestMaxValuesSize(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{MaxValuesSize: 64})
+	err := router.AddRoute("GET", "/files/{src*}/to/{dst*}?{note?}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	long := strings.Repeat("a/", 20) // 40 bytes per capture
+
+	_, err = router.Match(httptest.NewRequest("GET", "/files/a/b/c/to/d/e?note=ok", nil))
+	if err != nil {
+		t.Errorf("Match() unexpected error under the cap: %v", err)
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/files/"+long+"x/to/"+long+"y", nil))
+	if !errors.Is(err, pathvars.ErrValuesTooLarge) {
+		t.Fatalf("Match() error = %v, want ErrValuesTooLarge", err)
+	}
+	if status := pathvars.StatusForError(err); status != http.StatusBadRequest {
+		t.Errorf("StatusForError() = %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+func TestMaxValuesSizeDefaultsNotCounted(t *testing.T) {
+	router := pathvars.NewRouter(&pathvars.RouterArgs{MaxValuesSize: 16})
+	err := router.AddRoute("GET", "/search?{q}&{sort?relevance-descending}", nil)
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	_, err = router.Match(httptest.NewRequest("GET", "/search?q=go", nil))
+	if err != nil {
+		t.Errorf("Match() unexpected error: %v", err)
+	}
+}
+
+func TestMaxValuesSizeCheckedBeforeValidation(t *testing.T) {
+	var calls atomic.Int32
+	router := pathvars.NewRouter(&pathvars.RouterArgs{MaxValuesSize: 32})
+	err := router.AddRoute("GET", "/users/{id:int}", &pathvars.RouteArgs{
+		Parameters: []pathvars.Parameter{
+			pathvars.NewParameter(pathvars.ParameterArgs{
+				NameProps: pathvars.NameSpecProps{Name: "id"},
+				Location:  pathvars.PathLocation,
+				DataType:  pathvars.IntegerType,
+				AsyncValidator: func(context.Context, string) error {
+					calls.Add(1)
+					return nil
+				},
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add route: %v", err)
+	}
+
+	// Neither an invalid nor a valid oversized value is validated
+	for _, id := range []string{strings.Repeat("x", 64), strings.Repeat("1", 64)} {
+		_, err = router.Match(httptest.NewRequest("GET", "/users/"+id, nil))
+		if !errors.Is(err, pathvars.ErrValuesTooLarge) {
+			t.Fatalf("Match() error = %v, want ErrValuesTooLarge", err)
+		}
+		if _, ok := pathvars.FindErr[*pathvars.ParameterError](err); ok {
+			t.Errorf("Match() error = %v, want no parameter validation error", err)
+		}
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("validator called %d times, want 0 for oversized requests", got)
+	}
+}
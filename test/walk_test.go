@@ -0,0 +1,101 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mikeschinkel/go-pathvars"
+)
+
+// restAPIRoutes mirrors the routes registered by examples/rest_api.
+var restAPIRoutes = []struct {
+	method   pathvars.HTTPMethod
+	template pathvars.Template
+}{
+	{"GET", "/users?{limit?10:int:range[1..100]}&{offset?0:int:range[0..1000]}"},
+	{"POST", "/users"},
+	{"GET", "/users/{id:uuid}"},
+	{"PUT", "/users/{id:uuid}"},
+	{"DELETE", "/users/{id:uuid}"},
+	{"GET", "/health"},
+}
+
+func newRESTAPIRouter(t *testing.T) *pathvars.Router {
+	t.Helper()
+	router := pathvars.NewRouter()
+	for _, route := range restAPIRoutes {
+		err := router.AddRoute(route.method, route.template, &pathvars.RouteArgs{
+			Description: string(route.method) + " " + string(route.template),
+		})
+		if err != nil {
+			t.Fatalf("Failed to add route %s %s: %v", route.method, route.template, err)
+		}
+	}
+	return router
+}
+
+func TestRouterWalk(t *testing.T) {
+	router := newRESTAPIRouter(t)
+
+	var visited int
+	router.Walk(func(method pathvars.HTTPMethod, template *pathvars.ParsedTemplate, args pathvars.RouteArgs) bool {
+		if visited >= len(restAPIRoutes) {
+			t.Fatalf("Walk() visited more than %d routes", len(restAPIRoutes))
+		}
+		want := restAPIRoutes[visited]
+		if method != want.method {
+			t.Errorf("route %d method = %s, want %s", visited, method, want.method)
+		}
+		if template.Original() != string(want.template) {
+			t.Errorf("route %d template = %s, want %s", visited, template.Original(), want.template)
+		}
+		if args.Index != visited {
+			t.Errorf("route %d args.Index = %d", visited, args.Index)
+		}
+		if args.Description != string(want.method)+" "+string(want.template) {
+			t.Errorf("route %d args.Description = %q", visited, args.Description)
+		}
+		visited++
+		return true
+	})
+	if visited != len(restAPIRoutes) {
+		t.Errorf("Walk() visited %d routes, want %d", visited, len(restAPIRoutes))
+	}
+}
+
+func TestRouterWalkParameters(t *testing.T) {
+	router := newRESTAPIRouter(t)
+
+	// Template parameters are not guaranteed to be listed in declaration order
+	counts := make(map[pathvars.Identifier]int)
+	router.Walk(func(method pathvars.HTTPMethod, template *pathvars.ParsedTemplate, args pathvars.RouteArgs) bool {
+		for _, param := range args.Parameters {
+			counts[param.Name]++
+		}
+		return true
+	})
+	want := map[pathvars.Identifier]int{"limit": 1, "offset": 1, "id": 3}
+	if len(counts) != len(want) {
+		t.Fatalf("parameters = %v, want %v", counts, want)
+	}
+	for name, n := range want {
+		if counts[name] != n {
+			t.Errorf("parameter %s seen %d times, want %d", name, counts[name], n)
+		}
+	}
+}
+
+func TestRouterWalkStopsEarly(t *testing.T) {
+	router := newRESTAPIRouter(t)
+
+	var methods []pathvars.HTTPMethod
+	router.Walk(func(method pathvars.HTTPMethod, template *pathvars.ParsedTemplate, args pathvars.RouteArgs) bool {
+		methods = append(methods, method)
+		return len(methods) < 3
+	})
+	if len(methods) != 3 {
+		t.Fatalf("Walk() visited %d routes after stopping, want 3", len(methods))
+	}
+	if methods[2] != "GET" {
+		t.Errorf("third route method = %s, want GET", methods[2])
+	}
+}
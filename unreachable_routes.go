@@ -0,0 +1,145 @@
+package pathvars
+
+import (
+	"strings"
+)
+
+// RouteInfo describes a route reported by Router.UnreachableRoutes() or
+// Router.RoutesByFirstSegment().
+type RouteInfo struct {
+	// Route is the route reported, e.g. one that can never be matched.
+	Route *Route
+
+	// ShadowedBy is the earlier route that claims every request Route could
+	// match, e.g. "/users/{id}" with a higher Priority than "/users/active".
+	// It is only set by UnreachableRoutes().
+	ShadowedBy *Route
+}
+
+// UnreachableRoutes reports routes that can never be matched because an
+// earlier route always claims their requests first, such as a duplicate
+// template or a broader route given a higher Priority. Since Match() stops at
+// the first route whose method, host and path match, a parameter route such
+// as "/users/{id:int}" with a higher Priority shadows "/users/active" even
+// though "active" fails its int validation: the request gets that validation
+// error rather than falling through. An earlier route with Produces or ClientCert does not
+// shadow later ones, since a request it cannot serve moves on to the next
+// route.
+//
+// The analysis is conservative and only reports routes whose shadowing can be
+// proven from the templates, so an empty result does not guarantee every
+// route is reachable. It is intended for linting route tables at startup or
+// in tests, and is not intended for the hot path.
+func (r *Router) UnreachableRoutes() (unreachable []RouteInfo) {
+	for i, route := range r.routes {
+		for _, earlier := range r.routes[:i] {
+			if !earlier.shadows(route) {
+				continue
+			}
+			unreachable = append(unreachable, RouteInfo{
+				Route:      route,
+				ShadowedBy: earlier,
+			})
+			break
+		}
+	}
+	return unreachable
+}
+
+// shadows reports whether r, tried before later, claims every request that
+// later could match.
+func (r *Route) shadows(later *Route) bool {
+	switch {
+	case len(r.Produces) != 0, r.ClientCert != nil:
+		return false
+	case r.Method != MethodAny && r.Method != "" && r.Method != later.Method:
+		return false
+	case !r.coversHost(later.Host):
+		return false
+	}
+	return coversSegments(r.ParsedTemplate, later.ParsedTemplate)
+}
+
+// coversHost reports whether every host matching the later host also matches
+// the route's Host.
+func (r *Route) coversHost(host string) bool {
+	if r.Host == "" || r.Host == host {
+		return true
+	}
+	suffix, wildcard := strings.CutPrefix(r.Host, "*.")
+	return wildcard && strings.HasSuffix(host, "."+suffix)
+}
+
+// coversSegments reports whether every path matching later's segments also
+// matches pt's. A multi-segment parameter is only understood as the whole of
+// pt's last segment, e.g. "/files/{path*}", where it covers one or more of
+// later's remaining segments.
+func coversSegments(pt, later *ParsedTemplate) bool {
+	segments := pt.segments
+	laterSegments := later.segments
+
+	for i, segment := range segments {
+		if pt.isCatchAll(segment) && i == len(segments)-1 {
+			return len(laterSegments) > i && coversRemaining(laterSegments[i:])
+		}
+		if i >= len(laterSegments) || !pt.coversSegment(segment, later, laterSegments[i]) {
+			return false
+		}
+	}
+	return len(segments) == len(laterSegments)
+}
+
+// coversSegment reports whether every value matched by later's segment also
+// matches segment.
+func (pt *ParsedTemplate) coversSegment(segment Segment, later *ParsedTemplate, laterSegment Segment) (covers bool) {
+	if segment.IsLiteral() {
+		covers = laterSegment.IsLiteral() && laterSegment.Raw == segment.Raw
+		goto end
+	}
+	if len(segment.Parameters) != 1 || pt.isMultiSegment(segment.Parameters[0].Name) {
+		goto end
+	}
+	if laterSegment.IsLiteral() {
+		_, covers = trimSegmentAffixes(laterSegment.Raw, segment.Prefix, segment.Suffix)
+		goto end
+	}
+	for _, param := range laterSegment.Parameters {
+		if later.isMultiSegment(param.Name) {
+			goto end
+		}
+	}
+	// later's parameters always match at least one character between its
+	// prefix and suffix, leaving a value for segment's parameter
+	covers = strings.HasPrefix(laterSegment.Prefix, segment.Prefix) &&
+		strings.HasSuffix(laterSegment.Suffix, segment.Suffix)
+end:
+	return covers
+}
+
+// coversRemaining reports whether each of segments matches only non-empty
+// values, as a trailing catch-all parameter requires.
+func coversRemaining(segments []Segment) bool {
+	for _, segment := range segments {
+		if segment.IsLiteral() && segment.Raw == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// isCatchAll reports whether segment is a lone multi-segment parameter with
+// no literal prefix or suffix, such as "{path*}".
+func (pt *ParsedTemplate) isCatchAll(segment Segment) bool {
+	return segment.IsParameter() &&
+		len(segment.Parameters) == 1 &&
+		segment.Prefix == "" &&
+		segment.Suffix == "" &&
+		pt.isMultiSegment(segment.Parameters[0].Name)
+}
+
+// isMultiSegment reports whether the named parameter may span several path
+// segments.
+func (pt *ParsedTemplate) isMultiSegment(name Identifier) bool {
+	param, exists := pt.params.Get(name)
+	return exists && param.MultiSegment
+}
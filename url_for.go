@@ -0,0 +1,77 @@
+package pathvars
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/mikeschinkel/go-pathvars/pvtypes"
+)
+
+// URLFor builds a URL for the route whose RouteArgs.Index is index by
+// substituting values into its template, e.g. "/users/{id:uuid}" with
+// {"id": "550e8400-..."} gives "/users/550e8400-...". Values are validated
+// against their parameters and escaped; optional query parameters without a
+// value are omitted. It fails with ErrRouteIndexNotFound for an unknown index
+// and ErrRequiredParameterNotProvided for a missing required parameter.
+func (r *Router) URLFor(index int, values map[Identifier]any) (u string, err error) {
+	var route *Route
+	var ordered *pvtypes.OrderedMap[Identifier, any]
+	var pt *ParsedTemplate
+
+	for _, rt := range r.routes {
+		if rt.Index == index {
+			route = rt
+			break
+		}
+	}
+	if route == nil {
+		err = NewErr(ErrRouteIndexNotFound, "index", index)
+		goto end
+	}
+
+	pt = route.ParsedTemplate
+	err = pt.Validate(values)
+	if err != nil {
+		goto end
+	}
+
+	// Template order keeps the query string stable across calls
+	ordered = pvtypes.NewOrderedMap[Identifier, any](len(values))
+	for p := range pt.params.Values() {
+		value, found := values[p.Name]
+		if !found {
+			continue
+		}
+		ordered.Set(p.Name, escapeURLValue(p, p.FormatValue(value)))
+	}
+	for name, value := range values {
+		_, found := ordered.Get(name)
+		if !found {
+			ordered.Set(name, value)
+		}
+	}
+
+	u, err = pt.Substitute(ordered)
+
+end:
+	if err != nil {
+		err = WithErr(err, "index", index)
+	}
+	return u, err
+}
+
+// escapeURLValue escapes value for p's location, keeping the separators of a
+// multi-segment path parameter intact.
+func escapeURLValue(p Parameter, value string) string {
+	if p.Location() == QueryLocation {
+		return url.QueryEscape(value)
+	}
+	if !p.MultiSegment {
+		return url.PathEscape(value)
+	}
+	parts := strings.Split(value, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
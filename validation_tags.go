@@ -0,0 +1,88 @@
+package pathvars
+
+import (
+	"strings"
+)
+
+// dataTypeValidationTags maps data types to the equivalent baked-in tags of
+// struct validators such as github.com/go-playground/validator. Types without
+// an equivalent (e.g. slug or date) contribute no tag.
+var dataTypeValidationTags = map[PVDataType]string{
+	IntegerType:      "numeric",
+	RealType:         "numeric",
+	DecimalType:      "numeric",
+	UUIDType:         "uuid",
+	AlphanumericType: "alphanum",
+	BooleanType:      "boolean",
+	FlagType:         "boolean",
+	EmailType:        "email",
+	LatitudeType:     "latitude",
+	LongitudeType:    "longitude",
+}
+
+// formatValidationTags maps format[...] rules to equivalent validator tags.
+var formatValidationTags = map[string]string{
+	"v1":  "uuid",
+	"v3":  "uuid3",
+	"v4":  "uuid4",
+	"v5":  "uuid5",
+	"url": "url",
+	"jwt": "jwt",
+}
+
+// ValidationTags returns a struct-validation tag for each parameter, e.g.
+// "required,numeric,min=1,max=100", so parameters can be re-validated by
+// validators such as github.com/go-playground/validator after being bound to a
+// struct. Tags are derived from optionality, data type and constraints;
+// constraints with no validator equivalent, such as regex, are omitted.
+func (pt *ParsedTemplate) ValidationTags() map[Identifier]string {
+	tags := make(map[Identifier]string, pt.params.Len())
+	for name, p := range pt.params.Iterator() {
+		tags[name] = validationTag(p)
+	}
+	return tags
+}
+
+// validationTag builds the validator tag for a single parameter.
+func validationTag(p Parameter) string {
+	var parts []string
+
+	if !p.Optional {
+		parts = append(parts, "required")
+	}
+	if tag, ok := dataTypeValidationTags[p.DataType()]; ok {
+		parts = append(parts, tag)
+	}
+	for _, c := range p.Constraints() {
+		if _, negated := c.(*NegatedConstraint); negated {
+			// Validators have no general way to express negation
+			continue
+		}
+		rule := c.Rule()
+		switch c.Type() {
+		case RangeConstraintType, LengthConstraintType:
+			minimum, maximum, ok := strings.Cut(rule, "..")
+			if !ok || p.DataType() == DateType {
+				// Validators have no min/max equivalent for date ranges
+				continue
+			}
+			parts = append(parts, "min="+minimum, "max="+maximum)
+		case EnumConstraintType:
+			parts = append(parts, "oneof="+strings.ReplaceAll(rule, ",", " "))
+		case NotEmptyConstraintType:
+			if p.Optional {
+				// "required" would also reject an omitted value
+				parts = append(parts, "min=1")
+			}
+		case LuhnConstraintType:
+			parts = append(parts, "luhn_checksum")
+		case CaseConstraintType:
+			parts = append(parts, rule+"case")
+		case FormatConstraintType:
+			if tag, ok := formatValidationTags[strings.ToLower(rule)]; ok {
+				parts = append(parts, tag)
+			}
+		}
+	}
+	return strings.Join(parts, ",")
+}
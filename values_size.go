@@ -0,0 +1,21 @@
+package pathvars
+
+// checkValuesSize returns an error wrapping ErrValuesTooLarge, a client fault,
+// when path and rawQuery total more than RouterArgs.MaxValuesSize bytes. Every
+// value a request provides is taken from them, so their raw length bounds the
+// values before any is extracted or validated.
+func (r *Router) checkValuesSize(path, rawQuery string) (err error) {
+	size := len(path) + len(rawQuery)
+	if r.maxValuesSize <= 0 || size <= r.maxValuesSize {
+		goto end
+	}
+	// The path and query are not echoed back, as they may be huge
+	err = NewErr(
+		ErrValuesTooLarge,
+		"fault_source", ClientFaultSource.Slug(),
+		"request_size", size,
+		"max_values_size", r.maxValuesSize,
+	)
+end:
+	return err
+}